@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "vulnerabilities_stable_vuln_hash_idx";
+
+ALTER TABLE "vulnerabilities"
+DROP COLUMN "stable_vuln_hash";
+
+COMMIT;
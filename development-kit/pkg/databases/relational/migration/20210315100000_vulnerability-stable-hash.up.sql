@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE "vulnerabilities"
+ADD 
+    "stable_vuln_hash" VARCHAR(255);
+
+CREATE INDEX IF NOT EXISTS "vulnerabilities_stable_vuln_hash_idx" ON "vulnerabilities" ("stable_vuln_hash");
+
+COMMIT;
@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "vulnerabilities_owasp_top10_idx";
+DROP INDEX IF EXISTS "vulnerabilities_cwes_idx";
+
+ALTER TABLE "vulnerabilities"
+DROP COLUMN "owasp_top10",
+DROP COLUMN "cwes";
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE "vulnerabilities"
+ADD 
+    "cwes" TEXT[],
+ADD 
+    "owasp_top10" TEXT[];
+
+CREATE INDEX IF NOT EXISTS "vulnerabilities_cwes_idx" ON "vulnerabilities" USING GIN ("cwes");
+CREATE INDEX IF NOT EXISTS "vulnerabilities_owasp_top10_idx" ON "vulnerabilities" USING GIN ("owasp_top10");
+
+COMMIT;
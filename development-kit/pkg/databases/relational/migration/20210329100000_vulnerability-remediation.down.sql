@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE "vulnerabilities"
+DROP COLUMN "reference_urls",
+DROP COLUMN "remediation",
+DROP COLUMN "title",
+DROP COLUMN "rule_id";
+
+COMMIT;
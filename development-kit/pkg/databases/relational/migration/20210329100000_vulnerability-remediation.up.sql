@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE "vulnerabilities"
+ADD 
+    "rule_id" VARCHAR(255),
+ADD 
+    "title" TEXT,
+ADD 
+    "remediation" TEXT,
+ADD 
+    "reference_urls" TEXT[];
+
+COMMIT;
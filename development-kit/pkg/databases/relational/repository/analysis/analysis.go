@@ -19,7 +19,6 @@ import "C"
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	SQL "github.com/ZupIT/horusec/development-kit/pkg/databases/relational"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/dashboard"
@@ -32,24 +31,15 @@ import (
 type IAnalysisRepository interface {
 	Create(analysis *horusec.Analysis, tx SQL.InterfaceWrite) error
 	GetByID(analysisID uuid.UUID) (*horusec.Analysis, error)
-	GetDetailsPaginated(companyID, repositoryID uuid.UUID, page, size int, initialDate,
-		finalDate time.Time) (vulnDetails []dashboard.VulnDetails, err error)
-	GetDetailsCount(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (count int, err error)
-	GetDeveloperCount(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (count int, err error)
-	GetRepositoryCount(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (count int, err error)
-	GetVulnBySeverity(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (vulnBySeverity []dashboard.VulnBySeverity, err error)
-	GetVulnByDeveloper(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (vulnByDeveloper []dashboard.VulnByDeveloper, err error)
-	GetVulnByLanguage(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (vulnByLanguage []dashboard.VulnByLanguage, err error)
-	GetVulnByRepository(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (vulnByRepository []dashboard.VulnByRepository, err error)
-	GetVulnByTime(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (vulnByTime []dashboard.VulnByTime, err error)
+	GetDetailsPaginated(filter *dashboard.Filter, page, size int) (vulnDetails []dashboard.VulnDetails, err error)
+	GetDetailsCount(filter *dashboard.Filter) (count int, err error)
+	GetDeveloperCount(filter *dashboard.Filter) (count int, err error)
+	GetRepositoryCount(filter *dashboard.Filter) (count int, err error)
+	GetVulnBySeverity(filter *dashboard.Filter) (vulnBySeverity []dashboard.VulnBySeverity, err error)
+	GetVulnByDeveloper(filter *dashboard.Filter) (vulnByDeveloper []dashboard.VulnByDeveloper, err error)
+	GetVulnByLanguage(filter *dashboard.Filter) (vulnByLanguage []dashboard.VulnByLanguage, err error)
+	GetVulnByRepository(filter *dashboard.Filter) (vulnByRepository []dashboard.VulnByRepository, err error)
+	GetVulnByTime(filter *dashboard.Filter) (vulnByTime []dashboard.VulnByTime, err error)
 }
 
 type Repository struct {
@@ -89,7 +79,7 @@ func (ar *Repository) validateToCreateManyToMany(conn SQL.InterfaceWrite, analys
 	for key := range analysis.AnalysisVulnerabilities {
 		vuln := analysis.AnalysisVulnerabilities[key].Vulnerability
 		// Validate if already exists vulnerability lookup hash and repositoryID
-		vulnerabilityID, err := ar.getVulnerabilityIDByHashAndRepositoryID(vuln.VulnHash, analysis.RepositoryID, conn.GetConnection())
+		vulnerabilityID, err := ar.getVulnerabilityIDByHashAndRepositoryID(&vuln, analysis.RepositoryID, conn.GetConnection())
 		if err != nil {
 			return err
 		}
@@ -110,6 +100,9 @@ func (ar *Repository) createManyToManyAndVulnerability(vulnerabilityID uuid.UUID
 	if vulnerabilityID != uuid.Nil {
 		// If exists vulnerability we need replace generic VulnerabilityID to existing vulnerability in DB
 		analyseVulnerability.VulnerabilityID = vulnerabilityID
+		if err := ar.backfillExistingVulnerability(conn.GetConnection(), vulnerabilityID, &vuln); err != nil {
+			return err
+		}
 		// If not exists we need create vulnerability with instance InterfaceWrite
 	} else if err := ar.execCreateVulnerability(vuln, conn); err != nil {
 		return err
@@ -118,14 +111,34 @@ func (ar *Repository) createManyToManyAndVulnerability(vulnerabilityID uuid.UUID
 	return ar.execCreateAnalysisVulnerabilities(*analyseVulnerability, conn.GetConnection())
 }
 
-func (ar *Repository) getVulnerabilityIDByHashAndRepositoryID(vulnHash string, repositoryID uuid.UUID, conn *gorm.DB) (vulnerabilityID uuid.UUID, err error) {
+func (ar *Repository) getVulnerabilityIDByHashAndRepositoryID(vuln *horusec.Vulnerability, repositoryID uuid.UUID,
+	conn *gorm.DB) (vulnerabilityID uuid.UUID, err error) {
+	// First we lookup by stable hash because it not change when vulnerability is moved to another line
+	if vuln.StableVulnHash != "" {
+		vulnerabilityID, err = ar.findVulnerabilityID(conn, repositoryID,
+			"vulnerabilities.stable_vuln_hash = ?", vuln.StableVulnHash)
+		if err != nil || vulnerabilityID != uuid.Nil {
+			return vulnerabilityID, err
+		}
+	}
+	// Vulnerabilities created before stable hash existed are found only by legacy hash
+	vulnerabilityID, err = ar.findVulnerabilityID(conn, repositoryID, "vulnerabilities.vuln_hash = ?", vuln.VulnHash)
+	if err != nil || vulnerabilityID == uuid.Nil || vuln.StableVulnHash == "" {
+		return vulnerabilityID, err
+	}
+	// Found by legacy hash so we backfill stable hash to next analysis found this vulnerability by it
+	return vulnerabilityID, ar.setStableHashIfNotExists(conn, vulnerabilityID, vuln.StableVulnHash)
+}
+
+func (ar *Repository) findVulnerabilityID(conn *gorm.DB, repositoryID uuid.UUID,
+	hashFilter, vulnHash string) (uuid.UUID, error) {
 	vulnerability := horusec.Vulnerability{}
 	// To validate if already exists this vulnerability inside of repository we need find by hash and repositoryID
 	query := conn.
 		Joins("INNER JOIN analysis_vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
 		Joins("INNER JOIN analysis ON analysis_vulnerabilities.analysis_id = analysis.analysis_id").
 		Where("analysis.repository_id = ?", repositoryID.String()).
-		Where("vulnerabilities.vuln_hash = ?", vulnHash).
+		Where(hashFilter, vulnHash).
 		Table(vulnerability.GetTable()).Find(&vulnerability)
 	if query.Error != nil {
 		// If error is "record not found" is not necessary procced because we go add new vulnerability
@@ -139,6 +152,47 @@ func (ar *Repository) getVulnerabilityIDByHashAndRepositoryID(vulnHash string, r
 	return vulnerability.VulnerabilityID, nil
 }
 
+func (ar *Repository) setStableHashIfNotExists(conn *gorm.DB, vulnerabilityID uuid.UUID, stableVulnHash string) error {
+	vulnerability := horusec.Vulnerability{}
+	return conn.Table(vulnerability.GetTable()).
+		Where("vulnerability_id = ? AND (stable_vuln_hash IS NULL OR stable_vuln_hash = '')", vulnerabilityID).
+		Update("stable_vuln_hash", stableVulnHash).Error
+}
+
+func (ar *Repository) backfillExistingVulnerability(conn *gorm.DB, vulnerabilityID uuid.UUID,
+	vuln *horusec.Vulnerability) error {
+	// Vulnerabilities created before classification existed receive the CWEs found in this analysis
+	if err := ar.setClassificationIfNotExists(conn, vulnerabilityID, vuln); err != nil {
+		return err
+	}
+	// Vulnerabilities created before rule information existed receive the remediation found in this analysis
+	return ar.setRuleInformationIfNotExists(conn, vulnerabilityID, vuln)
+}
+
+func (ar *Repository) setClassificationIfNotExists(conn *gorm.DB, vulnerabilityID uuid.UUID,
+	vuln *horusec.Vulnerability) error {
+	if !vuln.HasClassification() {
+		return nil
+	}
+
+	return conn.Table(vuln.GetTable()).
+		Where("vulnerability_id = ? AND (cwes IS NULL OR cwes = '{}')", vulnerabilityID).
+		Updates(map[string]interface{}{"cwes": vuln.CWEs, "owasp_top10": vuln.OWASPTop10}).Error
+}
+
+func (ar *Repository) setRuleInformationIfNotExists(conn *gorm.DB, vulnerabilityID uuid.UUID,
+	vuln *horusec.Vulnerability) error {
+	if !vuln.HasRuleInformation() {
+		return nil
+	}
+
+	return conn.Table(vuln.GetTable()).
+		Where("vulnerability_id = ? AND (rule_id IS NULL OR rule_id = '') AND "+
+			"(remediation IS NULL OR remediation = '')", vulnerabilityID).
+		Updates(map[string]interface{}{"rule_id": vuln.RuleID, "title": vuln.Title,
+			"remediation": vuln.Remediation, "reference_urls": vuln.References}).Error
+}
+
 func (ar *Repository) execCreateVulnerability(vul horusec.Vulnerability, conn SQL.InterfaceWrite) error {
 	return conn.Create(vul, vul.GetTable()).GetError()
 }
@@ -177,8 +231,8 @@ func (ar *Repository) GetByID(analysisID uuid.UUID) (*horusec.Analysis, error) {
 	return response.GetData().(*horusec.Analysis), nil
 }
 
-func (ar *Repository) GetDetailsPaginated(companyID, repositoryID uuid.UUID, page, size int, initialDate,
-	finalDate time.Time) (vulnDetails []dashboard.VulnDetails, err error) {
+func (ar *Repository) GetDetailsPaginated(
+	filter *dashboard.Filter, page, size int) (vulnDetails []dashboard.VulnDetails, err error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Select("DISTINCT ON (vulnerabilities.vulnerability_id) vulnerabilities.vulnerability_id," +
@@ -188,20 +242,19 @@ func (ar *Repository) GetDetailsPaginated(companyID, repositoryID uuid.UUID, pag
 			" vulnerabilities.details, vulnerabilities.security_tool, vulnerabilities.language," +
 			" vulnerabilities.severity, vulnerabilities.commit_author, vulnerabilities.commit_email," +
 			" vulnerabilities.commit_hash, vulnerabilities.commit_message, vulnerabilities.commit_date," +
-			" vulnerabilities.vuln_hash").
+			" vulnerabilities.vuln_hash, vulnerabilities.cwes, vulnerabilities.owasp_top10").
 		Table("analysis").
 		Joins("JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id").
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
 		Limit(size).
 		Offset(int(pagination.GetSkip(int64(page), int64(size))))
 
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Find(&vulnDetails)
+	query = ar.setWhereFilter(query, filter).Find(&vulnDetails)
 
 	return vulnDetails, query.Error
 }
 
-func (ar *Repository) GetDetailsCount(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (int, error) {
+func (ar *Repository) GetDetailsCount(filter *dashboard.Filter) (int, error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Table("analysis").
@@ -210,13 +263,12 @@ func (ar *Repository) GetDetailsCount(companyID, repositoryID uuid.UUID, initial
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id")
 
 	var count int64
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Count(&count)
+	query = ar.setWhereFilter(query, filter).Count(&count)
 
 	return int(count), query.Error
 }
 
-func (ar *Repository) GetDeveloperCount(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (int, error) {
+func (ar *Repository) GetDeveloperCount(filter *dashboard.Filter) (int, error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Table("analysis").
@@ -225,26 +277,32 @@ func (ar *Repository) GetDeveloperCount(companyID, repositoryID uuid.UUID, initi
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id")
 
 	var count int64
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Count(&count)
+	query = ar.setWhereFilter(query, filter).Count(&count)
 
 	return int(count), query.Error
 }
 
-func (ar *Repository) GetRepositoryCount(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (int, error) {
+func (ar *Repository) GetRepositoryCount(filter *dashboard.Filter) (int, error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Table("analysis").
 		Select("COUNT( DISTINCT ( analysis.repository_id ) )")
 
+	// Classification is only in vulnerabilities, so it is joined only when is necessary filter by it
+	if filter.HasClassification() {
+		query = query.
+			Joins("JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id").
+			Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id")
+	}
+
 	var count int64
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Count(&count)
+	query = ar.setWhereFilter(query, filter).Count(&count)
 
 	return int(count), query.Error
 }
 
-func (ar *Repository) GetVulnBySeverity(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (vulnBySeverity []dashboard.VulnBySeverity, err error) {
+func (ar *Repository) GetVulnBySeverity(
+	filter *dashboard.Filter) (vulnBySeverity []dashboard.VulnBySeverity, err error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Select("vulnerabilities.severity AS severity, COUNT( DISTINCT (vulnerabilities.vulnerability_id) ) AS total").
@@ -253,23 +311,23 @@ func (ar *Repository) GetVulnBySeverity(companyID, repositoryID uuid.UUID, initi
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
 		Group("vulnerabilities.severity")
 
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Find(&vulnBySeverity)
+	query = ar.setWhereFilter(query, filter).Find(&vulnBySeverity)
 
 	return vulnBySeverity, query.Error
 }
 
-func (ar *Repository) GetVulnByDeveloper(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (vulnByDeveloper []dashboard.VulnByDeveloper, err error) {
+func (ar *Repository) GetVulnByDeveloper(
+	filter *dashboard.Filter) (vulnByDeveloper []dashboard.VulnByDeveloper, err error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Select("vulnerabilities.commit_email AS developer, COUNT( DISTINCT (vulnerabilities.vulnerability_id) ) AS total,"+
 			" (?) AS critical, (?) AS high, (?) AS medium, (?) AS low, (?) AS unknown, (?) AS info",
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "commit_email", "CRITICAL"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "commit_email", "HIGH"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "commit_email", "MEDIUM"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "commit_email", "LOW"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "commit_email", "UNKNOWN"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "commit_email", "INFO")).
+			ar.getSubQueryByVulnerability(filter, "commit_email", "CRITICAL"),
+			ar.getSubQueryByVulnerability(filter, "commit_email", "HIGH"),
+			ar.getSubQueryByVulnerability(filter, "commit_email", "MEDIUM"),
+			ar.getSubQueryByVulnerability(filter, "commit_email", "LOW"),
+			ar.getSubQueryByVulnerability(filter, "commit_email", "UNKNOWN"),
+			ar.getSubQueryByVulnerability(filter, "commit_email", "INFO")).
 		Table("analysis").
 		Joins("JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id").
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
@@ -277,45 +335,45 @@ func (ar *Repository) GetVulnByDeveloper(companyID, repositoryID uuid.UUID, init
 		Order("total DESC").
 		Limit(5)
 
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Find(&vulnByDeveloper)
+	query = ar.setWhereFilter(query, filter).Find(&vulnByDeveloper)
 
 	return vulnByDeveloper, query.Error
 }
 
-func (ar *Repository) GetVulnByLanguage(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (vulnByLanguage []dashboard.VulnByLanguage, err error) {
+func (ar *Repository) GetVulnByLanguage(
+	filter *dashboard.Filter) (vulnByLanguage []dashboard.VulnByLanguage, err error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Select("vulnerabilities.language AS language, COUNT( DISTINCT (vulnerabilities.vulnerability_id) ) AS total,"+
 			" (?) AS critical, (?) AS high, (?) AS medium, (?) AS low, (?) AS unknown, (?) AS info",
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "language", "CRITICAL"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "language", "HIGH"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "language", "MEDIUM"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "language", "LOW"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "language", "UNKNOWN"),
-			ar.getSubQueryByVulnerability(companyID, repositoryID, initialDate, finalDate, "language", "INFO")).
+			ar.getSubQueryByVulnerability(filter, "language", "CRITICAL"),
+			ar.getSubQueryByVulnerability(filter, "language", "HIGH"),
+			ar.getSubQueryByVulnerability(filter, "language", "MEDIUM"),
+			ar.getSubQueryByVulnerability(filter, "language", "LOW"),
+			ar.getSubQueryByVulnerability(filter, "language", "UNKNOWN"),
+			ar.getSubQueryByVulnerability(filter, "language", "INFO")).
 		Table("analysis").
 		Joins("JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id").
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
 		Group("vulnerabilities.language")
 
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Find(&vulnByLanguage)
+	query = ar.setWhereFilter(query, filter).Find(&vulnByLanguage)
 
 	return vulnByLanguage, query.Error
 }
 
-func (ar *Repository) GetVulnByRepository(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (vulnByRepository []dashboard.VulnByRepository, err error) {
+func (ar *Repository) GetVulnByRepository(
+	filter *dashboard.Filter) (vulnByRepository []dashboard.VulnByRepository, err error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Select(" MAX(analysis.repository_name) AS repository, COUNT( DISTINCT (vulnerabilities.vulnerability_id) ) AS total,"+
 			" (?) AS critical, (?) AS high, (?) AS medium, (?) AS low, (?) AS unknown, (?) AS info",
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "repository_id", "CRITICAL"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "repository_id", "HIGH"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "repository_id", "MEDIUM"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "repository_id", "LOW"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "repository_id", "UNKNOWN"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "repository_id", "INFO")).
+			ar.getSubQueryByAnalysis(filter, "repository_id", "CRITICAL"),
+			ar.getSubQueryByAnalysis(filter, "repository_id", "HIGH"),
+			ar.getSubQueryByAnalysis(filter, "repository_id", "MEDIUM"),
+			ar.getSubQueryByAnalysis(filter, "repository_id", "LOW"),
+			ar.getSubQueryByAnalysis(filter, "repository_id", "UNKNOWN"),
+			ar.getSubQueryByAnalysis(filter, "repository_id", "INFO")).
 		Table("analysis").
 		Joins("JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id").
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
@@ -323,35 +381,33 @@ func (ar *Repository) GetVulnByRepository(companyID, repositoryID uuid.UUID, ini
 		Order("total DESC").
 		Limit(5)
 
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Find(&vulnByRepository)
+	query = ar.setWhereFilter(query, filter).Find(&vulnByRepository)
 
 	return vulnByRepository, query.Error
 }
 
-func (ar *Repository) GetVulnByTime(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (vulnByTime []dashboard.VulnByTime, err error) {
+func (ar *Repository) GetVulnByTime(filter *dashboard.Filter) (vulnByTime []dashboard.VulnByTime, err error) {
 	query := ar.databaseRead.
 		GetConnection().
 		Select("analysis.finished_at AS time, COUNT( DISTINCT (vulnerabilities.vulnerability_id) ) AS total,"+
 			" (?) AS critical, (?) AS high, (?) AS medium, (?) AS low, (?) AS unknown, (?) AS info",
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "finished_at", "CRITICAL"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "finished_at", "HIGH"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "finished_at", "MEDIUM"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "finished_at", "LOW"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "finished_at", "UNKNOWN"),
-			ar.getSubQueryByAnalysis(companyID, repositoryID, initialDate, finalDate, "finished_at", "INFO")).
+			ar.getSubQueryByAnalysis(filter, "finished_at", "CRITICAL"),
+			ar.getSubQueryByAnalysis(filter, "finished_at", "HIGH"),
+			ar.getSubQueryByAnalysis(filter, "finished_at", "MEDIUM"),
+			ar.getSubQueryByAnalysis(filter, "finished_at", "LOW"),
+			ar.getSubQueryByAnalysis(filter, "finished_at", "UNKNOWN"),
+			ar.getSubQueryByAnalysis(filter, "finished_at", "INFO")).
 		Table("analysis").
 		Joins("JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id").
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
 		Group("analysis.finished_at")
 
-	query = ar.setWhereFilter(query, companyID, repositoryID, initialDate, finalDate).Find(&vulnByTime)
+	query = ar.setWhereFilter(query, filter).Find(&vulnByTime)
 
 	return vulnByTime, query.Error
 }
 
-func (ar *Repository) getSubQueryByAnalysis(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time, field, severity string) *gorm.DB {
+func (ar *Repository) getSubQueryByAnalysis(filter *dashboard.Filter, field, severity string) *gorm.DB {
 	subQuery := ar.databaseRead.
 		GetConnection().
 		Select("COUNT( DISTINCT (vuln.vulnerability_id) )").
@@ -360,11 +416,10 @@ func (ar *Repository) getSubQueryByAnalysis(companyID, repositoryID uuid.UUID, i
 		Joins("JOIN vulnerabilities AS vuln ON vuln.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
 		Where(fmt.Sprintf("ana.%s = analysis.%s AND vuln.severity = ?", field, field), severity)
 
-	return ar.setWhereFilter(subQuery, companyID, repositoryID, initialDate, finalDate)
+	return ar.setWhereFilter(subQuery, filter)
 }
 
-func (ar *Repository) getSubQueryByVulnerability(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time, field, severity string) *gorm.DB {
+func (ar *Repository) getSubQueryByVulnerability(filter *dashboard.Filter, field, severity string) *gorm.DB {
 	subQuery := ar.databaseRead.
 		GetConnection().
 		Select("COUNT( DISTINCT (vuln.vulnerability_id) )").
@@ -373,25 +428,37 @@ func (ar *Repository) getSubQueryByVulnerability(companyID, repositoryID uuid.UU
 		Joins("JOIN vulnerabilities AS vuln ON vuln.vulnerability_id = analysis_vulnerabilities.vulnerability_id").
 		Where(fmt.Sprintf("vuln.%s = vulnerabilities.%s AND vuln.severity = ?", field, field), severity)
 
-	return ar.setWhereFilter(subQuery, companyID, repositoryID, initialDate, finalDate)
+	return ar.setWhereFilter(subQuery, filter)
 }
 
-func (ar *Repository) setWhereFilter(query *gorm.DB, companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) *gorm.DB {
+func (ar *Repository) setWhereFilter(query *gorm.DB, filter *dashboard.Filter) *gorm.DB {
+	query = ar.setClassificationFilter(query, filter)
 
-	if (initialDate == time.Time{} && finalDate == time.Time{}) {
-		if companyID != uuid.Nil {
-			return query.Where("company_id = ?", companyID)
+	if !filter.HasDateRange() {
+		if filter.CompanyID != uuid.Nil {
+			return query.Where("company_id = ?", filter.CompanyID)
 		}
 
-		return query.Where("repository_id = ?", repositoryID)
+		return query.Where("repository_id = ?", filter.RepositoryID)
 	}
 
-	if companyID != uuid.Nil {
+	if filter.CompanyID != uuid.Nil {
 		return query.Where("finished_at BETWEEN ? AND ? AND company_id = ?",
-			initialDate, finalDate, companyID)
+			filter.InitialDate, filter.FinalDate, filter.CompanyID)
 	}
 
 	return query.Where("finished_at BETWEEN ? AND ? AND repository_id = ?",
-		initialDate, finalDate, repositoryID)
+		filter.InitialDate, filter.FinalDate, filter.RepositoryID)
+}
+
+func (ar *Repository) setClassificationFilter(query *gorm.DB, filter *dashboard.Filter) *gorm.DB {
+	if cwe := filter.GetCWE(); cwe != "" {
+		query = query.Where("? = ANY(cwes)", cwe)
+	}
+
+	if pattern := filter.GetOWASPTop10Pattern(); pattern != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM UNNEST(owasp_top10) AS category WHERE category ILIKE ?)", pattern)
+	}
+
+	return query
 }
@@ -15,8 +15,6 @@
 package analysis
 
 import (
-	"time"
-
 	SQL "github.com/ZupIT/horusec/development-kit/pkg/databases/relational"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/dashboard"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
@@ -39,47 +37,47 @@ func (m *Mock) GetByID(_ uuid.UUID) (*horusec.Analysis, error) {
 	return args.Get(0).(*horusec.Analysis), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetDetailsPaginated(_, _ uuid.UUID, _, _ int, _, _ time.Time) ([]dashboard.VulnDetails, error) {
+func (m *Mock) GetDetailsPaginated(_ *dashboard.Filter, _, _ int) ([]dashboard.VulnDetails, error) {
 	args := m.MethodCalled("GetDetailsPaginated")
 	return args.Get(0).([]dashboard.VulnDetails), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetDetailsCount(_, _ uuid.UUID, _, _ time.Time) (int, error) {
+func (m *Mock) GetDetailsCount(_ *dashboard.Filter) (int, error) {
 	args := m.MethodCalled("GetDetailsCount")
 	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetDeveloperCount(_, _ uuid.UUID, _, _ time.Time) (count int, err error) {
+func (m *Mock) GetDeveloperCount(_ *dashboard.Filter) (count int, err error) {
 	args := m.MethodCalled("GetDeveloperCount")
 	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetRepositoryCount(_, _ uuid.UUID, _, _ time.Time) (count int, err error) {
+func (m *Mock) GetRepositoryCount(_ *dashboard.Filter) (count int, err error) {
 	args := m.MethodCalled("GetRepositoryCount")
 	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnBySeverity(_, _ uuid.UUID, _, _ time.Time) ([]dashboard.VulnBySeverity, error) {
+func (m *Mock) GetVulnBySeverity(_ *dashboard.Filter) ([]dashboard.VulnBySeverity, error) {
 	args := m.MethodCalled("GetVulnBySeverity")
 	return args.Get(0).([]dashboard.VulnBySeverity), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByDeveloper(_, _ uuid.UUID, _, _ time.Time) ([]dashboard.VulnByDeveloper, error) {
+func (m *Mock) GetVulnByDeveloper(_ *dashboard.Filter) ([]dashboard.VulnByDeveloper, error) {
 	args := m.MethodCalled("GetVulnByDeveloper")
 	return args.Get(0).([]dashboard.VulnByDeveloper), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByLanguage(_, _ uuid.UUID, _, _ time.Time) ([]dashboard.VulnByLanguage, error) {
+func (m *Mock) GetVulnByLanguage(_ *dashboard.Filter) ([]dashboard.VulnByLanguage, error) {
 	args := m.MethodCalled("GetVulnByLanguage")
 	return args.Get(0).([]dashboard.VulnByLanguage), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByRepository(_, _ uuid.UUID, _, _ time.Time) ([]dashboard.VulnByRepository, error) {
+func (m *Mock) GetVulnByRepository(_ *dashboard.Filter) ([]dashboard.VulnByRepository, error) {
 	args := m.MethodCalled("GetVulnByRepository")
 	return args.Get(0).([]dashboard.VulnByRepository), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByTime(_, _ uuid.UUID, _, _ time.Time) ([]dashboard.VulnByTime, error) {
+func (m *Mock) GetVulnByTime(_ *dashboard.Filter) ([]dashboard.VulnByTime, error) {
 	args := m.MethodCalled("GetVulnByTime")
 	return args.Get(0).([]dashboard.VulnByTime), mockUtils.ReturnNilOrError(args, 1)
 }
@@ -20,7 +20,6 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/google/uuid"
 	"testing"
-	"time"
 )
 
 //var accountID = uuid.New()
@@ -165,15 +164,15 @@ func TestMock(t *testing.T) {
 		var tx SQL.InterfaceWrite
 		_ = mock.Create(&horusec.Analysis{}, tx)
 		_, _ = mock.GetByID(uuid.New())
-		_, _ = mock.GetDetailsPaginated(uuid.New(), uuid.New(), 1, 1, time.Now(), time.Now())
-		_, _ = mock.GetDetailsCount(uuid.New(), uuid.New(), time.Now(), time.Now())
-		_, _ = mock.GetDeveloperCount(uuid.New(), uuid.New(), time.Now(), time.Now())
-		_, _ = mock.GetRepositoryCount(uuid.New(), uuid.New(), time.Now(), time.Now())
-		_, _ = mock.GetVulnBySeverity(uuid.New(), uuid.New(), time.Now(), time.Now())
-		_, _ = mock.GetVulnByDeveloper(uuid.New(), uuid.New(), time.Now(), time.Now())
-		_, _ = mock.GetVulnByLanguage(uuid.New(), uuid.New(), time.Now(), time.Now())
-		_, _ = mock.GetVulnByRepository(uuid.New(), uuid.New(), time.Now(), time.Now())
-		_, _ = mock.GetVulnByTime(uuid.New(), uuid.New(), time.Now(), time.Now())
+		_, _ = mock.GetDetailsPaginated(&dashboardEntities.Filter{}, 1, 1)
+		_, _ = mock.GetDetailsCount(&dashboardEntities.Filter{})
+		_, _ = mock.GetDeveloperCount(&dashboardEntities.Filter{})
+		_, _ = mock.GetRepositoryCount(&dashboardEntities.Filter{})
+		_, _ = mock.GetVulnBySeverity(&dashboardEntities.Filter{})
+		_, _ = mock.GetVulnByDeveloper(&dashboardEntities.Filter{})
+		_, _ = mock.GetVulnByLanguage(&dashboardEntities.Filter{})
+		_, _ = mock.GetVulnByRepository(&dashboardEntities.Filter{})
+		_, _ = mock.GetVulnByTime(&dashboardEntities.Filter{})
 	})
 }
 
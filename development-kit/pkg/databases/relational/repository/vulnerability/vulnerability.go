@@ -163,7 +163,9 @@ func (r *Repository) listVulnManagementDataSubQuery(repositoryID uuid.UUID,
 		Select("DISTINCT ON (vulnerabilities.vulnerability_id) vulnerabilities.vulnerability_id," +
 			" vulnerabilities.type, vulnerabilities.vuln_hash, vulnerabilities.line, vulnerabilities.column," +
 			" vulnerabilities.confidence, vulnerabilities.file, vulnerabilities.code, vulnerabilities.details," +
-			" vulnerabilities.security_tool, vulnerabilities.language, vulnerabilities.severity").
+			" vulnerabilities.security_tool, vulnerabilities.language, vulnerabilities.severity," +
+			" vulnerabilities.rule_id, vulnerabilities.title, vulnerabilities.remediation," +
+			" vulnerabilities.reference_urls").
 		Table("analysis").
 		Joins("JOIN analysis_vulnerabilities ON analysis.analysis_id = analysis_vulnerabilities.analysis_id").
 		Joins("JOIN vulnerabilities ON vulnerabilities.vulnerability_id = analysis_vulnerabilities.vulnerability_id")
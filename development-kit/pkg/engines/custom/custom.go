@@ -0,0 +1,138 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package custom
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	engineenums "github.com/ZupIT/horusec/development-kit/pkg/enums/engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/bmatcuk/doublestar/v2"
+	"github.com/go-enry/go-enry/v2"
+)
+
+// Rule is a text rule of user applied only in the files matched by its globs, or in the files of its language when
+// there are no globs, so it does not depend on a native engine of the language
+type Rule struct {
+	text.TextRule
+	Language languages.Language
+	Files    []string
+}
+
+type Interface interface {
+	Run(projectPath string, rules []Rule) (map[languages.Language][]engine.Finding, error)
+}
+
+type Engine struct{}
+
+func NewEngine() Interface {
+	return &Engine{}
+}
+
+// Run returns the findings of rules grouped by the language of rule, every file of project is read only once even
+// when matched by many rules
+func (e *Engine) Run(projectPath string, rules []Rule) (map[languages.Language][]engine.Finding, error) {
+	units, err := e.getTextUnitsByRule(projectPath, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := map[languages.Language][]engine.Finding{}
+	for index := range rules {
+		if len(units[index].Files) == 0 {
+			continue
+		}
+
+		findings[rules[index].Language] = append(findings[rules[index].Language], engine.RunMaxUnitsByAnalysis(
+			[]engine.Unit{units[index]}, []engine.Rule{rules[index].TextRule},
+			engineenums.DefaultMaxUnitsPerAnalysis)...)
+	}
+
+	return findings, nil
+}
+
+func (e *Engine) getTextUnitsByRule(projectPath string, rules []Rule) ([]text.TextUnit, error) {
+	units := make([]text.TextUnit, len(rules))
+	return units, filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || info.Size() == 0 {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(projectPath, path)
+		if err != nil {
+			return err
+		}
+
+		return e.appendFileInUnitsOfMatchedRules(path, filepath.ToSlash(relativePath), rules, units)
+	})
+}
+
+func (e *Engine) appendFileInUnitsOfMatchedRules(path, relativePath string, rules []Rule,
+	units []text.TextUnit) error {
+	var textFile *text.TextFile
+	for index := range rules {
+		if !rules[index].IsFileMatch(relativePath) {
+			continue
+		}
+
+		if textFile == nil {
+			file, err := text.ReadAndCreateTextFile(path)
+			if err != nil {
+				return err
+			}
+
+			textFile = &file
+		}
+
+		units[index].Files = append(units[index].Files, *textFile)
+	}
+
+	return nil
+}
+
+// IsFileMatch returns true when the slash separated path relative to project matches any glob of rule, globs
+// without a separator match the name of file in any folder, like in .gitignore. Without globs the language detected
+// by the name of file is used instead
+func (r *Rule) IsFileMatch(relativePath string) bool {
+	if len(r.Files) == 0 {
+		return r.isFileOfLanguage(relativePath)
+	}
+
+	for _, glob := range r.Files {
+		if !strings.Contains(glob, "/") {
+			glob = "**/" + glob
+		}
+
+		if matched, _ := doublestar.Match(strings.TrimPrefix(glob, "/"), relativePath); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Rule) isFileOfLanguage(relativePath string) bool {
+	for _, language := range enry.GetLanguages(relativePath, nil) {
+		if language == r.Language.ToString() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,123 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package custom
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRule(id string, language languages.Language, files []string, expression string) Rule {
+	return Rule{
+		TextRule: text.TextRule{
+			Metadata:    engine.Metadata{ID: id, Name: id},
+			Type:        text.Regular,
+			Expressions: []*regexp.Regexp{regexp.MustCompile(expression)},
+		},
+		Language: language,
+		Files:    files,
+	}
+}
+
+func createProject(t *testing.T, files map[string]string) string {
+	path := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(path, name)), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, name), []byte(content), os.ModePerm))
+	}
+
+	return path
+}
+
+func TestRule_IsFileMatch(t *testing.T) {
+	t.Run("should match name of file in any folder when glob has no separator", func(t *testing.T) {
+		rule := newRule("1", languages.HCL, []string{"*.tf"}, "")
+
+		assert.True(t, rule.IsFileMatch("main.tf"))
+		assert.True(t, rule.IsFileMatch("modules/network/main.tf"))
+		assert.False(t, rule.IsFileMatch("main.tfvars"))
+	})
+
+	t.Run("should match path relative to project when glob has separator", func(t *testing.T) {
+		rule := newRule("1", languages.Go, []string{"/cmd/**/*.go", "internal/*.go"}, "")
+
+		assert.True(t, rule.IsFileMatch("cmd/api/main.go"))
+		assert.True(t, rule.IsFileMatch("internal/service.go"))
+		assert.False(t, rule.IsFileMatch("internal/handler/handler.go"))
+		assert.False(t, rule.IsFileMatch("main.go"))
+	})
+
+	t.Run("should match files of language when rule has no globs", func(t *testing.T) {
+		rule := newRule("1", languages.Python, nil, "")
+
+		assert.True(t, rule.IsFileMatch("app/views.py"))
+		assert.False(t, rule.IsFileMatch("app/views.go"))
+	})
+}
+
+func TestEngine_Run(t *testing.T) {
+	projectPath := createProject(t, map[string]string{
+		"main.go":             "package main\n\nfunc main() {\n\tos.Exec(\"sh\")\n}\n",
+		"scripts/run.py":      "import os\n\nos.system(input())\n",
+		"infra/main.tf":       "resource \"aws_s3_bucket\" \"b\" {\n  acl = \"public-read\"\n}\n",
+		"infra/empty.tf":      "",
+		"docs/os.system.md":   "os.system is dangerous\n",
+		"vendor/lib/lib.py":   "os.system(cmd)\n",
+		"infra/variables.tf":  "variable \"acl\" {}\n",
+		"scripts/readme.txt":  "os.Exec\n",
+		"scripts/tool/job.py": "print('ok')\n",
+	})
+
+	t.Run("should return findings grouped by language of rules", func(t *testing.T) {
+		rules := []Rule{
+			newRule("GO-1", languages.Go, nil, `os\.Exec\(`),
+			newRule("PY-1", languages.Python, []string{"scripts/**/*.py"}, `os\.system\(`),
+			newRule("TF-1", languages.HCL, []string{"*.tf"}, `public-read`),
+		}
+
+		findings, err := NewEngine().Run(projectPath, rules)
+
+		assert.NoError(t, err)
+		assert.Len(t, findings, 3)
+		assert.Len(t, findings[languages.Go], 1)
+		assert.Equal(t, 4, findings[languages.Go][0].SourceLocation.Line)
+		assert.Len(t, findings[languages.Python], 1)
+		assert.Equal(t, filepath.Join(projectPath, "scripts", "run.py"),
+			findings[languages.Python][0].SourceLocation.Filename)
+		assert.Len(t, findings[languages.HCL], 1)
+		assert.Equal(t, "TF-1", findings[languages.HCL][0].ID)
+	})
+
+	t.Run("should return empty when no files are matched", func(t *testing.T) {
+		findings, err := NewEngine().Run(projectPath, []Rule{newRule("1", languages.Ruby, nil, `.`)})
+
+		assert.NoError(t, err)
+		assert.Empty(t, findings)
+	})
+
+	t.Run("should return error when project not exists", func(t *testing.T) {
+		_, err := NewEngine().Run(filepath.Join(projectPath, "invalid"), []Rule{newRule("1", languages.Go, nil, `.`)})
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwe
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
+)
+
+// rustAdvisoryPrefix identifies the findings of vulnerable crates found by the audit of Cargo.lock
+const rustAdvisoryPrefix = "RUSTSEC-"
+
+// GetCWEs returns the CWEs of a native rule. Most of the rules mention the CWE in the description, the others are
+// mapped by rule ID because changing their description would change the hash of vulnerabilities already found.
+// Advisories of vulnerable crates are classified as use of a vulnerable third party component
+func GetCWEs(ruleID, description string) []string {
+	if strings.HasPrefix(ruleID, rustAdvisoryPrefix) {
+		return []string{classification.CWEVulnerableThirdPartyComponent}
+	}
+
+	if cwes := classification.GetCWEsFromText(description); len(cwes) > 0 {
+		return cwes
+	}
+
+	return classification.NormalizeCWEs(rulesWithoutCWEInDescription()[ruleID]...)
+}
+
+// nolint:funlen mapping table of rules is necessary more 15 lines
+func rulesWithoutCWEInDescription() map[string][]string {
+	return map[string][]string{
+		// C#
+		"782ad071-1cf3-4230-936f-b7a1e794828d": {"78"},
+		"010a99b2-9f35-4cb3-9209-248bacba07f8": {"643"},
+		"f3390c7e-8151-4f8a-8bc4-433841569153": {"611"},
+		"3b905eb5-5af7-41db-a234-38c934f675b2": {"22"},
+		"b6f82b7c-f321-4651-8ad3-87fbf5e0412b": {"89"},
+		"f9436bdd-8a80-4168-98fa-17af9f8c51b8": {"327"},
+		"236724c0-482a-47f4-ba10-7ae14f47fd7b": {"90"},
+		"64cd7acd-99a8-4640-a2eb-ca839c47040d": {"89"},
+		"a5a1dcad-76e7-4d9d-afe4-0dba1bcca105": {"502"},
+		"c3c93cc6-f010-42fa-9e13-34dbaf33b852": {"614"},
+		"837c504d-38b4-4ea6-987b-d91e92ac86a2": {"1004"},
+		"0ea39e22-de31-4888-9348-58f4170755fd": {"89"},
+		"56dbcac5-f61b-4ad0-bcf3-214bca83b172": {"89"},
+		"9027bece-7a6f-4e6e-b7e5-5dbbe0870562": {"521"},
+		"5fc0eefc-31b3-4d07-8d97-37834aff963e": {"524"},
+		"eb1e2fb1-38f0-419f-b6f8-d9dd78d9cb6d": {"601"},
+		"bd2e5131-5afa-4063-a303-7d5cb2696265": {"20"},
+		"055817b7-8a0c-4024-b170-e96ad4fe32a0": {"20"},
+		"54a8ac1a-83df-4d7d-97de-e0901080b451": {"20"},
+		"25926a6c-b546-482d-81ee-8d82cd6919d5": {"311"},
+		"cbd6b77e-b4d5-4507-8835-3262faf669e4": {"642"},
+		"2586df5f-1302-48b7-b5ab-780bccf16963": {"89"},
+		"c418d2d0-1a99-4f44-8e22-8af3c56a3f60": {"89"},
+		"ae6164f0-e336-4fd1-9337-1214afe24972": {"89"},
+		"5c53c81e-5125-45a1-8b2c-bfa2b50a9cc5": {"89"},
+		"4a5d6ab4-ee09-4b39-b6b5-1f485c15e041": {"89"},
+		"c83a6f75-898e-4621-be87-b9ef0ce85ce7": {"295"},
+		"654e89b5-714c-4006-bd08-345c60e5ce00": {"327"},
+		"4b546b8d-0d0c-4b37-ad5f-f8f788019a3e": {"338"},
+		// Dart
+		"2a1596de-d78a-4e35-8384-7fa7cebd3259": {"312"},
+		// Java and Kotlin
+		"f06f8a53-311a-45d1-9047-ae243c2a313d": {"311"},
+		"47b35134-a487-4dee-8104-c7c36eddd342": {"311"},
+		"14cc5f74-278c-4e7c-941b-9aab07ae6b85": {"311"},
+		"02137214-b1af-41f0-9c5b-b4fd3c7b7ccf": {"287"},
+		"4b089244-6fbf-4341-a786-10c62fe0bae7": {"20"},
+		"009d6057-c351-4f05-93ad-ef172c3d15be": {"287"},
+		"96864660-9c6c-45ee-9027-acd3575745fa": {"235"},
+		"4d0e7c37-3fa0-4a88-b21b-a6b42c081db0": {"94"},
+		"9e6db86e-b461-47e7-a558-3d48bced66e8": {"94"},
+		"8f091815-f05e-4782-945b-a7b4fd8cf5ae": {"502"},
+		"67dfe348-5a8b-4fd3-a66e-8234a60278a3": {"1004"},
+		"827ed743-5a6a-4758-adcd-6c1534555422": {"287"},
+		"0cc60028-b33b-45e3-9c62-44a0c60ae517": {"326"},
+		"4455e6d5-4533-49e4-8edc-6efda9fce9c3": {"326"},
+		"8f10b6ba-065d-4e14-b3b9-ec231884b086": {"942"},
+		"ba2f4ac8-e5fa-4bd9-b124-d08aeedbe60d": {"749"},
+		// Kubernetes
+		"1e0be755-5333-4a74-ade4-d23a95d58b54": {"250"},
+		"89b452df-dfbd-487c-a6c6-9e002aac0823": {"250"},
+		"3e68d755-e860-49db-84b4-65f323edb1f1": {"250"},
+		"6deb1d82-7579-4e1b-9f1e-ed287e0eaccb": {"250"},
+		"9b26b361-7a92-465a-ae77-1c7122266823": {"250"},
+		"36255af0-2d6f-49c3-a2e7-e1f91d6c7652": {"653"},
+		"8408e039-b8d1-4104-bfc7-b58705843793": {"653"},
+		"db2df11f-9e58-45ce-94ef-861c6a8af361": {"653"},
+		// NodeJS
+		"9be13831-1147-4b55-b858-c2cbe595f9e4": {"829"},
+		"f135b762-8647-462a-8b00-1198ece1f972": {"295"},
+		"c575e343-7b3c-4d9d-bbd6-c020641c1fa3": {"200"},
+		"37c047e5-e48b-4346-a1f4-eb128f3c5e16": {"693"},
+		"295e4212-13f1-4132-beec-1ce4cb025150": {"1021"},
+		"11c90831-d161-4f97-96b0-6e2d45f9ef6d": {"311"},
+		"c4bd82a9-8089-45fe-9b64-017843f98928": {"693"},
+		"f65ac143-d2c7-44b8-b7b3-1f33c7cf9a1d": {"1004"},
+		"a60c9e48-4a28-41b7-990e-47a9cf237974": {"614"},
+		"340829b4-29cb-42c2-a518-e442feaa71f6": {"345"},
+		"698a44c8-2baf-495f-8437-5125767e2bbf": {"922"},
+		"f27cc667-219e-4066-99cd-b9c5fd3c94d9": {"942"},
+	}
+}
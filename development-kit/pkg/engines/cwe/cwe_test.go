@@ -0,0 +1,41 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cwe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCWEs(t *testing.T) {
+	t.Run("should return cwes mentioned in description", func(t *testing.T) {
+		description := "For more information checkout the CWE-532 (https://cwe.mitre.org/data/definitions/532.html) advisory."
+
+		assert.Equal(t, []string{"CWE-532"}, GetCWEs("782ad071-1cf3-4230-936f-b7a1e794828d", description))
+	})
+
+	t.Run("should return cwes mapped by rule id when description not contains cwe", func(t *testing.T) {
+		assert.Equal(t, []string{"CWE-78"}, GetCWEs("782ad071-1cf3-4230-936f-b7a1e794828d", "Command Injection"))
+	})
+
+	t.Run("should return empty when rule is not classified", func(t *testing.T) {
+		assert.Empty(t, GetCWEs("fc601f5a-0dd9-472f-9476-24f12ef8e990", "Host Aliases"))
+	})
+
+	t.Run("should return cwe of vulnerable dependency when finding is an advisory of crate", func(t *testing.T) {
+		assert.Equal(t, []string{"CWE-1395"}, GetCWEs("RUSTSEC-2020-0071", "Potential segfault in the time crate"))
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notmatch
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewDockerfileNotMatchUser() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "2df94d35-c96e-4d54-b371-4f0d77ec3516",
+			Name:        "Container running as root",
+			Description: "The image does not set a USER, so the container runs as root by default. A process running as root inside the container has the same privileges of root in the host when it escapes the container. Create an unprivileged user and set it with the USER instruction. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.NotMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?mi)^[ \t]*USER[ \t]+\S+`),
+		},
+	}
+}
+
+func NewDockerfileNotMatchHealthcheck() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "ac6c46a8-4731-4598-bcd7-b8890492d21a",
+			Name:        "Missing HEALTHCHECK",
+			Description: "The image does not declare a HEALTHCHECK, so the container runtime cannot detect when the application stops responding and the container keeps running in an unhealthy state. Add a HEALTHCHECK instruction that checks the application. For more information checkout the CWE-693 (https://cwe.mitre.org/data/definitions/693.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.NotMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?mi)^[ \t]*HEALTHCHECK[ \t]+`),
+		},
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package regular
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewDockerfileRegularRootUser() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "4c096bfd-a8b6-4f8f-8562-21e9952c2194",
+			Name:        "Container running as root",
+			Description: "The image sets root as the user of the container. A process running as root inside the container has the same privileges of root in the host when it escapes the container. Create an unprivileged user and set it with the USER instruction. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?mi)^[ \t]*USER[ \t]+(root|0)([ \t]*:[ \t]*\S+)?[ \t]*$`),
+		},
+	}
+}
+
+func NewDockerfileRegularAddFromRemoteURL() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "44ae4aa1-2216-4ebb-8475-dc0f5d2461c8",
+			Name:        "ADD from remote URL",
+			Description: "The ADD instruction downloads a file from a remote URL without verifying its integrity, a compromised server or a MITM attack can inject any content in the image. Download the file with a tool that validates a checksum or use COPY with files of the build context. For more information checkout the CWE-494 (https://cwe.mitre.org/data/definitions/494.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?mi)^[ \t]*ADD[ \t]+(--\S+[ \t]+)*(https?|ftp)://`),
+		},
+	}
+}
+
+func NewDockerfileRegularLatestTag() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "1508ea87-75f6-49b6-af65-3bbc4d9a8f96",
+			Name:        "Image with latest tag",
+			Description: "The base image uses the latest tag, so each build can use a different image that was not reviewed and the build is not reproducible. Pin the base image to a version or to a digest. For more information checkout the CWE-1357 (https://cwe.mitre.org/data/definitions/1357.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?mi)^[ \t]*FROM[ \t]+(--\S+[ \t]+)*[^\s:@]+:latest([ \t]|$)`),
+		},
+	}
+}
+
+func NewDockerfileRegularCurlPipeShell() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "25f6ba28-4afc-4c44-a8e0-df7a66ac1da4",
+			Name:        "Remote script piped to shell",
+			Description: "A script downloaded from a remote URL is executed directly by a shell, without any verification of its content. A compromised server or a MITM attack can execute any command during the build. Download the script, validate its checksum and only then execute it. For more information checkout the CWE-494 (https://cwe.mitre.org/data/definitions/494.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(curl|wget)[ \t][^|\n]*\|[ \t]*(sudo[ \t]+)?(/(usr/)?bin/)?(ba|z|da|k)?sh\b`),
+		},
+	}
+}
+
+func NewDockerfileRegularSecretInEnvOrArg() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "a95e9afe-291c-48d0-9fd0-30061ff9dd27",
+			Name:        "Secret in ENV or ARG",
+			Description: "A secret is set with the ENV or ARG instruction. The values of these instructions are stored in the image layers and in the image history, so anyone with access to the image can read them. Use build secrets (RUN --mount=type=secret) or pass the secret at runtime. For more information checkout the CWE-538 (https://cwe.mitre.org/data/definitions/538.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?mi)^[ \t]*(ENV|ARG)[ \t]+[\w.-]*(password|passwd|secret|token|api_?key|access_?key|private_?key)[\w.-]*([ \t]*=[ \t]*|[ \t]+)["']?[^\s"'$/][^\s"']{3,}`),
+		},
+	}
+}
+
+func NewDockerfileRegularAptGetWithoutVersionPinning() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "e545535f-d505-476f-b7ef-1752bc7b3c5b",
+			Name:        "apt-get install without version pinning",
+			Description: "A package is installed with apt-get without a pinned version, so each build can install a different version with vulnerabilities that were not reviewed. Pin the version of each package, for example apt-get install curl=7.74.0-1.3. For more information checkout the CWE-1357 (https://cwe.mitre.org/data/definitions/1357.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)apt-get([ \t]+|[ \t]*\\\r?\n[ \t]*)(-[^\s&;|]+([ \t]+|[ \t]*\\\r?\n[ \t]*))*install([ \t]+|[ \t]*\\\r?\n[ \t]*)([^\s&;|\\]+([ \t]+|[ \t]*\\\r?\n[ \t]*))*?[a-z0-9][a-z0-9+.-]*([ \t]|\\|&|;|\||$)`),
+		},
+	}
+}
+
+func NewDockerComposeRegularPrivilegedService() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "225a6d3c-c307-40c9-87c3-cf35eaa6708f",
+			Name:        "Privileged compose service",
+			Description: "The service runs in privileged mode, so the container has all the capabilities of the host and can access its devices, which allows a container breakout. Add only the capabilities that the service needs with cap_add. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^[ \t]*privileged:[ \t]*["']?true["']?[ \t]*$`),
+		},
+	}
+}
+
+func NewDockerComposeRegularHostNetwork() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "5a4debbc-3b03-479b-8d4b-422571ab7206",
+			Name:        "Compose service using host network",
+			Description: "The service shares the network namespace of the host, so it can bind to any port of the host and access services listening only on localhost. Publish only the ports the service needs. For more information checkout the CWE-668 (https://cwe.mitre.org/data/definitions/668.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^[ \t]*network_mode:[ \t]*["']?host["']?[ \t]*$`),
+		},
+	}
+}
+
+func NewDockerComposeRegularDockerSocketMounted() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "c51761f1-d02b-4888-89fe-2682f6b76446",
+			Name:        "Docker socket mounted",
+			Description: "The docker socket of the host is mounted in the service. Anyone with access to the socket controls the docker daemon and can start a privileged container to take over the host. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`/var/run/docker\.sock`),
+		},
+	}
+}
+
+func NewDockerComposeRegularLatestTag() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "47d78765-b0ab-425d-b9ef-37ac9958c7db",
+			Name:        "Compose image with latest tag",
+			Description: "The service uses an image with the latest tag, so each deploy can run a different image that was not reviewed. Pin the image to a version or to a digest. For more information checkout the CWE-1357 (https://cwe.mitre.org/data/definitions/1357.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?m)^[ \t]*image:[ \t]*["']?[^\s"'@]+:latest["']?[ \t]*$`),
+		},
+	}
+}
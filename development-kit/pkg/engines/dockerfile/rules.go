@@ -0,0 +1,144 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerfile
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/dockerfile/notmatch"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/dockerfile/regular"
+)
+
+// the engine reads the first bytes of each file to skip binaries, so smaller files are not loaded
+const minFileSize = 4
+
+var (
+	dockerfileRegex    = regexp.MustCompile(`(?i)^(dockerfile|containerfile)(\..+)?$|\.dockerfile$`)
+	dockerComposeRegex = regexp.MustCompile(`(?i)^(docker-)?compose([.-].+)?\.ya?ml$`)
+)
+
+type Interface interface {
+	GetAllRules() []engine.Rule
+	GetDockerfileRules() []engine.Rule
+	GetDockerComposeRules() []engine.Rule
+	GetDockerfileTextUnits(projectPath string) ([]engine.Unit, error)
+	GetDockerComposeTextUnits(projectPath string) ([]engine.Unit, error)
+}
+
+type Rules struct{}
+
+func NewRules() Interface {
+	return &Rules{}
+}
+
+// IsDockerfile returns true when the name of file is Dockerfile, Containerfile or has the dockerfile extension,
+// like Dockerfile.prod and api.dockerfile
+func IsDockerfile(path string) bool {
+	return dockerfileRegex.MatchString(filepath.Base(path))
+}
+
+// IsDockerComposeFile returns true when the file is a compose file, like docker-compose.yml and
+// docker-compose.prod.yaml
+func IsDockerComposeFile(path string) bool {
+	return dockerComposeRegex.MatchString(filepath.Base(path))
+}
+
+func (r *Rules) GetAllRules() []engine.Rule {
+	return append(r.GetDockerfileRules(), r.GetDockerComposeRules()...)
+}
+
+func (r *Rules) GetDockerfileRules() (rules []engine.Rule) {
+	for _, rule := range allRulesDockerfileRegular() {
+		rules = append(rules, rule)
+	}
+
+	for _, rule := range allRulesDockerfileNotMatch() {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func (r *Rules) GetDockerComposeRules() (rules []engine.Rule) {
+	for _, rule := range allRulesDockerComposeRegular() {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func allRulesDockerfileRegular() []text.TextRule {
+	return []text.TextRule{
+		regular.NewDockerfileRegularRootUser(),
+		regular.NewDockerfileRegularAddFromRemoteURL(),
+		regular.NewDockerfileRegularLatestTag(),
+		regular.NewDockerfileRegularCurlPipeShell(),
+		regular.NewDockerfileRegularSecretInEnvOrArg(),
+		regular.NewDockerfileRegularAptGetWithoutVersionPinning(),
+	}
+}
+
+func allRulesDockerfileNotMatch() []text.TextRule {
+	return []text.TextRule{
+		notmatch.NewDockerfileNotMatchUser(),
+		notmatch.NewDockerfileNotMatchHealthcheck(),
+	}
+}
+
+func allRulesDockerComposeRegular() []text.TextRule {
+	return []text.TextRule{
+		regular.NewDockerComposeRegularPrivilegedService(),
+		regular.NewDockerComposeRegularHostNetwork(),
+		regular.NewDockerComposeRegularDockerSocketMounted(),
+		regular.NewDockerComposeRegularLatestTag(),
+	}
+}
+
+func (r *Rules) GetDockerfileTextUnits(projectPath string) ([]engine.Unit, error) {
+	return r.getTextUnitsByFileMatch(projectPath, IsDockerfile)
+}
+
+func (r *Rules) GetDockerComposeTextUnits(projectPath string) ([]engine.Unit, error) {
+	return r.getTextUnitsByFileMatch(projectPath, IsDockerComposeFile)
+}
+
+// getTextUnitsByFileMatch is necessary because the files are selected by name and dockerfiles usually
+// do not have extension, so text.LoadDirIntoMultiUnit can not be used
+func (r *Rules) getTextUnitsByFileMatch(projectPath string, isFileMatch func(path string) bool) (
+	units []engine.Unit, err error) {
+	textUnit := text.TextUnit{}
+	err = filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !isFileMatch(path) || info.Size() < minFileSize {
+			return err
+		}
+
+		textFile, err := text.ReadAndCreateTextFile(path)
+		if err != nil {
+			return err
+		}
+
+		textUnit.Files = append(textUnit.Files, textFile)
+		return nil
+	})
+	if err != nil {
+		return []engine.Unit{}, err
+	}
+
+	return append(units, textUnit), nil
+}
@@ -0,0 +1,205 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockerfile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRules(t *testing.T) {
+	assert.IsType(t, NewRules(), &Rules{})
+}
+
+func TestRules_GetAllRules(t *testing.T) {
+	t.Run("should return all rules enable", func(t *testing.T) {
+		rules := NewRules().GetAllRules()
+		totalRegexes := 0
+
+		for i := range rules {
+			textRule := rules[i].(text.TextRule)
+			totalRegexes += len(textRule.Expressions)
+		}
+
+		assert.Greater(t, len(rules), 0)
+		assert.Greater(t, totalRegexes, 0)
+	})
+}
+
+func TestRulesEnum(t *testing.T) {
+	var totalRules []text.TextRule
+
+	totalRules = append(totalRules, allRulesDockerfileRegular()...)
+	totalRules = append(totalRules, allRulesDockerfileNotMatch()...)
+	totalRules = append(totalRules, allRulesDockerComposeRegular()...)
+	lenExpectedTotalRules := 12
+
+	t.Run("should not exists duplicated ID in rules and return lenExpectedTotalRules in dockerfile", func(t *testing.T) {
+		encountered := map[string]bool{}
+
+		for v := range totalRules {
+			if encountered[totalRules[v].ID] == true {
+				msg := fmt.Sprintf("This rules in dockerfile is duplicated ID(%s) => Name: %s, Description: %s, Type: %v", totalRules[v].ID, totalRules[v].Name, totalRules[v].Description, totalRules[v].Type)
+				assert.False(t, encountered[totalRules[v].ID], msg)
+			} else {
+				// Record this element as an encountered element.
+				encountered[totalRules[v].ID] = true
+			}
+		}
+		assert.Equal(t, len(totalRules), lenExpectedTotalRules, "totalRules in dockerfile is not equal the expected")
+		assert.Equal(t, len(encountered), lenExpectedTotalRules, "encountered in dockerfile is not equal the expected")
+	})
+}
+
+func TestIsDockerfileAndIsDockerComposeFile(t *testing.T) {
+	t.Run("should detect dockerfiles by name", func(t *testing.T) {
+		for _, path := range []string{"Dockerfile", "api/Dockerfile.prod", "dockerfile", "Containerfile", "build/api.dockerfile"} {
+			assert.True(t, IsDockerfile(path), path)
+		}
+		for _, path := range []string{"Dockerfile_old.md", "main.go", "dockerfiles/README.md"} {
+			assert.False(t, IsDockerfile(path), path)
+		}
+	})
+
+	t.Run("should detect compose files by name", func(t *testing.T) {
+		for _, path := range []string{"docker-compose.yml", "deployments/docker-compose.prod.yaml", "compose.yaml", "docker-compose-dev.yml"} {
+			assert.True(t, IsDockerComposeFile(path), path)
+		}
+		for _, path := range []string{"deployment.yaml", "docker-compose.json", "Dockerfile"} {
+			assert.False(t, IsDockerComposeFile(path), path)
+		}
+	})
+}
+
+func findDockerfileRules(content string, rules []engine.Rule) map[string]int {
+	textFile, _ := text.NewTextFile("Dockerfile", []byte(content))
+	findings := engine.Run([]engine.Unit{text.TextUnit{Files: []text.TextFile{textFile}}}, rules)
+
+	lines := map[string]int{}
+	for index := range findings {
+		lines[findings[index].ID] = findings[index].SourceLocation.Line
+	}
+
+	return lines
+}
+
+func TestRules_DockerfileFindings(t *testing.T) {
+	safeDockerfile := `FROM golang:1.16.3 AS builder
+RUN apt-get update && apt-get install -y --no-install-recommends \
+    ca-certificates=20210119 curl=7.74.0-1.3 && rm -rf /var/lib/apt/lists/*
+ADD ./app /app
+RUN curl -sSL -o install.sh https://example.com/install.sh && sha256sum -c install.sha256 && sh install.sh
+ARG GITHUB_TOKEN
+ENV DB_PASSWORD_FILE=/run/secrets/db_password
+ENV PWD_DIR=/app
+USER app
+HEALTHCHECK --interval=30s CMD curl -f http://localhost:8080/health || exit 1
+`
+	testcases := []struct {
+		rule       text.TextRule
+		vulnerable string
+		line       int
+	}{
+		{allRulesDockerfileRegular()[0], "FROM alpine:3.13\nUSER root\n", 2},
+		{allRulesDockerfileRegular()[1], "FROM alpine:3.13\nADD https://example.com/app.tar.gz /app\n", 2},
+		{allRulesDockerfileRegular()[2], "FROM node:latest AS build\n", 1},
+		{allRulesDockerfileRegular()[3], "FROM alpine:3.13\nRUN curl -sSL https://example.com/install.sh | bash\n", 2},
+		{allRulesDockerfileRegular()[4], "FROM alpine:3.13\nENV API_KEY=4f8d2b6a91c3\n", 2},
+		{allRulesDockerfileRegular()[5], "FROM debian:10\nRUN apt-get update && apt-get install -y curl=7.64.0 \\\n    wget\n", 2},
+		{allRulesDockerfileNotMatch()[0], "FROM alpine:3.13\nRUN ./build.sh\n", 0},
+		{allRulesDockerfileNotMatch()[1], "FROM alpine:3.13\nRUN ./build.sh\n", 0},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(fmt.Sprintf("should find %s", testcase.rule.Name), func(t *testing.T) {
+			lines := findDockerfileRules(testcase.vulnerable, []engine.Rule{testcase.rule})
+			assert.Contains(t, lines, testcase.rule.ID)
+			assert.Equal(t, testcase.line, lines[testcase.rule.ID])
+		})
+
+		t.Run(fmt.Sprintf("should not find %s in safe dockerfile", testcase.rule.Name), func(t *testing.T) {
+			lines := findDockerfileRules(safeDockerfile, []engine.Rule{testcase.rule})
+			assert.NotContains(t, lines, testcase.rule.ID)
+		})
+	}
+}
+
+func TestRules_DockerComposeFindings(t *testing.T) {
+	vulnerable := `version: "3.8"
+services:
+  agent:
+    image: portainer/agent:latest
+    privileged: true
+    network_mode: host
+    volumes:
+      - /var/run/docker.sock:/var/run/docker.sock
+`
+	safe := `version: "3.8"
+services:
+  api:
+    image: "horuszup/horusec-api:v1.10.0"
+    privileged: false
+    network_mode: bridge
+    volumes:
+      - ./data:/data
+`
+	expectedLines := []int{5, 6, 8, 4}
+
+	for index, rule := range allRulesDockerComposeRegular() {
+		t.Run(fmt.Sprintf("should find %s only in vulnerable compose file", rule.Name), func(t *testing.T) {
+			lines := findDockerfileRules(vulnerable, []engine.Rule{rule})
+			assert.Equal(t, expectedLines[index], lines[rule.ID])
+			assert.Empty(t, findDockerfileRules(safe, []engine.Rule{rule}))
+		})
+	}
+}
+
+func TestRules_GetTextUnits(t *testing.T) {
+	projectPath, err := ioutil.TempDir("", "horusec-dockerfile")
+	assert.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(projectPath)
+	}()
+	assert.NoError(t, os.MkdirAll(filepath.Join(projectPath, "api"), 0750))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "api", "Dockerfile"), []byte("FROM alpine:3.13\n"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "docker-compose.yml"), []byte("version: \"3.8\"\n"), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "main.go"), []byte("package main\n"), 0600))
+
+	t.Run("should load only dockerfiles", func(t *testing.T) {
+		units, err := NewRules().GetDockerfileTextUnits(projectPath)
+		assert.NoError(t, err)
+		assert.Len(t, units[0].(text.TextUnit).Files, 1)
+		assert.Equal(t, "Dockerfile", units[0].(text.TextUnit).Files[0].Name)
+	})
+
+	t.Run("should load only compose files", func(t *testing.T) {
+		units, err := NewRules().GetDockerComposeTextUnits(projectPath)
+		assert.NoError(t, err)
+		assert.Len(t, units[0].(text.TextUnit).Files, 1)
+		assert.Equal(t, "docker-compose.yml", units[0].(text.TextUnit).Files[0].Name)
+	})
+
+	t.Run("should return error when path not exists", func(t *testing.T) {
+		_, err := NewRules().GetDockerfileTextUnits(filepath.Join(projectPath, "not-exists"))
+		assert.Error(t, err)
+	})
+}
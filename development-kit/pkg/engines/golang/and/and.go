@@ -0,0 +1,60 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package and
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewGolangAndInsecureRandomForSecrets() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "6e93da0b-4a3a-41ff-929c-24dfce0750ff",
+			Name:        "Insecure random number generator used for secrets",
+			Description: "The package math/rand is used to generate a value that looks like a secret (token, password, key, nonce, salt or session). Its output is predictable and must not be used for security purposes. Use crypto/rand instead. For more information checkout the CWE-338 (https://cwe.mitre.org/data/definitions/338.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`"math/rand"`),
+			regexp.MustCompile(`(?i:(token|secret|passw(or)?d|key|nonce|salt|otp|session|csrf)\w*\s*(:=|=|\+=)).*\brand\.(Int|Intn|Int31|Int31n|Int63|Int63n|Uint32|Uint64|Float32|Float64|Perm|Read)\(`),
+		},
+	}
+}
+
+func NewGolangAndCommandExecutionWithRequestInput() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "3a05a7ba-98bb-41ad-ab0a-ab5ef2a075ea",
+			Name:        "Command execution in HTTP handler",
+			Description: "A file that reads values from HTTP requests also executes external commands. Make sure that no request value reaches the command or its arguments without being validated against an allow list. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Low.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`"os/exec"`),
+			regexp.MustCompile(`\.(FormValue|PostFormValue)\(|\.URL\.Query\(\)`),
+			regexp.MustCompile(`exec\.Command(Context)?\((\w+\s*,\s*)?[^"\s)]`),
+		},
+	}
+}
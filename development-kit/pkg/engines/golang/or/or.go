@@ -0,0 +1,77 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package or
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewGolangOrWeakHash() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "8c6833dc-fe47-4d97-9b63-6f725c77b25f",
+			Name:        "Weak hashing function md5 or sha1",
+			Description: "MD5 or SHA1 have known collision weaknesses and are no longer considered strong hashing algorithms. Use SHA-256 or higher, and bcrypt, scrypt or argon2 for passwords. For more information checkout the CWE-328 (https://cwe.mitre.org/data/definitions/328.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(md5|sha1)\.(New|Sum)\(`),
+			regexp.MustCompile(`crypto\.(MD4|MD5|SHA1)\.New\(`),
+			regexp.MustCompile(`"golang\.org/x/crypto/md4"`),
+		},
+	}
+}
+
+func NewGolangOrWeakCipher() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "d1ce0681-e233-49e8-be88-776ac7958b41",
+			Name:        "Weak cryptographic algorithm DES or RC4",
+			Description: "DES, Triple DES and RC4 are considered broken cryptographic algorithms. Use AES with an authenticated mode like GCM instead. For more information checkout the CWE-327 (https://cwe.mitre.org/data/definitions/327.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`des\.(NewCipher|NewTripleDESCipher)\(`),
+			regexp.MustCompile(`rc4\.NewCipher\(`),
+		},
+	}
+}
+
+func NewGolangOrInsecureCGIHandler() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "f3253dc4-75df-4cb2-97ad-5beb1228dbe8",
+			Name:        "Use of net/http/cgi",
+			Description: "The package net/http/cgi is vulnerable to httpoxy attacks in Go versions before 1.6.3. Prefer serving the application with net/http directly. For more information checkout the CWE-327 (https://cwe.mitre.org/data/definitions/327.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`"net/http/cgi"`),
+			regexp.MustCompile(`cgi\.(Serve|Handler)\b`),
+		},
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package regular
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewGolangRegularCommandExecutionWithTaintedInput() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "92187347-1b9b-4ee1-bf75-1250d1abab00",
+			Name:        "Command execution with tainted input",
+			Description: "A command is executed with arguments built from external input (HTTP request values, command line arguments, environment variables or string concatenation). An attacker who controls this input may be able to execute arbitrary commands. Validate the input against an allow list and never pass it to a shell interpreter. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`exec\.Command(Context)?\(.*(\.FormValue\(|\.PostFormValue\(|\.URL\.Query\(\)|\.Header\.Get\(|os\.Args|os\.Getenv\(|fmt\.Sprintf\(|"\s*\+\s*\w)`),
+			regexp.MustCompile(`exec\.Command(Context)?\((\w+\s*,\s*)?"((/usr)?/bin/)?(ba|z)?sh"\s*,\s*"-c"\s*,\s*[^"\s]`),
+			regexp.MustCompile(`exec\.Command(Context)?\((\w+\s*,\s*)?"cmd(\.exe)?"\s*,\s*"/(c|C)"\s*,\s*[^"\s]`),
+		},
+	}
+}
+
+func NewGolangRegularTLSInsecureSkipVerify() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "6d44fe57-24d2-44a0-ba0e-a1e688030c5d",
+			Name:        "TLS InsecureSkipVerify set true",
+			Description: "The TLS configuration disables the verification of the server certificate chain and host name. This application is vulnerable to MITM attacks. For more information checkout the CWE-295 (https://cwe.mitre.org/data/definitions/295.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`InsecureSkipVerify\s*:\s*true`),
+			regexp.MustCompile(`\.InsecureSkipVerify\s*=\s*true`),
+		},
+	}
+}
+
+func NewGolangRegularTLSWeakMinVersion() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "9691d903-0b9d-4bff-bcd3-10bda22c1f7b",
+			Name:        "TLS MinVersion too low",
+			Description: "The TLS configuration allows protocol versions that have known weaknesses. Use tls.VersionTLS12 or higher as MinVersion. For more information checkout the CWE-327 (https://cwe.mitre.org/data/definitions/327.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(MinVersion|MaxVersion)\s*(:|=)\s*tls\.Version(SSL30|TLS10|TLS11)`),
+		},
+	}
+}
+
+func NewGolangRegularSQLStringConcatenation() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "efb0b045-bb94-47f9-ba24-cb6797465f13",
+			Name:        "SQL query built with string concatenation",
+			Description: "A SQL query is built by concatenating or formatting strings. If any part of the query comes from external input the application is vulnerable to SQL Injection. Use placeholders and pass the values as query arguments. For more information checkout the CWE-89 (https://cwe.mitre.org/data/definitions/89.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\.(Query|QueryRow|Exec|Prepare|QueryContext|QueryRowContext|ExecContext|PrepareContext|Raw)\((\w+\s*,\s*)?"(?i:[^"]*\b(select|insert|update|delete)\b)[^"]*"\s*\+`),
+			regexp.MustCompile(`\.(Query|QueryRow|Exec|Prepare|QueryContext|QueryRowContext|ExecContext|PrepareContext|Raw)\((\w+\s*,\s*)?fmt\.Sprintf\(`),
+			regexp.MustCompile(`fmt\.Sprintf\(\s*"(?i:\s*(select\b.*\bfrom|insert\s+into|update\b.*\bset|delete\s+from)\b)[^"]*%[sv]`),
+		},
+	}
+}
+
+func NewGolangRegularUnescapedDataInHTMLTemplate() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "6ee9964f-ef3c-41b4-9359-181b6d103ab7",
+			Name:        "Unescaped data in HTML template",
+			Description: "Converting a non constant value to template.HTML, template.JS, template.URL, template.CSS or template.HTMLAttr disables the automatic escaping of html/template and may lead to Cross-Site Scripting (XSS). For more information checkout the CWE-79 (https://cwe.mitre.org/data/definitions/79.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Low.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`template\.(HTML|JS|JSStr|URL|CSS|HTMLAttr|Srcset)\(\s*[^")\s]`),
+		},
+	}
+}
+
+func NewGolangRegularPathTraversal() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "e0128904-2a53-40bb-a1b0-79116a61e1f8",
+			Name:        "File path built with request input",
+			Description: "A file is opened with a path built from HTTP request values. An attacker may use sequences like \"../\" to read or write files outside the intended directory. Clean the path and make sure it stays inside the base directory. For more information checkout the CWE-22 (https://cwe.mitre.org/data/definitions/22.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(os\.(Open|OpenFile|ReadFile|Create|Remove|RemoveAll)|ioutil\.(ReadFile|WriteFile)|http\.ServeFile)\(.*(\.FormValue\(|\.PostFormValue\(|\.URL\.Query\(\)|\.URL\.Path|mux\.Vars\()`),
+		},
+	}
+}
+
+func NewGolangRegularSSRF() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "b5b4d488-3a59-4435-9b30-33d6d9d0d3fb",
+			Name:        "HTTP request with URL from request input",
+			Description: "An outgoing HTTP request is made to a URL taken from the incoming request. An attacker may force the server to reach internal services (Server-Side Request Forgery). Validate the destination against an allow list. For more information checkout the CWE-918 (https://cwe.mitre.org/data/definitions/918.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`http\.(Get|Head|Post|PostForm|NewRequest|NewRequestWithContext)\(.*(\.FormValue\(|\.PostFormValue\(|\.URL\.Query\(\))`),
+		},
+	}
+}
+
+func NewGolangRegularPprofEndpointExposed() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "6d5b5e60-539d-44b4-b710-2ca379802726",
+			Name:        "Profiling endpoint automatically exposed",
+			Description: "Importing net/http/pprof registers the /debug/pprof endpoints in the default HTTP server mux, exposing runtime information of the application. For more information checkout the CWE-200 (https://cwe.mitre.org/data/definitions/200.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`_\s+"net/http/pprof"`),
+		},
+	}
+}
+
+func NewGolangRegularPermissiveFilePermissions() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "2e51a82c-02f2-4e3c-a085-d65015fef23f",
+			Name:        "Files or directories created with world writable permissions",
+			Description: "A file or directory is created with permissions that allow any user of the system to modify it. Use 0600 for files and 0750 for directories or less. For more information checkout the CWE-276 (https://cwe.mitre.org/data/definitions/276.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(os\.(WriteFile|OpenFile|Chmod|Mkdir|MkdirAll)|ioutil\.WriteFile)\(.*,\s*(0o?)?[0-7]?[0-7][2367]\)`),
+		},
+	}
+}
+
+func NewGolangRegularHTTPServerWithoutTimeouts() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "aeb7927b-7091-468d-b105-53489ec6a5b6",
+			Name:        "HTTP server without timeouts",
+			Description: "http.ListenAndServe and http.ListenAndServeTLS start a server without read and write timeouts, which makes it vulnerable to Slowloris attacks. Use an http.Server with ReadHeaderTimeout set. For more information checkout the CWE-400 (https://cwe.mitre.org/data/definitions/400.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`http\.ListenAndServe(TLS)?\(`),
+		},
+	}
+}
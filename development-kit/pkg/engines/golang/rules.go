@@ -0,0 +1,100 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package golang
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/golang/and"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/golang/or"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/golang/regular"
+)
+
+type Interface interface {
+	GetAllRules() []engine.Rule
+	GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error)
+}
+
+type Rules struct{}
+
+func NewRules() Interface {
+	return &Rules{}
+}
+
+func (r *Rules) GetAllRules() (rules []engine.Rule) {
+	for _, rule := range allRulesGolangAnd() {
+		rules = append(rules, rule)
+	}
+
+	for _, rule := range allRulesGolangOr() {
+		rules = append(rules, rule)
+	}
+
+	for _, rule := range allRulesGolangRegular() {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func allRulesGolangRegular() []text.TextRule {
+	return []text.TextRule{
+		regular.NewGolangRegularCommandExecutionWithTaintedInput(),
+		regular.NewGolangRegularTLSInsecureSkipVerify(),
+		regular.NewGolangRegularTLSWeakMinVersion(),
+		regular.NewGolangRegularSQLStringConcatenation(),
+		regular.NewGolangRegularUnescapedDataInHTMLTemplate(),
+		regular.NewGolangRegularPathTraversal(),
+		regular.NewGolangRegularSSRF(),
+		regular.NewGolangRegularPprofEndpointExposed(),
+		regular.NewGolangRegularPermissiveFilePermissions(),
+		regular.NewGolangRegularHTTPServerWithoutTimeouts(),
+	}
+}
+
+func allRulesGolangAnd() []text.TextRule {
+	return []text.TextRule{
+		and.NewGolangAndInsecureRandomForSecrets(),
+		and.NewGolangAndCommandExecutionWithRequestInput(),
+	}
+}
+
+func allRulesGolangOr() []text.TextRule {
+	return []text.TextRule{
+		or.NewGolangOrWeakHash(),
+		or.NewGolangOrWeakCipher(),
+		or.NewGolangOrInsecureCGIHandler(),
+	}
+}
+
+func (r *Rules) GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error) {
+	textUnits, err := text.LoadDirIntoMultiUnit(projectPath, 5, r.getExtensions())
+	if err != nil {
+		return []engine.Unit{}, err
+	}
+	return r.parseTextUnitsToUnits(textUnits), nil
+}
+
+func (r *Rules) parseTextUnitsToUnits(textUnits []text.TextUnit) (units []engine.Unit) {
+	for index := range textUnits {
+		units = append(units, textUnits[index])
+	}
+	return units
+}
+
+func (r *Rules) getExtensions() []string {
+	return []string{".go"}
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"fmt"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRules(t *testing.T) {
+	assert.IsType(t, NewRules(), &Rules{})
+}
+
+func TestRules_GetAllRules(t *testing.T) {
+	t.Run("should return all rules enable", func(t *testing.T) {
+		rules := NewRules().GetAllRules()
+		totalRegexes := 0
+
+		for i := range rules {
+			textRule := rules[i].(text.TextRule)
+			totalRegexes += len(textRule.Expressions)
+		}
+
+		assert.Greater(t, len(rules), 0)
+		assert.Greater(t, totalRegexes, 0)
+	})
+}
+
+func TestRulesEnum(t *testing.T) {
+	var totalRules []text.TextRule
+
+	totalRules = append(totalRules, allRulesGolangAnd()...)
+	totalRules = append(totalRules, allRulesGolangOr()...)
+	totalRules = append(totalRules, allRulesGolangRegular()...)
+	lenExpectedTotalRules := 15
+
+	t.Run("should not exists duplicated ID in rules and return lenExpectedTotalRules in golang", func(t *testing.T) {
+		encountered := map[string]bool{}
+
+		for v := range totalRules {
+			if encountered[totalRules[v].ID] == true {
+				msg := fmt.Sprintf("This rules in golang is duplicated ID(%s) => Name: %s, Description: %s, Type: %v", totalRules[v].ID, totalRules[v].Name, totalRules[v].Description, totalRules[v].Type)
+				assert.False(t, encountered[totalRules[v].ID], msg)
+			} else {
+				// Record this element as an encountered element.
+				encountered[totalRules[v].ID] = true
+			}
+		}
+		assert.Equal(t, len(totalRules), lenExpectedTotalRules, "totalRules in golang is not equal the expected")
+		assert.Equal(t, len(encountered), lenExpectedTotalRules, "encountered in golang is not equal the expected")
+	})
+}
+
+func TestRules_FindVulnerabilities(t *testing.T) {
+	code := `package main
+
+import (
+	"crypto/md5"
+	"crypto/tls"
+	"database/sql"
+	"math/rand"
+	"net/http"
+	"os/exec"
+)
+
+func handler(w http.ResponseWriter, r *http.Request, db *sql.DB) {
+	out, _ := exec.Command("sh", "-c", r.FormValue("cmd")).Output()
+	token := rand.Int63()
+	_ = &tls.Config{InsecureSkipVerify: true}
+	rows, _ := db.Query("SELECT * FROM users WHERE name = '" + r.FormValue("name") + "'")
+	sum := md5.Sum(out)
+	_, _, _ = token, rows, sum
+}
+`
+	textFile, err := text.NewTextFile("main.go", []byte(code))
+	assert.NoError(t, err)
+
+	findings := engine.Run([]engine.Unit{text.TextUnit{Files: []text.TextFile{textFile}}}, NewRules().GetAllRules())
+
+	var names []string
+	for index := range findings {
+		names = append(names, findings[index].Name)
+	}
+
+	for _, rule := range []text.TextRule{
+		allRulesGolangRegular()[0], allRulesGolangRegular()[1], allRulesGolangRegular()[3],
+		allRulesGolangAnd()[0], allRulesGolangOr()[0],
+	} {
+		assert.Contains(t, names, rule.Name)
+	}
+}
+
+func TestRules_NotFindVulnerabilitiesInSafeCode(t *testing.T) {
+	code := `package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"database/sql"
+	"os/exec"
+)
+
+func run(db *sql.DB, name string) {
+	out, _ := exec.Command("git", "status").Output()
+	token := make([]byte, 32)
+	_, _ = rand.Read(token)
+	_ = &tls.Config{MinVersion: tls.VersionTLS12}
+	rows, _ := db.Query("SELECT * FROM users WHERE name = ?", name)
+	sum := sha256.Sum256(out)
+	_, _ = rows, sum
+}
+`
+	textFile, err := text.NewTextFile("main.go", []byte(code))
+	assert.NoError(t, err)
+
+	findings := engine.Run([]engine.Unit{text.TextUnit{Files: []text.TextFile{textFile}}}, NewRules().GetAllRules())
+
+	assert.Empty(t, findings)
+}
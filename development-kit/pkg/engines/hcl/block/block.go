@@ -0,0 +1,178 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll descriptions of rules are not possible broken lines
+package block
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/structure"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+const (
+	blockTypeResource = "resource"
+	blockTypeData     = "data"
+	blockTypeProvider = "provider"
+)
+
+func NewHCLBlockS3BucketPublicACL() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "3f81b118-ecd0-43b7-a40a-a6fac4ada944",
+			Name:        "S3 Bucket with public ACL",
+			Description: "The ACL of the bucket allows the read or write of objects by everyone on internet or by any AWS account. Use the private ACL and grant access only to the principals that need it. For more information checkout the CWE-732 (https://cwe.mitre.org/data/definitions/732.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    []string{"aws_s3_bucket", "aws_s3_bucket_acl"},
+		Check:     checkS3BucketPublicACL,
+	}
+}
+
+func NewHCLBlockS3PublicAccessBlockDisabled() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "82746a5a-3599-4ff0-9958-399f1c3cf41a",
+			Name:        "S3 public access block disabled",
+			Description: "The public access block does not enable all its protections, so public ACLs and policies can expose the buckets. Set block_public_acls, block_public_policy, ignore_public_acls and restrict_public_buckets to true. For more information checkout the CWE-732 (https://cwe.mitre.org/data/definitions/732.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    []string{"aws_s3_bucket_public_access_block", "aws_s3_account_public_access_block"},
+		Check:     checkS3PublicAccessBlockDisabled,
+	}
+}
+
+func NewHCLBlockAWSSecurityGroupOpenToInternet() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "f3ede91f-ac83-4f88-86a0-f0b8d5a07128",
+			Name:        "AWS security group open to internet",
+			Description: "The ingress rule allows connections from any address (0.0.0.0/0 or ::/0), exposing the services to attacks from the internet. Allow only the addresses that need to access the resources. For more information checkout the CWE-284 (https://cwe.mitre.org/data/definitions/284.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    []string{"aws_security_group", "aws_security_group_rule", "aws_vpc_security_group_ingress_rule"},
+		Check:     checkAWSSecurityGroupOpenToInternet,
+	}
+}
+
+func NewHCLBlockGoogleFirewallOpenToInternet() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "164167d6-2235-4f3f-b95d-48784de98f1d",
+			Name:        "Google firewall open to internet",
+			Description: "The ingress firewall rule allows connections from any address (0.0.0.0/0 or ::/0), exposing the services to attacks from the internet. Allow only the addresses that need to access the resources. For more information checkout the CWE-284 (https://cwe.mitre.org/data/definitions/284.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    []string{"google_compute_firewall"},
+		Check:     checkGoogleFirewallOpenToInternet,
+	}
+}
+
+func NewHCLBlockAzureNetworkSecurityRuleOpenToInternet() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "0abbfb4e-4b2a-43b5-88f0-05eb4b0fdca6",
+			Name:        "Azure network security rule open to internet",
+			Description: "The inbound security rule allows connections from any address, exposing the services to attacks from the internet. Allow only the addresses that need to access the resources. For more information checkout the CWE-284 (https://cwe.mitre.org/data/definitions/284.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    []string{"azurerm_network_security_rule", "azurerm_network_security_group"},
+		Check:     checkAzureNetworkSecurityRuleOpenToInternet,
+	}
+}
+
+func NewHCLBlockStorageNotEncrypted() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "c4a2be68-59e1-4776-ba0d-3b0a6679aa1f",
+			Name:        "Storage not encrypted",
+			Description: "The data of the storage is not encrypted at rest, so it can be read by anyone with access to the disks, snapshots or backups. Enable the encryption of the storage. For more information checkout the CWE-311 (https://cwe.mitre.org/data/definitions/311.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    getLabelsOfEncryptionAttributes(),
+		Check:     checkStorageNotEncrypted,
+	}
+}
+
+func NewHCLBlockInstanceBlockDeviceNotEncrypted() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "908bbd1c-a0b9-4aea-813c-cb88784cece0",
+			Name:        "Instance block device not encrypted",
+			Description: "The block device of the instance is not encrypted at rest, so its data can be read by anyone with access to the volumes or snapshots. Set encrypted to true in the block device. For more information checkout the CWE-311 (https://cwe.mitre.org/data/definitions/311.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    []string{"aws_instance", "aws_launch_configuration", "aws_launch_template"},
+		Check:     checkInstanceBlockDeviceNotEncrypted,
+	}
+}
+
+func NewHCLBlockIAMPolicyWildcardAction() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "c78c7338-9b2a-4a6e-83d1-dee9bea2c53b",
+			Name:        "IAM policy with wildcard action",
+			Description: "The policy allows all actions (*), granting full administrative access to the principals which have it. Allow only the actions that are needed. For more information checkout the CWE-269 (https://cwe.mitre.org/data/definitions/269.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		BlockType: blockTypeResource,
+		Labels:    []string{"aws_iam_policy", "aws_iam_role_policy", "aws_iam_user_policy", "aws_iam_group_policy"},
+		Check:     checkIAMPolicyWildcardAction,
+	}
+}
+
+func NewHCLBlockIAMPolicyDocumentWildcardAction() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "d80c063a-901e-4e0d-92bf-afb0f2671f09",
+			Name:        "IAM policy document with wildcard action",
+			Description: "The policy document allows all actions (*), granting full administrative access to the principals which have it. Allow only the actions that are needed. For more information checkout the CWE-269 (https://cwe.mitre.org/data/definitions/269.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		BlockType: blockTypeData,
+		Labels:    []string{"aws_iam_policy_document"},
+		Check:     checkIAMPolicyDocumentWildcardAction,
+	}
+}
+
+func NewHCLBlockProviderHardcodedCredentials() structure.Rule {
+	return structure.Rule{
+		Metadata: engine.Metadata{
+			ID:          "f4189751-f6e6-43e2-a059-d0cca0dce507",
+			Name:        "Provider with hardcoded credentials",
+			Description: "The credentials of the provider are hardcoded, so anyone with access to the code can use them. Use environment variables, shared credential files or sensitive variables instead. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:    severity.Critical.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		BlockType: blockTypeProvider,
+		Check:     checkProviderHardcodedCredentials,
+	}
+}
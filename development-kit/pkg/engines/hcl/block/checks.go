@@ -0,0 +1,363 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package block
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/syntax"
+)
+
+const (
+	anyIPv4Address = "0.0.0.0/0"
+	anyIPv6Address = "::/0"
+	wildcardAction = "*"
+)
+
+var (
+	publicACLs                  = []string{"public-read", "public-read-write", "authenticated-read"}
+	publicGrantURIs             = []string{"global/AllUsers", "global/AuthenticatedUsers"}
+	publicAccessBlockAttributes = []string{
+		"block_public_acls", "block_public_policy", "ignore_public_acls", "restrict_public_buckets",
+	}
+	azureAnyAddresses = []string{"*", anyIPv4Address, "Internet", "Any"}
+	// encryptionAttributes are the attributes that enable the encryption at rest of each storage resource
+	encryptionAttributes = map[string]string{
+		"aws_ebs_volume":                    "encrypted",
+		"aws_efs_file_system":               "encrypted",
+		"aws_db_instance":                   "storage_encrypted",
+		"aws_rds_cluster":                   "storage_encrypted",
+		"aws_docdb_cluster":                 "storage_encrypted",
+		"aws_neptune_cluster":               "storage_encrypted",
+		"aws_redshift_cluster":              "encrypted",
+		"aws_elasticache_replication_group": "at_rest_encryption_enabled",
+	}
+	providerCredentialAttributes = []string{
+		"access_key", "secret_key", "token", "password", "client_secret", "api_key", "api_token",
+	}
+)
+
+func checkS3BucketPublicACL(block *syntax.Block) (lines []int) {
+	if attribute := block.Body.GetAttribute("acl"); attribute != nil && containsAny(attribute.Value, publicACLs) {
+		lines = append(lines, attribute.Line)
+	}
+
+	for _, attribute := range findAttributesByName(block.Body, "uri") {
+		for _, value := range attribute.Value.GetStrings() {
+			if hasAnySuffix(value, publicGrantURIs) {
+				lines = append(lines, attribute.Line)
+			}
+		}
+	}
+
+	return lines
+}
+
+// checkS3PublicAccessBlockDisabled returns the attributes set to false or the block when any of them is missing,
+// because all protections are disabled by default
+func checkS3PublicAccessBlockDisabled(block *syntax.Block) (lines []int) {
+	isMissingAttribute := false
+	for _, name := range publicAccessBlockAttributes {
+		attribute := block.Body.GetAttribute(name)
+		switch {
+		case attribute == nil:
+			isMissingAttribute = true
+		case attribute.Value.IsFalse():
+			lines = append(lines, attribute.Line)
+		}
+	}
+
+	if isMissingAttribute {
+		lines = append(lines, block.Line)
+	}
+
+	return lines
+}
+
+func checkAWSSecurityGroupOpenToInternet(block *syntax.Block) (lines []int) {
+	switch block.GetLabel(0) {
+	case "aws_security_group":
+		for _, ingress := range block.Body.GetBlocksByType("ingress") {
+			lines = append(lines, getLinesOfAnyAddress(ingress.Body, "cidr_blocks", "ipv6_cidr_blocks")...)
+		}
+	case "aws_security_group_rule":
+		if isAttributeEqual(block.Body, "type", "ingress") {
+			lines = getLinesOfAnyAddress(block.Body, "cidr_blocks", "ipv6_cidr_blocks")
+		}
+	default:
+		lines = getLinesOfAnyAddress(block.Body, "cidr_ipv4", "cidr_ipv6")
+	}
+
+	return lines
+}
+
+func checkGoogleFirewallOpenToInternet(block *syntax.Block) []int {
+	if isAttributeEqual(block.Body, "direction", "EGRESS") || len(block.Body.GetBlocksByType("allow")) == 0 {
+		return nil
+	}
+
+	return getLinesOfAnyAddress(block.Body, "source_ranges")
+}
+
+func checkAzureNetworkSecurityRuleOpenToInternet(block *syntax.Block) (lines []int) {
+	if block.GetLabel(0) == "azurerm_network_security_rule" {
+		return getLinesOfAzureRuleOpenToInternet(block.Body)
+	}
+
+	for _, securityRule := range block.Body.GetBlocksByType("security_rule") {
+		lines = append(lines, getLinesOfAzureRuleOpenToInternet(securityRule.Body)...)
+	}
+
+	return lines
+}
+
+func getLinesOfAzureRuleOpenToInternet(body *syntax.Body) (lines []int) {
+	if !isAttributeEqual(body, "direction", "Inbound") || !isAttributeEqual(body, "access", "Allow") {
+		return nil
+	}
+
+	for _, name := range []string{"source_address_prefix", "source_address_prefixes"} {
+		if attribute := body.GetAttribute(name); attribute != nil && containsAny(attribute.Value, azureAnyAddresses) {
+			lines = append(lines, attribute.Line)
+		}
+	}
+
+	return lines
+}
+
+func checkStorageNotEncrypted(block *syntax.Block) []int {
+	return getLinesOfNotEnabled(block, encryptionAttributes[block.GetLabel(0)])
+}
+
+func checkInstanceBlockDeviceNotEncrypted(block *syntax.Block) (lines []int) {
+	devices := append(block.Body.GetBlocksByType("root_block_device"), block.Body.GetBlocksByType("ebs_block_device")...)
+	for _, mapping := range block.Body.GetBlocksByType("block_device_mappings") {
+		devices = append(devices, mapping.Body.GetBlocksByType("ebs")...)
+	}
+
+	for _, device := range devices {
+		lines = append(lines, getLinesOfNotEnabled(device, "encrypted")...)
+	}
+
+	return lines
+}
+
+func checkIAMPolicyWildcardAction(block *syntax.Block) []int {
+	attribute := block.Body.GetAttribute("policy")
+	if attribute == nil {
+		return nil
+	}
+
+	if attribute.Value.Kind == syntax.String {
+		if isJSONPolicyWithWildcardAction(attribute.Value.Value) {
+			return []int{attribute.Line}
+		}
+		return nil
+	}
+
+	if attribute.Value.Kind == syntax.FunctionCall && attribute.Value.Value == "jsonencode" &&
+		len(attribute.Value.Items) == 1 {
+		return getLinesOfObjectPolicyWithWildcardAction(attribute.Value.Items[0])
+	}
+
+	return nil
+}
+
+func checkIAMPolicyDocumentWildcardAction(block *syntax.Block) (lines []int) {
+	for _, statement := range block.Body.GetBlocksByType("statement") {
+		if isAttributeEqual(statement.Body, "effect", "Deny") {
+			continue
+		}
+
+		if actions := statement.Body.GetAttribute("actions"); actions != nil &&
+			actions.Value.ContainsString(wildcardAction) {
+			lines = append(lines, actions.Line)
+		}
+	}
+
+	return lines
+}
+
+func checkProviderHardcodedCredentials(block *syntax.Block) (lines []int) {
+	for _, name := range providerCredentialAttributes {
+		attribute := block.Body.GetAttribute(name)
+		if attribute != nil && attribute.Value.IsLiteralString() && attribute.Value.Value != "" {
+			lines = append(lines, attribute.Line)
+		}
+	}
+
+	if credentials := block.Body.GetAttribute("credentials"); credentials != nil &&
+		credentials.Value.IsLiteralString() && strings.Contains(credentials.Value.Value, "private_key") {
+		lines = append(lines, credentials.Line)
+	}
+
+	sort.Ints(lines)
+	return lines
+}
+
+func getLinesOfObjectPolicyWithWildcardAction(policy *syntax.Expression) (lines []int) {
+	attribute := policy.GetAttribute("Statement")
+	if attribute == nil {
+		return nil
+	}
+
+	statements := []*syntax.Expression{attribute.Value}
+	if attribute.Value.Kind == syntax.Tuple {
+		statements = attribute.Value.Items
+	}
+
+	for _, statement := range statements {
+		if effect := statement.GetAttribute("Effect"); effect != nil && effect.Value.ContainsString("Deny") {
+			continue
+		}
+
+		if action := statement.GetAttribute("Action"); action != nil && action.Value.ContainsString(wildcardAction) {
+			lines = append(lines, action.Line)
+		}
+	}
+
+	return lines
+}
+
+func isJSONPolicyWithWildcardAction(content string) bool {
+	policy := struct {
+		Statement json.RawMessage
+	}{}
+	if err := json.Unmarshal([]byte(content), &policy); err != nil || len(policy.Statement) == 0 {
+		return false
+	}
+
+	for _, statement := range parseJSONStatements(policy.Statement) {
+		if statement.Effect != "Deny" && containsWildcard(statement.Action) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type jsonStatement struct {
+	Effect string
+	Action json.RawMessage
+}
+
+// parseJSONStatements returns the statements of policy, which can be a single object or a list of them
+func parseJSONStatements(content json.RawMessage) []jsonStatement {
+	var statements []jsonStatement
+	if err := json.Unmarshal(content, &statements); err == nil {
+		return statements
+	}
+
+	statement := jsonStatement{}
+	if err := json.Unmarshal(content, &statement); err != nil {
+		return nil
+	}
+
+	return []jsonStatement{statement}
+}
+
+// containsWildcard checks the action of statement, which can be a single string or a list of them
+func containsWildcard(content json.RawMessage) bool {
+	var actions []string
+	if err := json.Unmarshal(content, &actions); err != nil {
+		var action string
+		if err := json.Unmarshal(content, &action); err != nil {
+			return false
+		}
+		actions = []string{action}
+	}
+
+	for _, action := range actions {
+		if action == wildcardAction {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getLinesOfNotEnabled returns the attribute set to false or the block when the attribute is missing
+func getLinesOfNotEnabled(block *syntax.Block, name string) []int {
+	attribute := block.Body.GetAttribute(name)
+	switch {
+	case attribute == nil:
+		return []int{block.Line}
+	case attribute.Value.IsFalse():
+		return []int{attribute.Line}
+	default:
+		return nil
+	}
+}
+
+func getLinesOfAnyAddress(body *syntax.Body, names ...string) (lines []int) {
+	for _, name := range names {
+		attribute := body.GetAttribute(name)
+		if attribute != nil && containsAny(attribute.Value, []string{anyIPv4Address, anyIPv6Address}) {
+			lines = append(lines, attribute.Line)
+		}
+	}
+
+	return lines
+}
+
+// findAttributesByName returns the attributes with the name in the body and in all its nested blocks
+func findAttributesByName(body *syntax.Body, name string) (attributes []*syntax.Attribute) {
+	if attribute := body.GetAttribute(name); attribute != nil {
+		attributes = append(attributes, attribute)
+	}
+
+	for _, nested := range body.Blocks {
+		attributes = append(attributes, findAttributesByName(nested.Body, name)...)
+	}
+
+	return attributes
+}
+
+func isAttributeEqual(body *syntax.Body, name, value string) bool {
+	attribute := body.GetAttribute(name)
+	return attribute != nil && attribute.Value.Kind == syntax.String &&
+		strings.EqualFold(attribute.Value.Value, value)
+}
+
+func containsAny(expression *syntax.Expression, values []string) bool {
+	for _, value := range values {
+		if expression.ContainsString(value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasAnySuffix(value string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(value, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func getLabelsOfEncryptionAttributes() (labels []string) {
+	for label := range encryptionAttributes {
+		labels = append(labels, label)
+	}
+
+	sort.Strings(labels)
+	return labels
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hcl
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/block"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/structure"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+)
+
+const (
+	maxFilesPerUnit = 5
+	// terraformFolder contains the modules and providers downloaded by terraform init
+	terraformFolder = ".terraform"
+)
+
+type Interface interface {
+	GetAllRules() []engine.Rule
+	GetStructureUnits(projectPath string) ([]engine.Unit, error)
+	GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error)
+}
+
+type Rules struct{}
+
+func NewRules() Interface {
+	return &Rules{}
+}
+
+func (r *Rules) GetAllRules() (rules []engine.Rule) {
+	for _, rule := range allRulesHCLBlock() {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+// GetStructureUnits parses the files of project, the files with invalid syntax are ignored because terraform
+// would also not accept them
+func (r *Rules) GetStructureUnits(projectPath string) (units []engine.Unit, err error) {
+	unit := structure.Unit{}
+	err = filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || r.isToSkip(info) {
+			return r.getWalkResult(info, err)
+		}
+
+		file, err := r.parseFile(path)
+		if err != nil {
+			logger.LogWarnWithLevel("{HORUSEC_CLI} Ignoring file in HCL analysis because its syntax is invalid: ",
+				err.Error())
+			return nil
+		}
+
+		if unit.Files = append(unit.Files, file); len(unit.Files) == maxFilesPerUnit {
+			units = append(units, unit)
+			unit = structure.Unit{}
+		}
+		return nil
+	})
+
+	return r.appendUnitWithFiles(units, unit), err
+}
+
+func (r *Rules) GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error) {
+	textUnits, err := text.LoadDirIntoMultiUnit(projectPath, maxFilesPerUnit, r.getExtensions())
+	if err != nil {
+		return []engine.Unit{}, err
+	}
+
+	return r.parseTextUnitsToUnits(textUnits), nil
+}
+
+func (r *Rules) isToSkip(info os.FileInfo) bool {
+	return info.IsDir() || filepath.Ext(info.Name()) != r.getExtensions()[0]
+}
+
+func (r *Rules) getWalkResult(info os.FileInfo, err error) error {
+	if err == nil && info.IsDir() && info.Name() == terraformFolder {
+		return filepath.SkipDir
+	}
+
+	return err
+}
+
+func (r *Rules) parseFile(path string) (*structure.File, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return structure.NewFile(path, content)
+}
+
+func (r *Rules) appendUnitWithFiles(units []engine.Unit, unit structure.Unit) []engine.Unit {
+	if len(unit.Files) > 0 {
+		return append(units, unit)
+	}
+
+	return units
+}
+
+func (r *Rules) parseTextUnitsToUnits(textUnits []text.TextUnit) (units []engine.Unit) {
+	for index := range textUnits {
+		units = append(units, textUnits[index])
+	}
+
+	return units
+}
+
+func (r *Rules) getExtensions() []string {
+	return []string{".tf"}
+}
+
+func allRulesHCLBlock() []structure.Rule {
+	return []structure.Rule{
+		block.NewHCLBlockS3BucketPublicACL(),
+		block.NewHCLBlockS3PublicAccessBlockDisabled(),
+		block.NewHCLBlockAWSSecurityGroupOpenToInternet(),
+		block.NewHCLBlockGoogleFirewallOpenToInternet(),
+		block.NewHCLBlockAzureNetworkSecurityRuleOpenToInternet(),
+		block.NewHCLBlockStorageNotEncrypted(),
+		block.NewHCLBlockInstanceBlockDeviceNotEncrypted(),
+		block.NewHCLBlockIAMPolicyWildcardAction(),
+		block.NewHCLBlockIAMPolicyDocumentWildcardAction(),
+		block.NewHCLBlockProviderHardcodedCredentials(),
+	}
+}
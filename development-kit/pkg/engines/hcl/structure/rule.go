@@ -0,0 +1,52 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structure
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/syntax"
+)
+
+// Rule is checked in all top level blocks of the type, like resource, data and provider. When labels are informed
+// only the blocks with one of them as first label are checked, like resource "aws_s3_bucket"
+type Rule struct {
+	engine.Metadata
+	BlockType string
+	Labels    []string
+	// Check returns the lines of the vulnerable code in the block
+	Check func(block *syntax.Block) []int
+}
+
+func (rule Rule) IsFor(unitType engine.UnitType) bool {
+	return engine.StructuredDataUnit == unitType
+}
+
+func (rule Rule) isForBlock(block *syntax.Block) bool {
+	if block.Type != rule.BlockType {
+		return false
+	}
+
+	if len(rule.Labels) == 0 {
+		return true
+	}
+
+	for _, label := range rule.Labels {
+		if label == block.GetLabel(0) {
+			return true
+		}
+	}
+
+	return false
+}
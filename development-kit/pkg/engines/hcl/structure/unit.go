@@ -0,0 +1,102 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structure
+
+import (
+	"fmt"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/syntax"
+)
+
+// File is a parsed HCL file, the name is used in the findings like the display name of text files
+type File struct {
+	Name  string
+	Lines []string
+	Body  *syntax.Body
+}
+
+type Unit struct {
+	Files []*File
+}
+
+func NewFile(name string, content []byte) (*File, error) {
+	body, err := syntax.Parse(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return &File{Name: name, Lines: strings.Split(string(content), "\n"), Body: body}, nil
+}
+
+func (unit Unit) Type() engine.UnitType {
+	return engine.StructuredDataUnit
+}
+
+func (unit Unit) Eval(rule engine.Rule) (findings []engine.Finding) {
+	structureRule, ok := rule.(Rule)
+	if !ok {
+		return findings
+	}
+
+	for _, file := range unit.Files {
+		findings = append(findings, file.eval(structureRule)...)
+	}
+
+	return findings
+}
+
+func (f *File) eval(rule Rule) (findings []engine.Finding) {
+	for _, block := range f.Body.Blocks {
+		if !rule.isForBlock(block) {
+			continue
+		}
+
+		for _, line := range rule.Check(block) {
+			findings = append(findings, f.newFinding(rule, line))
+		}
+	}
+
+	return findings
+}
+
+func (f *File) newFinding(rule Rule, line int) engine.Finding {
+	codeSample, column := f.getCodeSampleAndColumn(line)
+
+	return engine.Finding{
+		ID:          rule.ID,
+		Name:        rule.Name,
+		Severity:    rule.Severity,
+		Confidence:  rule.Confidence,
+		Description: rule.Description,
+		CodeSample:  codeSample,
+		SourceLocation: engine.Location{
+			Filename: f.Name,
+			Line:     line,
+			Column:   column,
+		},
+	}
+}
+
+func (f *File) getCodeSampleAndColumn(line int) (string, int) {
+	if line < 1 || line > len(f.Lines) {
+		return "", 0
+	}
+
+	content := strings.TrimRight(f.Lines[line-1], "\r")
+	codeSample := strings.TrimSpace(content)
+	return codeSample, strings.Index(content, codeSample)
+}
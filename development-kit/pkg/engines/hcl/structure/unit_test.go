@@ -0,0 +1,98 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structure
+
+import (
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/syntax"
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleContent = `resource "aws_ebs_volume" "first" {
+  encrypted = false
+}
+
+resource "aws_ebs_volume" "second" {
+  encrypted = true
+}
+
+resource "aws_efs_file_system" "third" {
+  encrypted = false
+}
+`
+
+func newEncryptedRule(labels ...string) Rule {
+	return Rule{
+		Metadata:  engine.Metadata{ID: "test", Name: "Encrypted false", Severity: "HIGH", Confidence: "LOW"},
+		BlockType: "resource",
+		Labels:    labels,
+		Check: func(block *syntax.Block) []int {
+			if attribute := block.Body.GetAttribute("encrypted"); attribute != nil && attribute.Value.IsFalse() {
+				return []int{attribute.Line}
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestUnit(t *testing.T) {
+	t.Run("should return findings of blocks with the labels of the rule", func(t *testing.T) {
+		file, err := NewFile("main.tf", []byte(exampleContent))
+		assert.NoError(t, err)
+
+		findings := Unit{Files: []*File{file}}.Eval(newEncryptedRule("aws_ebs_volume"))
+
+		assert.Len(t, findings, 1)
+		assert.Equal(t, "test", findings[0].ID)
+		assert.Equal(t, "HIGH", findings[0].Severity)
+		assert.Equal(t, "encrypted = false", findings[0].CodeSample)
+		assert.Equal(t, "main.tf", findings[0].SourceLocation.Filename)
+		assert.Equal(t, 2, findings[0].SourceLocation.Line)
+		assert.Equal(t, 2, findings[0].SourceLocation.Column)
+	})
+
+	t.Run("should return findings of all blocks of type when rule has no labels", func(t *testing.T) {
+		file, err := NewFile("main.tf", []byte(exampleContent))
+		assert.NoError(t, err)
+
+		findings := Unit{Files: []*File{file}}.Eval(newEncryptedRule())
+
+		assert.Len(t, findings, 2)
+	})
+
+	t.Run("should ignore rules which are not structure rules", func(t *testing.T) {
+		file, err := NewFile("main.tf", []byte(exampleContent))
+		assert.NoError(t, err)
+
+		assert.Empty(t, Unit{Files: []*File{file}}.Eval(text.TextRule{}))
+	})
+
+	t.Run("should return error with file name when content is invalid", func(t *testing.T) {
+		_, err := NewFile("main.tf", []byte("resource {"))
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "main.tf: line 1")
+	})
+
+	t.Run("should be structured data unit and rule", func(t *testing.T) {
+		assert.Equal(t, engine.StructuredDataUnit, Unit{}.Type())
+		assert.True(t, Rule{}.IsFor(engine.StructuredDataUnit))
+		assert.False(t, Rule{}.IsFor(engine.ProgramTextUnit))
+	})
+}
@@ -0,0 +1,551 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrUnexpectedEOF = errors.New("unexpected end of file")
+
+var unquoteReplacer = strings.NewReplacer(`\"`, `"`, `\\`, `\`, `\n`, "\n", `\t`, "\t", `\r`, "\r")
+
+// parser reads the structure of bodies, blocks and attributes of HCL native syntax, the expressions are kept as
+// raw text and only strings, tuples, objects and function calls are split in its parts
+type parser struct {
+	src        string
+	pos        int
+	lineStarts []int
+}
+
+// Parse returns the body of a HCL file, like terraform .tf files
+func Parse(content []byte) (*Body, error) {
+	p := newParser(string(content))
+
+	return p.parseBody(false)
+}
+
+func newParser(src string) *parser {
+	lineStarts := []int{0}
+	for index := range src {
+		if src[index] == '\n' {
+			lineStarts = append(lineStarts, index+1)
+		}
+	}
+
+	return &parser{src: src, lineStarts: lineStarts}
+}
+
+func (p *parser) parseBody(isNested bool) (*Body, error) {
+	body := &Body{}
+	for {
+		p.skipSpacesAndComments(true)
+		if p.isEOF() {
+			if isNested {
+				return nil, p.errorf(ErrUnexpectedEOF)
+			}
+			return body, nil
+		}
+
+		if p.peek(0) == '}' {
+			if !isNested {
+				return nil, p.errorf(fmt.Errorf("unexpected character %q", '}'))
+			}
+			p.pos++
+			return body, nil
+		}
+
+		if err := p.parseBodyItem(body); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) parseBodyItem(body *Body) error {
+	start := p.pos
+	name := p.readIdentifier()
+	if name == "" {
+		return p.errorf(fmt.Errorf("unexpected character %q", p.peek(0)))
+	}
+
+	p.skipSpacesAndComments(false)
+	if p.peek(0) == '=' && p.peek(1) != '=' {
+		p.pos++
+		attribute, err := p.parseAttributeValue(name, start)
+		if err != nil {
+			return err
+		}
+		body.Attributes = append(body.Attributes, attribute)
+		return p.expectEndOfItem()
+	}
+
+	block, err := p.parseBlock(name, start)
+	if err != nil {
+		return err
+	}
+	body.Blocks = append(body.Blocks, block)
+	return nil
+}
+
+func (p *parser) parseAttributeValue(name string, start int) (*Attribute, error) {
+	p.skipSpacesAndComments(false)
+	valueStart := p.pos
+	valueEnd, err := p.scanExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	if valueStart == valueEnd {
+		return nil, p.errorf(fmt.Errorf("missing value of attribute %s", name))
+	}
+
+	return &Attribute{Name: name, Line: p.lineOf(start), Value: p.newExpression(valueStart, valueEnd)}, nil
+}
+
+func (p *parser) expectEndOfItem() error {
+	p.skipSpacesAndComments(false)
+	switch {
+	case p.isEOF() || p.peek(0) == '}':
+		return nil
+	case p.peek(0) == '\n':
+		p.pos++
+		return nil
+	default:
+		return p.errorf(fmt.Errorf("unexpected character %q after attribute", p.peek(0)))
+	}
+}
+
+func (p *parser) parseBlock(blockType string, start int) (*Block, error) {
+	block := &Block{Type: blockType, Line: p.lineOf(start)}
+	for {
+		p.skipSpacesAndComments(false)
+		switch {
+		case p.isEOF():
+			return nil, p.errorf(ErrUnexpectedEOF)
+		case p.peek(0) == '{':
+			p.pos++
+			body, err := p.parseBody(true)
+			block.Body = body
+			return block, err
+		case p.peek(0) == '"':
+			labelStart := p.pos
+			if err := p.skipQuoted(); err != nil {
+				return nil, err
+			}
+			block.Labels = append(block.Labels, unquote(p.src[labelStart+1:p.pos-1]))
+		case isIdentifierStart(p.peek(0)):
+			block.Labels = append(block.Labels, p.readIdentifier())
+		default:
+			return nil, p.errorf(fmt.Errorf("unexpected character %q in block %s", p.peek(0), blockType))
+		}
+	}
+}
+
+// scanExpression moves to the end of the expression that starts in the current position, which is a new line,
+// a comma or a closing bracket out of any string, heredoc or brackets of the expression
+// nolint:funlen,gocyclo is necessary to check all characters in the same loop
+func (p *parser) scanExpression() (int, error) {
+	depth := 0
+	for !p.isEOF() {
+		current := p.peek(0)
+		switch {
+		case current == '"':
+			if err := p.skipQuoted(); err != nil {
+				return 0, err
+			}
+			continue
+		case p.isHeredocStart():
+			if err := p.skipHeredoc(); err != nil {
+				return 0, err
+			}
+			continue
+		case p.isCommentStart():
+			if depth == 0 {
+				return p.trimEnd(p.pos), nil
+			}
+			p.skipSpacesAndComments(false)
+			continue
+		case strings.ContainsRune("([{", rune(current)):
+			depth++
+		case strings.ContainsRune(")]}", rune(current)):
+			if depth == 0 {
+				return p.trimEnd(p.pos), nil
+			}
+			depth--
+		case (current == '\n' || current == ',') && depth == 0:
+			return p.trimEnd(p.pos), nil
+		}
+		p.pos++
+	}
+
+	if depth > 0 {
+		return 0, p.errorf(ErrUnexpectedEOF)
+	}
+
+	return p.trimEnd(p.pos), nil
+}
+
+func (p *parser) newExpression(start, end int) *Expression {
+	expression := &Expression{Kind: Other, Raw: p.src[start:end], Line: p.lineOf(start)}
+	sub := &parser{src: p.src[:end], pos: start, lineStarts: p.lineStarts}
+
+	var ok bool
+	switch {
+	case p.src[start] == '"':
+		ok = sub.parseQuotedExpression(expression)
+	case sub.isHeredocStart():
+		ok = sub.parseHeredocExpression(expression)
+	case p.src[start] == '[':
+		sub.pos++
+		ok = sub.parseItems(expression, ']') && sub.isEOF()
+		expression.Kind = Tuple
+	case p.src[start] == '{':
+		ok = sub.parseObjectExpression(expression)
+	case isIdentifierStart(p.src[start]):
+		ok = sub.parseFunctionCallExpression(expression)
+	}
+
+	if !ok {
+		return &Expression{Kind: Other, Raw: expression.Raw, Line: expression.Line}
+	}
+
+	return expression
+}
+
+func (p *parser) parseQuotedExpression(expression *Expression) bool {
+	if err := p.skipQuoted(); err != nil || !p.isEOF() {
+		return false
+	}
+
+	expression.Kind = String
+	expression.Value = unquote(expression.Raw[1 : len(expression.Raw)-1])
+	return true
+}
+
+func (p *parser) parseHeredocExpression(expression *Expression) bool {
+	start := p.pos
+	if err := p.skipHeredoc(); err != nil || !p.isEOF() {
+		return false
+	}
+
+	lines := strings.Split(p.src[start:p.pos], "\n")
+	expression.Kind = String
+	expression.Value = strings.Join(lines[1:len(lines)-1], "\n")
+	return true
+}
+
+func (p *parser) parseFunctionCallExpression(expression *Expression) bool {
+	name := p.readIdentifier()
+	for p.peek(0) == ':' && p.peek(1) == ':' {
+		p.pos += 2
+		name += "::" + p.readIdentifier()
+	}
+
+	if p.peek(0) != '(' {
+		return false
+	}
+
+	p.pos++
+	expression.Kind = FunctionCall
+	expression.Value = name
+	return p.parseItems(expression, ')') && p.isEOF()
+}
+
+// parseItems reads the items of tuples and arguments of function calls until the closing character
+func (p *parser) parseItems(expression *Expression, closing byte) bool {
+	for {
+		p.skipSpacesAndComments(true)
+		if p.isEOF() {
+			return false
+		}
+
+		if p.peek(0) == closing {
+			p.pos++
+			return true
+		}
+
+		item, ok := p.parseItem()
+		if !ok {
+			return false
+		}
+
+		expression.Items = append(expression.Items, item)
+	}
+}
+
+func (p *parser) parseItem() (*Expression, bool) {
+	start := p.pos
+	end, err := p.scanExpression()
+	if err != nil || start == end {
+		return nil, false
+	}
+
+	item := p.newExpression(start, end)
+	p.skipSpacesAndComments(true)
+	if p.peek(0) == ',' {
+		p.pos++
+	}
+
+	return item, true
+}
+
+func (p *parser) parseObjectExpression(expression *Expression) bool {
+	p.pos++
+	expression.Kind = Object
+	for {
+		p.skipSpacesAndComments(true)
+		if p.isEOF() {
+			return false
+		}
+
+		if p.peek(0) == '}' {
+			p.pos++
+			return p.isEOF()
+		}
+
+		attribute, ok := p.parseObjectAttribute()
+		if !ok {
+			return false
+		}
+
+		expression.Attributes = append(expression.Attributes, attribute)
+	}
+}
+
+func (p *parser) parseObjectAttribute() (*Attribute, bool) {
+	start := p.pos
+	name, ok := p.readObjectKey()
+	if !ok {
+		return nil, false
+	}
+
+	p.skipSpacesAndComments(false)
+	if p.peek(0) != '=' && p.peek(0) != ':' {
+		return nil, false
+	}
+
+	p.pos++
+	p.skipSpacesAndComments(false)
+	value, ok := p.parseItem()
+	if !ok {
+		return nil, false
+	}
+
+	return &Attribute{Name: name, Line: p.lineOf(start), Value: value}, true
+}
+
+func (p *parser) readObjectKey() (string, bool) {
+	start := p.pos
+	switch {
+	case p.peek(0) == '"':
+		if err := p.skipQuoted(); err != nil {
+			return "", false
+		}
+		return unquote(p.src[start+1 : p.pos-1]), true
+	case isIdentifierStart(p.peek(0)):
+		return p.readIdentifier(), true
+	case p.peek(0) == '(':
+		p.pos++
+		end, err := p.scanExpression()
+		if err != nil || p.peek(0) != ')' {
+			return "", false
+		}
+		p.pos++
+		return p.src[start+1 : end], true
+	default:
+		return "", false
+	}
+}
+
+// skipQuoted moves to after the closing quote of the string that starts in the current position, including the
+// quotes used inside of interpolations
+func (p *parser) skipQuoted() error {
+	p.pos++
+	for !p.isEOF() {
+		switch current := p.peek(0); {
+		case current == '\\':
+			p.pos += 2
+		case current == '"':
+			p.pos++
+			return nil
+		case current == '\n':
+			return p.errorf(errors.New("unterminated string"))
+		case (current == '$' || current == '%') && p.peek(1) == '{':
+			p.pos += 2
+			if err := p.skipTemplateInterpolation(); err != nil {
+				return err
+			}
+		default:
+			p.pos++
+		}
+	}
+
+	return p.errorf(ErrUnexpectedEOF)
+}
+
+func (p *parser) skipTemplateInterpolation() error {
+	depth := 1
+	for !p.isEOF() {
+		switch p.peek(0) {
+		case '"':
+			if err := p.skipQuoted(); err != nil {
+				return err
+			}
+			continue
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				p.pos++
+				return nil
+			}
+		}
+		p.pos++
+	}
+
+	return p.errorf(ErrUnexpectedEOF)
+}
+
+func (p *parser) isHeredocStart() bool {
+	if p.peek(0) != '<' || p.peek(1) != '<' {
+		return false
+	}
+
+	markerStart := p.pos + 2
+	if markerStart < len(p.src) && p.src[markerStart] == '-' {
+		markerStart++
+	}
+
+	return markerStart < len(p.src) && isIdentifierStart(p.src[markerStart])
+}
+
+// skipHeredoc moves to the end of the line with the closing marker of the heredoc in the current position
+func (p *parser) skipHeredoc() error {
+	lineEnd := strings.IndexByte(p.src[p.pos:], '\n')
+	if lineEnd < 0 {
+		return p.errorf(ErrUnexpectedEOF)
+	}
+
+	marker := strings.TrimSpace(strings.TrimLeft(p.src[p.pos:p.pos+lineEnd], "<-"))
+	p.pos += lineEnd + 1
+	for !p.isEOF() {
+		lineEnd = strings.IndexByte(p.src[p.pos:], '\n')
+		if lineEnd < 0 {
+			lineEnd = len(p.src) - p.pos
+		}
+
+		line := p.src[p.pos : p.pos+lineEnd]
+		p.pos += lineEnd
+		if strings.TrimSpace(line) == marker {
+			return nil
+		}
+
+		p.pos++
+	}
+
+	return p.errorf(fmt.Errorf("missing heredoc marker %s", marker))
+}
+
+func (p *parser) skipSpacesAndComments(skipNewLines bool) {
+	for !p.isEOF() {
+		current := p.peek(0)
+		switch {
+		case current == ' ' || current == '\t' || current == '\r' || (current == '\n' && skipNewLines):
+			p.pos++
+		case current == '#' || (current == '/' && p.peek(1) == '/'):
+			p.skipUntil("\n", false)
+		case current == '/' && p.peek(1) == '*':
+			p.skipUntil("*/", true)
+		default:
+			return
+		}
+	}
+}
+
+func (p *parser) skipUntil(value string, isToSkipValue bool) {
+	index := strings.Index(p.src[p.pos:], value)
+	if index < 0 {
+		p.pos = len(p.src)
+		return
+	}
+
+	p.pos += index
+	if isToSkipValue {
+		p.pos += len(value)
+	}
+}
+
+func (p *parser) isCommentStart() bool {
+	return p.peek(0) == '#' || (p.peek(0) == '/' && (p.peek(1) == '/' || p.peek(1) == '*'))
+}
+
+func (p *parser) readIdentifier() string {
+	start := p.pos
+	if p.isEOF() || !isIdentifierStart(p.peek(0)) {
+		return ""
+	}
+
+	for !p.isEOF() && isIdentifierPart(p.peek(0)) {
+		p.pos++
+	}
+
+	return p.src[start:p.pos]
+}
+
+func (p *parser) trimEnd(end int) int {
+	for end > 0 && strings.ContainsRune(" \t\r", rune(p.src[end-1])) {
+		end--
+	}
+
+	return end
+}
+
+func (p *parser) peek(offset int) byte {
+	if p.pos+offset >= len(p.src) {
+		return 0
+	}
+
+	return p.src[p.pos+offset]
+}
+
+func (p *parser) isEOF() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *parser) lineOf(position int) int {
+	return sort.Search(len(p.lineStarts), func(index int) bool {
+		return p.lineStarts[index] > position
+	})
+}
+
+func (p *parser) errorf(err error) error {
+	return fmt.Errorf("line %d: %w", p.lineOf(p.pos), err)
+}
+
+func isIdentifierStart(value byte) bool {
+	return value == '_' || (value >= 'a' && value <= 'z') || (value >= 'A' && value <= 'Z')
+}
+
+func isIdentifierPart(value byte) bool {
+	return isIdentifierStart(value) || value == '-' || (value >= '0' && value <= '9')
+}
+
+func unquote(value string) string {
+	return unquoteReplacer.Replace(value)
+}
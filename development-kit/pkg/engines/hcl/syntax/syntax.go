@@ -0,0 +1,137 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syntax
+
+import "strings"
+
+type ExpressionKind int
+
+const (
+	// Other are the expressions that are not statically known, like references, numbers, booleans and operations
+	Other ExpressionKind = iota
+	// String are quoted and heredoc templates, the interpolations are kept as they are
+	String
+	Tuple
+	Object
+	FunctionCall
+)
+
+// Body is the content of a file or of a block
+type Body struct {
+	Attributes []*Attribute
+	Blocks     []*Block
+}
+
+// Block is a structure like resource "aws_s3_bucket" "example" { ... }
+type Block struct {
+	Type   string
+	Labels []string
+	Line   int
+	Body   *Body
+}
+
+// Attribute is an assignment of a value to a name, in a body or in an object
+type Attribute struct {
+	Name  string
+	Line  int
+	Value *Expression
+}
+
+type Expression struct {
+	Kind ExpressionKind
+	Raw  string
+	Line int
+	// Value is the content of strings without quotes and the name of function calls
+	Value string
+	// Items are the elements of tuples and the arguments of function calls
+	Items []*Expression
+	// Attributes are the items of objects
+	Attributes []*Attribute
+}
+
+func (b *Body) GetAttribute(name string) *Attribute {
+	return getAttribute(b.Attributes, name)
+}
+
+func (b *Body) GetBlocksByType(blockType string) (blocks []*Block) {
+	for _, block := range b.Blocks {
+		if block.Type == blockType {
+			blocks = append(blocks, block)
+		}
+	}
+
+	return blocks
+}
+
+// GetLabel returns the label of the block in the position or empty when it not exists
+func (b *Block) GetLabel(index int) string {
+	if index < len(b.Labels) {
+		return b.Labels[index]
+	}
+
+	return ""
+}
+
+func (e *Expression) GetAttribute(name string) *Attribute {
+	return getAttribute(e.Attributes, name)
+}
+
+// IsLiteralString returns true for strings without interpolations, which value is the same in all executions
+func (e *Expression) IsLiteralString() bool {
+	return e.Kind == String && !strings.Contains(e.Value, "${")
+}
+
+// GetStrings returns the value of a string or the strings inside of a tuple
+func (e *Expression) GetStrings() (values []string) {
+	if e.Kind == String {
+		return []string{e.Value}
+	}
+
+	for _, item := range e.Items {
+		if e.Kind == Tuple && item.Kind == String {
+			values = append(values, item.Value)
+		}
+	}
+
+	return values
+}
+
+func (e *Expression) ContainsString(value string) bool {
+	for _, item := range e.GetStrings() {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (e *Expression) IsTrue() bool {
+	return e.Kind == Other && e.Raw == "true" || e.Kind == String && e.Value == "true"
+}
+
+func (e *Expression) IsFalse() bool {
+	return e.Kind == Other && e.Raw == "false" || e.Kind == String && e.Value == "false"
+}
+
+func getAttribute(attributes []*Attribute, name string) *Attribute {
+	for _, attribute := range attributes {
+		if attribute.Name == name {
+			return attribute
+		}
+	}
+
+	return nil
+}
@@ -12,4 +12,65 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//nolint:lll multiple regex is not possible broken lines
 package and
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewKotlinAndInsecureSharedPreferences() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "670beff1-b755-4edf-ad6d-563c6872cad9",
+			Name:        "Sensitive data stored in SharedPreferences without encryption",
+			Description: "Sensitive information such as passwords, tokens or keys is stored in plain SharedPreferences, which are saved unencrypted on the device. Use EncryptedSharedPreferences or the Android Keystore instead. For more information checkout the CWE-312 (https://cwe.mitre.org/data/definitions/312.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(getSharedPreferences|getDefaultSharedPreferences|getPreferences)\(`),
+			regexp.MustCompile(`\.put(String|Int|Long|Boolean|StringSet)\(\s*"(?i:[^"]*(password|passwd|token|secret|pin|key|session|credential)[^"]*)"`),
+		},
+	}
+}
+
+func NewKotlinAndExportedBroadcastReceiver() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "0f0ac269-bac3-4bdc-99d7-31d1284b272d",
+			Name:        "Broadcast receiver registered as exported",
+			Description: "A broadcast receiver is registered at runtime as exported, so any application on the device can send intents to it. Use RECEIVER_NOT_EXPORTED or protect it with a permission. For more information checkout the CWE-926 (https://cwe.mitre.org/data/definitions/926.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`registerReceiver\(`),
+			regexp.MustCompile(`\bRECEIVER_EXPORTED\b`),
+		},
+	}
+}
+
+func NewKotlinAndWebViewJavaScriptInterface() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "037dd0d7-4bfa-464f-b958-288ecba245f6",
+			Name:        "WebView JavaScript interface with JavaScript enabled",
+			Description: "A JavaScript interface is added to a WebView with JavaScript enabled. Any page loaded in the WebView can call the exposed methods, which may lead to code execution or data leaks if untrusted content is loaded. For more information checkout the CWE-749 (https://cwe.mitre.org/data/definitions/749.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`addJavascriptInterface\(`),
+			regexp.MustCompile(`javaScriptEnabled\s*=\s*true|setJavaScriptEnabled\(\s*true\s*\)`),
+		},
+	}
+}
@@ -12,4 +12,48 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//nolint:lll multiple regex is not possible broken lines
 package or
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewKotlinOrMutablePendingIntent() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "4aeacd12-6153-4ecb-808e-207cf9c3f26a",
+			Name:        "Mutable PendingIntent",
+			Description: "A PendingIntent is created mutable, so the application that receives it can change the wrapped intent and act with the identity of this application. Use PendingIntent.FLAG_IMMUTABLE unless mutability is required. For more information checkout the CWE-927 (https://cwe.mitre.org/data/definitions/927.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`PendingIntent\.FLAG_MUTABLE`),
+			regexp.MustCompile(`PendingIntent\.get(Activity|Service|Broadcast|ForegroundService)\([^)]*,\s*0\s*\)`),
+		},
+	}
+}
+
+func NewKotlinOrKtorDevelopmentMode() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "8d77f1aa-10ad-466a-bc09-1de314e4cec1",
+			Name:        "Ktor development mode enabled",
+			Description: "Ktor development mode enables auto reload and detailed error pages that expose internal information of the application. Never enable it in production. For more information checkout the CWE-489 (https://cwe.mitre.org/data/definitions/489.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`developmentMode\s*=\s*true`),
+			regexp.MustCompile(`"-Dio\.ktor\.development=true"`),
+		},
+	}
+}
@@ -12,4 +12,214 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//nolint:lll multiple regex is not possible broken lines
 package regular
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewKotlinRegularWebViewJavaScriptEnabled() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "f2ddb49d-d72e-4f35-b397-eb25911a15bd",
+			Name:        "WebView with JavaScript enabled",
+			Description: "Enabling JavaScript in a WebView allows scripts of the loaded page to run inside the application. If the WebView loads untrusted content it is exposed to Cross-Site Scripting (XSS). Only enable JavaScript when the content is fully trusted. For more information checkout the CWE-79 (https://cwe.mitre.org/data/definitions/79.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`javaScriptEnabled\s*=\s*true`),
+			regexp.MustCompile(`setJavaScriptEnabled\(\s*true\s*\)`),
+		},
+	}
+}
+
+func NewKotlinRegularWebViewFileAccessEnabled() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "f9aaa7cb-ff70-40ec-a5f5-6c4b995b9aca",
+			Name:        "WebView with file access from file URLs enabled",
+			Description: "Allowing a WebView to access files from file URLs lets a malicious page read local files of the application, including its private data. Keep allowFileAccessFromFileURLs and allowUniversalAccessFromFileURLs disabled. For more information checkout the CWE-200 (https://cwe.mitre.org/data/definitions/200.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`allow(File|Universal)AccessFromFileURLs\s*=\s*true`),
+			regexp.MustCompile(`setAllow(File|Universal)AccessFromFileURLs\(\s*true\s*\)`),
+		},
+	}
+}
+
+func NewKotlinRegularWorldReadableOrWritableMode() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "aca57fed-2086-48e3-a22f-91c4b6101b5c",
+			Name:        "File or preferences created world readable or writable",
+			Description: "MODE_WORLD_READABLE and MODE_WORLD_WRITEABLE allow any application installed on the device to read or modify the file. Use MODE_PRIVATE and share data through a ContentProvider when needed. For more information checkout the CWE-276 (https://cwe.mitre.org/data/definitions/276.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`MODE_WORLD_(READABLE|WRITEABLE)`),
+		},
+	}
+}
+
+func NewKotlinRegularRuntimeExec() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "89e40c3b-9343-40b8-8f95-5e55fcf54921",
+			Name:        "Execution of OS commands",
+			Description: "The application executes OS commands with Runtime.exec. If any part of the command comes from external input an attacker may be able to execute arbitrary commands. Avoid building commands with string templates or concatenation. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Low.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`Runtime\.getRuntime\(\)\.exec\(`),
+		},
+	}
+}
+
+func NewKotlinRegularWeakCipher() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "5da67d4b-40fd-4600-9a56-654a00a6ba34",
+			Name:        "Weak cipher algorithm",
+			Description: "DES, Triple DES, RC2, RC4 and Blowfish are considered weak, and AES without an explicit mode defaults to ECB. Use AES/GCM/NoPadding instead. For more information checkout the CWE-327 (https://cwe.mitre.org/data/definitions/327.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`Cipher\.getInstance\(\s*"(DES|DESede|TripleDES|RC2|RC4|ARCFOUR|Blowfish)(/|")`),
+			regexp.MustCompile(`Cipher\.getInstance\(\s*"AES"\s*\)`),
+		},
+	}
+}
+
+func NewKotlinRegularSQLInjectionWithStringTemplate() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "dba4b330-9191-48fd-a90c-074292028fcf",
+			Name:        "SQL query built with string template",
+			Description: "A SQL query is built with a Kotlin string template or concatenation. If any part of the query comes from external input the application is vulnerable to SQL Injection. Use selection arguments or bind parameters instead. For more information checkout the CWE-89 (https://cwe.mitre.org/data/definitions/89.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\.(rawQuery|execSQL|createQuery|createNativeQuery|queryForObject|queryForList|queryForMap)\(\s*"[^"]*\$\{?\w`),
+			regexp.MustCompile(`\.(rawQuery|execSQL|createQuery|createNativeQuery|queryForObject|queryForList|queryForMap)\(\s*"[^"]*"\s*\+`),
+		},
+	}
+}
+
+func NewKotlinRegularKtorCORSAnyHost() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "047a584f-29f5-4f51-adf1-afd5a6128b66",
+			Name:        "Ktor CORS allows any host",
+			Description: "The CORS plugin is configured with anyHost(), allowing any web site to make cross origin requests to the application. Declare the allowed hosts explicitly with host(). For more information checkout the CWE-942 (https://cwe.mitre.org/data/definitions/942.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\banyHost\(\)`),
+		},
+	}
+}
+
+func NewKotlinRegularSpringCSRFDisabled() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "3fc57cbc-2908-4885-a63b-8b22c652724f",
+			Name:        "Spring Security CSRF protection disabled",
+			Description: "The CSRF protection of Spring Security is disabled. Applications that use session cookies are then vulnerable to Cross-Site Request Forgery. Only disable it for stateless APIs that do not rely on cookies. For more information checkout the CWE-352 (https://cwe.mitre.org/data/definitions/352.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`csrf\s*\{\s*disable\(\)\s*\}`),
+			regexp.MustCompile(`\.csrf\(\)\s*\.disable\(\)`),
+			regexp.MustCompile(`\.csrf\s*\{\s*it\.disable\(\)\s*\}`),
+		},
+	}
+}
+
+func NewKotlinRegularSpringPermitAllRequests() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "b84566b7-1d21-4db5-832a-c612aaee8952",
+			Name:        "Spring Security permits any request",
+			Description: "The security configuration permits any request without authentication. Make sure that no endpoint that handles sensitive data or actions is left open. For more information checkout the CWE-306 (https://cwe.mitre.org/data/definitions/306.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`authorize\(\s*anyRequest\s*,\s*permitAll\s*\)`),
+			regexp.MustCompile(`\.anyRequest\(\)\s*\.permitAll\(\)`),
+		},
+	}
+}
+
+func NewKotlinRegularTrustManagerAcceptAnyCertificate() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "71413c77-cef6-4304-a361-d4ab2ba31bd9",
+			Name:        "TrustManager that accepts any certificate",
+			Description: "A X509TrustManager with an empty checkServerTrusted or checkClientTrusted accepts any certificate. This application is vulnerable to MITM attacks. Use the default trust manager or certificate pinning. For more information checkout the CWE-295 (https://cwe.mitre.org/data/definitions/295.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`fun\s+check(Server|Client)Trusted\([^)]*\)\s*(\{\s*\}|=\s*Unit)`),
+		},
+	}
+}
+
+func NewKotlinRegularHostnameVerifierAlwaysTrue() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "e247bd3d-420e-4633-9995-43dd995f5253",
+			Name:        "HostnameVerifier that accepts any host",
+			Description: "A HostnameVerifier that always returns true disables the verification of the server host name. This application is vulnerable to MITM attacks. For more information checkout the CWE-297 (https://cwe.mitre.org/data/definitions/297.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)hostnameVerifier\s*(\(\s*)?\{\s*\w+\s*,\s*\w+\s*->\s*true\s*\}`),
+			regexp.MustCompile(`fun\s+verify\([^)]*SSLSession[^)]*\)\s*(:\s*Boolean\s*)?(=\s*true|\{\s*return\s+true\s*\})`),
+		},
+	}
+}
+
+func NewKotlinRegularAndroidExportedComponent() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "a3b6f1d2-5c4e-4f7a-9b8d-2e1c0f6a7b93",
+			Name:        "Android component exported",
+			Description: "A component declared in AndroidManifest.xml with android:exported=\"true\" can be started or bound by any other application installed on the device. Protect the component with an android:permission or set android:exported=\"false\" when it is not meant to be used by other applications. For more information checkout the CWE-926 (https://cwe.mitre.org/data/definitions/926.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Low.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`android:exported\s*=\s*"true"`),
+		},
+	}
+}
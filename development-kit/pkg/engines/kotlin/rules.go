@@ -12,14 +12,23 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+//nolint:lll multiple regex is not possible broken lines
 package kotlin
 
 import (
+	"os"
+	"path/filepath"
+
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/jvm"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kotlin/and"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kotlin/or"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kotlin/regular"
 )
 
+const androidManifestFileName = "AndroidManifest.xml"
+
 type Interface interface {
 	GetAllRules() (rules []engine.Rule)
 	GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error)
@@ -36,16 +45,63 @@ func NewRules() Interface {
 }
 
 func (r *Rules) GetAllRules() (rules []engine.Rule) {
+	rules = r.addKotlinRules(rules)
 	rules = r.jvmRules.GetAllRules(rules)
 	return rules
 }
 
+func (r *Rules) addKotlinRules(rules []engine.Rule) []engine.Rule {
+	for _, rule := range allRulesKotlinAnd() {
+		rules = append(rules, rule)
+	}
+
+	for _, rule := range allRulesKotlinOr() {
+		rules = append(rules, rule)
+	}
+
+	for _, rule := range allRulesKotlinRegular() {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
 func (r *Rules) GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error) {
 	textUnits, err := text.LoadDirIntoMultiUnit(projectPath, 5, r.getExtensions())
 	if err != nil {
 		return []engine.Unit{}, err
 	}
-	return r.parseTextUnitsToUnits(textUnits), nil
+
+	manifestUnits, err := r.getAndroidManifestUnits(projectPath)
+	if err != nil {
+		return []engine.Unit{}, err
+	}
+
+	return r.parseTextUnitsToUnits(append(textUnits, manifestUnits...)), nil
+}
+
+// getAndroidManifestUnits load only the android manifest files, other xml files of the project are not analyzed
+func (r *Rules) getAndroidManifestUnits(projectPath string) ([]text.TextUnit, error) {
+	unit := text.TextUnit{}
+	err := filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || info.Name() != androidManifestFileName {
+			return err
+		}
+
+		textFile, err := text.ReadAndCreateTextFile(path)
+		if err != nil {
+			return err
+		}
+
+		unit.Files = append(unit.Files, textFile)
+		return nil
+	})
+
+	if err != nil || len(unit.Files) == 0 {
+		return []text.TextUnit{}, err
+	}
+
+	return []text.TextUnit{unit}, nil
 }
 
 func (r *Rules) parseTextUnitsToUnits(textUnits []text.TextUnit) (units []engine.Unit) {
@@ -60,13 +116,33 @@ func (r *Rules) getExtensions() []string {
 }
 
 func allRulesKotlinRegular() []text.TextRule {
-	return []text.TextRule{}
+	return []text.TextRule{
+		regular.NewKotlinRegularWebViewJavaScriptEnabled(),
+		regular.NewKotlinRegularWebViewFileAccessEnabled(),
+		regular.NewKotlinRegularWorldReadableOrWritableMode(),
+		regular.NewKotlinRegularRuntimeExec(),
+		regular.NewKotlinRegularWeakCipher(),
+		regular.NewKotlinRegularSQLInjectionWithStringTemplate(),
+		regular.NewKotlinRegularKtorCORSAnyHost(),
+		regular.NewKotlinRegularSpringCSRFDisabled(),
+		regular.NewKotlinRegularSpringPermitAllRequests(),
+		regular.NewKotlinRegularTrustManagerAcceptAnyCertificate(),
+		regular.NewKotlinRegularHostnameVerifierAlwaysTrue(),
+		regular.NewKotlinRegularAndroidExportedComponent(),
+	}
 }
 
 func allRulesKotlinAnd() []text.TextRule {
-	return []text.TextRule{}
+	return []text.TextRule{
+		and.NewKotlinAndInsecureSharedPreferences(),
+		and.NewKotlinAndExportedBroadcastReceiver(),
+		and.NewKotlinAndWebViewJavaScriptInterface(),
+	}
 }
 
 func allRulesKotlinOr() []text.TextRule {
-	return []text.TextRule{}
+	return []text.TextRule{
+		or.NewKotlinOrMutablePendingIntent(),
+		or.NewKotlinOrKtorDevelopmentMode(),
+	}
 }
@@ -16,9 +16,16 @@ package kotlin
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kotlin/and"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kotlin/or"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kotlin/regular"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -42,13 +49,43 @@ func TestRules_GetAllRules(t *testing.T) {
 	})
 }
 
+func TestRules_GetTextUnitByRulesExt(t *testing.T) {
+	t.Run("should load kotlin files and android manifest", func(t *testing.T) {
+		projectPath := t.TempDir()
+		assert.NoError(t, os.MkdirAll(filepath.Join(projectPath, "app", "src", "main"), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "app", "src", "main", "Main.kt"),
+			[]byte("fun main() {}"), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "app", "src", "main", "AndroidManifest.xml"),
+			[]byte(`<activity android:name=".DeepLinkActivity" android:exported="true" />`), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "pom.xml"),
+			[]byte(`<project android:exported="true"></project>`), os.ModePerm))
+
+		units, err := NewRules().GetTextUnitByRulesExt(projectPath)
+		assert.NoError(t, err)
+
+		findings := engine.Run(units, []engine.Rule{regular.NewKotlinRegularAndroidExportedComponent()})
+		assert.Len(t, findings, 1)
+		assert.Contains(t, findings[0].SourceLocation.Filename, "AndroidManifest.xml")
+	})
+}
+
+func TestRules_KotlinAndWebViewJavaScriptInterface(t *testing.T) {
+	t.Run("should report the line of the javascript interface", func(t *testing.T) {
+		code := "webView.settings.javaScriptEnabled = true\nwebView.addJavascriptInterface(Bridge(), \"bridge\")\n"
+
+		findings := runRuleInKotlinCode(t, and.NewKotlinAndWebViewJavaScriptInterface(), code)
+		assert.Len(t, findings, 1)
+		assert.Equal(t, 2, findings[0].SourceLocation.Line)
+	})
+}
+
 func TestRulesEnum(t *testing.T) {
 	var totalRules []text.TextRule
 
 	totalRules = append(totalRules, allRulesKotlinAnd()...)
 	totalRules = append(totalRules, allRulesKotlinOr()...)
 	totalRules = append(totalRules, allRulesKotlinRegular()...)
-	lenExpectedTotalRules := 0
+	lenExpectedTotalRules := 17
 
 	t.Run("Should not exists duplicated ID in rules and return lenExpectedTotalRules in kotlin", func(t *testing.T) {
 		encountered := map[string]bool{}
@@ -67,3 +104,132 @@ func TestRulesEnum(t *testing.T) {
 		assert.Equal(t, len(encountered), lenExpectedTotalRules, "encountered in kotlin is not equal the expected")
 	})
 }
+
+func TestRules_KotlinRulesFindings(t *testing.T) {
+	testcases := []struct {
+		name       string
+		rule       text.TextRule
+		vulnerable string
+		safe       string
+	}{
+		{
+			name:       "WebView JavaScript enabled",
+			rule:       regular.NewKotlinRegularWebViewJavaScriptEnabled(),
+			vulnerable: `webView.settings.javaScriptEnabled = true`,
+			safe:       `webView.settings.javaScriptEnabled = false`,
+		},
+		{
+			name:       "WebView file access enabled",
+			rule:       regular.NewKotlinRegularWebViewFileAccessEnabled(),
+			vulnerable: `webView.settings.allowUniversalAccessFromFileURLs = true`,
+			safe:       `webView.settings.allowUniversalAccessFromFileURLs = false`,
+		},
+		{
+			name:       "World readable mode",
+			rule:       regular.NewKotlinRegularWorldReadableOrWritableMode(),
+			vulnerable: `val prefs = getSharedPreferences("settings", Context.MODE_WORLD_READABLE)`,
+			safe:       `val prefs = getSharedPreferences("settings", Context.MODE_PRIVATE)`,
+		},
+		{
+			name:       "Runtime exec",
+			rule:       regular.NewKotlinRegularRuntimeExec(),
+			vulnerable: `val process = Runtime.getRuntime().exec("ping -c 1 $host")`,
+			safe:       `val runtime = Runtime.getRuntime().availableProcessors()`,
+		},
+		{
+			name:       "Weak cipher",
+			rule:       regular.NewKotlinRegularWeakCipher(),
+			vulnerable: `val cipher = Cipher.getInstance("DES/CBC/PKCS5Padding")`,
+			safe:       `val cipher = Cipher.getInstance("AES/GCM/NoPadding")`,
+		},
+		{
+			name:       "SQL injection with string template",
+			rule:       regular.NewKotlinRegularSQLInjectionWithStringTemplate(),
+			vulnerable: `db.rawQuery("SELECT * FROM users WHERE name = '${name}'", null)`,
+			safe:       `db.rawQuery("SELECT * FROM users WHERE name = ?", arrayOf(name))`,
+		},
+		{
+			name:       "Ktor CORS any host",
+			rule:       regular.NewKotlinRegularKtorCORSAnyHost(),
+			vulnerable: "install(CORS) {\n    anyHost()\n}",
+			safe:       "install(CORS) {\n    host(\"example.com\")\n}",
+		},
+		{
+			name:       "Spring CSRF disabled",
+			rule:       regular.NewKotlinRegularSpringCSRFDisabled(),
+			vulnerable: "http {\n    csrf { disable() }\n}",
+			safe:       "http {\n    csrf { csrfTokenRepository = repository }\n}",
+		},
+		{
+			name:       "Spring permit all requests",
+			rule:       regular.NewKotlinRegularSpringPermitAllRequests(),
+			vulnerable: `authorize(anyRequest, permitAll)`,
+			safe:       `authorize(anyRequest, authenticated)`,
+		},
+		{
+			name:       "TrustManager accepting any certificate",
+			rule:       regular.NewKotlinRegularTrustManagerAcceptAnyCertificate(),
+			vulnerable: `override fun checkServerTrusted(chain: Array<X509Certificate>, authType: String) {}`,
+			safe:       `override fun checkServerTrusted(chain: Array<X509Certificate>, authType: String) { delegate.checkServerTrusted(chain, authType) }`,
+		},
+		{
+			name:       "HostnameVerifier always true",
+			rule:       regular.NewKotlinRegularHostnameVerifierAlwaysTrue(),
+			vulnerable: `builder.hostnameVerifier { _, _ -> true }`,
+			safe:       `builder.hostnameVerifier { hostname, _ -> hostname == "example.com" }`,
+		},
+		{
+			name:       "Insecure SharedPreferences",
+			rule:       and.NewKotlinAndInsecureSharedPreferences(),
+			vulnerable: "val prefs = getSharedPreferences(\"auth\", Context.MODE_PRIVATE)\nprefs.edit().putString(\"auth_token\", token).apply()",
+			safe:       "val prefs = getSharedPreferences(\"settings\", Context.MODE_PRIVATE)\nprefs.edit().putString(\"theme\", theme).apply()",
+		},
+		{
+			name:       "Exported broadcast receiver",
+			rule:       and.NewKotlinAndExportedBroadcastReceiver(),
+			vulnerable: `registerReceiver(receiver, filter, Context.RECEIVER_EXPORTED)`,
+			safe:       `registerReceiver(receiver, filter, Context.RECEIVER_NOT_EXPORTED)`,
+		},
+		{
+			name:       "WebView JavaScript interface",
+			rule:       and.NewKotlinAndWebViewJavaScriptInterface(),
+			vulnerable: "webView.settings.javaScriptEnabled = true\nwebView.addJavascriptInterface(Bridge(), \"bridge\")",
+			safe:       "webView.settings.javaScriptEnabled = false\nwebView.addJavascriptInterface(Bridge(), \"bridge\")",
+		},
+		{
+			name:       "Android exported component",
+			rule:       regular.NewKotlinRegularAndroidExportedComponent(),
+			vulnerable: `<activity android:name=".DeepLinkActivity" android:exported="true" />`,
+			safe:       `<activity android:name=".MainActivity" android:exported="false" />`,
+		},
+		{
+			name:       "Mutable PendingIntent",
+			rule:       or.NewKotlinOrMutablePendingIntent(),
+			vulnerable: `PendingIntent.getActivity(context, 0, intent, PendingIntent.FLAG_MUTABLE)`,
+			safe:       `PendingIntent.getActivity(context, 0, intent, PendingIntent.FLAG_IMMUTABLE)`,
+		},
+		{
+			name:       "Ktor development mode",
+			rule:       or.NewKotlinOrKtorDevelopmentMode(),
+			vulnerable: `embeddedServer(Netty, port = 8080) { developmentMode = true }`,
+			safe:       `embeddedServer(Netty, port = 8080) { developmentMode = false }`,
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run("should find vulnerability: "+testcase.name, func(t *testing.T) {
+			assert.NotEmpty(t, runRuleInKotlinCode(t, testcase.rule, testcase.vulnerable))
+		})
+
+		t.Run("should not find vulnerability: "+testcase.name, func(t *testing.T) {
+			assert.Empty(t, runRuleInKotlinCode(t, testcase.rule, testcase.safe))
+		})
+	}
+}
+
+func runRuleInKotlinCode(t *testing.T, rule text.TextRule, code string) []engine.Finding {
+	textFile, err := text.NewTextFile("Main.kt", []byte(code))
+	assert.NoError(t, err)
+
+	return engine.Run([]engine.Unit{text.TextUnit{Files: []text.TextFile{textFile}}}, []engine.Rule{rule})
+}
@@ -0,0 +1,106 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Resource is a kubernetes object of a manifest, like a Deployment or a ClusterRole
+type Resource struct {
+	Kind string
+	Name string
+	Node *yaml.Node
+}
+
+// File is a manifest with its resources. When resources are rendered from helm charts or kustomizations the name is
+// the source of them, and nodes without lines are searched in the content of the source
+type File struct {
+	Name      string
+	Lines     []string
+	Resources []*Resource
+}
+
+func NewFile(name string, content []byte) (*File, error) {
+	resources, err := ParseResources(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return NewRenderedFile(name, content, resources), nil
+}
+
+func NewRenderedFile(source string, sourceContent []byte, resources []*Resource) *File {
+	return &File{Name: source, Lines: strings.Split(string(sourceContent), "\n"), Resources: resources}
+}
+
+// ParseResources returns the kubernetes objects of all documents, the documents without apiVersion and kind are
+// ignored because they are not manifests, like values of helm charts and configuration of tools
+func ParseResources(content []byte) (resources []*Resource, err error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		document := &yaml.Node{}
+		if err := decoder.Decode(document); err != nil {
+			if errors.Is(err, io.EOF) {
+				return resources, nil
+			}
+
+			return nil, err
+		}
+
+		resources = append(resources, newResources(document)...)
+	}
+}
+
+func newResources(document *yaml.Node) (resources []*Resource) {
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+
+	node := document.Content[0]
+	kind := GetValue(node, "kind")
+	if kind == nil || GetValue(node, "apiVersion") == nil {
+		return nil
+	}
+
+	if kind.Value == "List" || strings.HasSuffix(kind.Value, "List") {
+		for _, item := range GetItems(GetValue(node, "items")) {
+			resources = append(resources, newResources(&yaml.Node{Content: []*yaml.Node{item}})...)
+		}
+
+		return resources
+	}
+
+	return []*Resource{NewResource(node)}
+}
+
+func NewResource(node *yaml.Node) *Resource {
+	resource := &Resource{Node: node}
+	if kind := GetValue(node, "kind"); kind != nil {
+		resource.Kind = kind.Value
+	}
+
+	if name := GetValue(node, "metadata", "name"); name != nil {
+		resource.Name = name.Value
+	}
+
+	return resource
+}
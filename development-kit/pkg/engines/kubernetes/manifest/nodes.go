@@ -0,0 +1,163 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
+// podSpecPaths are the paths of pod spec in the workloads
+var podSpecPaths = map[string][]string{
+	"Pod":                   {"spec"},
+	"Deployment":            {"spec", "template", "spec"},
+	"StatefulSet":           {"spec", "template", "spec"},
+	"DaemonSet":             {"spec", "template", "spec"},
+	"ReplicaSet":            {"spec", "template", "spec"},
+	"ReplicationController": {"spec", "template", "spec"},
+	"Job":                   {"spec", "template", "spec"},
+	"CronJob":               {"spec", "jobTemplate", "spec", "template", "spec"},
+	"PodTemplate":           {"template", "spec"},
+}
+
+// GetWorkloadKinds returns the kinds of resources with pod spec
+func GetWorkloadKinds() (kinds []string) {
+	for kind := range podSpecPaths {
+		kinds = append(kinds, kind)
+	}
+
+	return kinds
+}
+
+// GetPodSpec returns the spec of pod of workloads or nil for other kinds of resources
+func (r *Resource) GetPodSpec() *yaml.Node {
+	path, ok := podSpecPaths[r.Kind]
+	if !ok {
+		return nil
+	}
+
+	return GetValue(r.Node, path...)
+}
+
+// GetPodTemplate returns the node with metadata and spec of pod, which is the template in workloads
+func (r *Resource) GetPodTemplate() *yaml.Node {
+	path, ok := podSpecPaths[r.Kind]
+	if !ok {
+		return nil
+	}
+
+	return GetValue(r.Node, path[:len(path)-1]...)
+}
+
+// GetPodMetadata returns the metadata of pod, which is the metadata of template in workloads
+func (r *Resource) GetPodMetadata() *yaml.Node {
+	return GetValue(r.GetPodTemplate(), "metadata")
+}
+
+// GetContainers returns the containers, init containers and ephemeral containers of the pod spec
+func (r *Resource) GetContainers() (containers []*yaml.Node) {
+	podSpec := r.GetPodSpec()
+	for _, key := range []string{"containers", "initContainers", "ephemeralContainers"} {
+		containers = append(containers, GetItems(GetValue(podSpec, key))...)
+	}
+
+	return containers
+}
+
+// GetValue returns the value of the path of keys in mapping nodes or nil when it not exists
+func GetValue(node *yaml.Node, keys ...string) *yaml.Node {
+	for _, key := range keys {
+		_, node = getKeyAndValue(node, key)
+	}
+
+	return node
+}
+
+// GetKey returns the node of the last key of path, which line is where the value is defined
+func GetKey(node *yaml.Node, keys ...string) *yaml.Node {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	keyNode, _ := getKeyAndValue(GetValue(node, keys[:len(keys)-1]...), keys[len(keys)-1])
+	return keyNode
+}
+
+func GetItems(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	return node.Content
+}
+
+func GetStrings(node *yaml.Node) (values []string) {
+	if node != nil && node.Kind == yaml.ScalarNode {
+		return []string{node.Value}
+	}
+
+	for _, item := range GetItems(node) {
+		if item.Kind == yaml.ScalarNode {
+			values = append(values, item.Value)
+		}
+	}
+
+	return values
+}
+
+func IsTrue(node *yaml.Node) bool {
+	value, err := strconv.ParseBool(getScalar(node))
+	return err == nil && value
+}
+
+func IsFalse(node *yaml.Node) bool {
+	value, err := strconv.ParseBool(getScalar(node))
+	return err == nil && !value
+}
+
+// ClearLines removes the lines of node and its children, used when the lines are not from the source file
+func ClearLines(node *yaml.Node) {
+	if node == nil {
+		return
+	}
+
+	node.Line, node.Column = 0, 0
+	for _, child := range node.Content {
+		ClearLines(child)
+	}
+}
+
+func getKeyAndValue(node *yaml.Node, key string) (keyNode, valueNode *yaml.Node) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			return node.Content[index], node.Content[index+1]
+		}
+	}
+
+	return nil, nil
+}
+
+func getScalar(node *yaml.Node) string {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+
+	return node.Value
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"gopkg.in/yaml.v3"
+)
+
+// Rule is checked in all resources of the kinds, or in all resources when kinds are not informed
+type Rule struct {
+	engine.Metadata
+	Kinds []string
+	// Check returns the nodes of the vulnerable code in the resource
+	Check func(resource *Resource) []*yaml.Node
+}
+
+func (rule Rule) IsFor(unitType engine.UnitType) bool {
+	return engine.StructuredDataUnit == unitType
+}
+
+func (rule Rule) isForResource(resource *Resource) bool {
+	if len(rule.Kinds) == 0 {
+		return true
+	}
+
+	for _, kind := range rule.Kinds {
+		if kind == resource.Kind {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,185 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"regexp"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"gopkg.in/yaml.v3"
+)
+
+type Unit struct {
+	Files []*File
+}
+
+func (unit Unit) Type() engine.UnitType {
+	return engine.StructuredDataUnit
+}
+
+func (unit Unit) Eval(rule engine.Rule) (findings []engine.Finding) {
+	manifestRule, ok := rule.(Rule)
+	if !ok {
+		return findings
+	}
+
+	for _, file := range unit.Files {
+		findings = append(findings, file.eval(manifestRule)...)
+	}
+
+	return findings
+}
+
+func (f *File) eval(rule Rule) (findings []engine.Finding) {
+	for _, resource := range f.Resources {
+		if !rule.isForResource(resource) {
+			continue
+		}
+
+		for _, node := range rule.Check(resource) {
+			findings = append(findings, f.newFinding(rule, f.getLine(resource, node)))
+		}
+	}
+
+	return findings
+}
+
+func (f *File) newFinding(rule Rule, line int) engine.Finding {
+	codeSample, column := f.getCodeSampleAndColumn(line)
+
+	return engine.Finding{
+		ID:          rule.ID,
+		Name:        rule.Name,
+		Severity:    rule.Severity,
+		Confidence:  rule.Confidence,
+		Description: rule.Description,
+		CodeSample:  codeSample,
+		SourceLocation: engine.Location{
+			Filename: f.Name,
+			Line:     line,
+			Column:   column,
+		},
+	}
+}
+
+// getLine returns the line of node, or searches it in the source when the node was rendered. The search uses the
+// key of the node, so a value like true is found in its own line and not in the first line where it is written.
+// When the key is not in the source, the line of the kind of resource is used
+func (f *File) getLine(resource *Resource, node *yaml.Node) int {
+	if node.Line > 0 {
+		return node.Line
+	}
+
+	for _, pattern := range getLinePatterns(resource.Node, node) {
+		if line := f.searchLine(pattern); line > 0 {
+			return line
+		}
+	}
+
+	return f.searchLine(newKeyValuePattern("kind", resource.Kind))
+}
+
+func (f *File) searchLine(pattern *regexp.Regexp) int {
+	for index, line := range f.Lines {
+		if pattern.MatchString(line) {
+			return index + 1
+		}
+	}
+
+	return 0
+}
+
+// getLinePatterns returns the patterns of the lines where node can be written, from the most to the least specific
+func getLinePatterns(root, node *yaml.Node) (patterns []*regexp.Regexp) {
+	if (node.Kind == yaml.MappingNode || node.Kind == yaml.SequenceNode) && len(node.Content) > 0 {
+		return getLinePatterns(root, node.Content[0])
+	}
+
+	key, value, isItem := searchKeyAndValue(root, node, nil)
+	if value != nil && value.Kind == yaml.ScalarNode {
+		if isItem {
+			patterns = append(patterns, newItemPattern(value.Value))
+		} else if key != nil {
+			patterns = append(patterns, newKeyValuePattern(key.Value, value.Value))
+		}
+	}
+
+	if key != nil {
+		patterns = append(patterns, newKeyPattern(key.Value))
+	}
+
+	return patterns
+}
+
+// searchKeyAndValue returns the key and value of the mapping pair of node, which can be the key or the value. When
+// node is an item of a sequence, the key of the sequence is returned and isItem is true
+func searchKeyAndValue(root, node, parentKey *yaml.Node) (key, value *yaml.Node, isItem bool) {
+	if root == nil {
+		return nil, nil, false
+	}
+
+	if root.Kind == yaml.SequenceNode {
+		return searchItem(root, node, parentKey)
+	}
+
+	for index := 0; root.Kind == yaml.MappingNode && index+1 < len(root.Content); index += 2 {
+		if root.Content[index] == node || root.Content[index+1] == node {
+			return root.Content[index], root.Content[index+1], false
+		}
+
+		if key, value, isItem = searchKeyAndValue(root.Content[index+1], node, root.Content[index]); value != nil {
+			return key, value, isItem
+		}
+	}
+
+	return nil, nil, false
+}
+
+func searchItem(sequence, node, sequenceKey *yaml.Node) (key, value *yaml.Node, isItem bool) {
+	for _, item := range sequence.Content {
+		if item == node {
+			return sequenceKey, item, true
+		}
+
+		if key, value, isItem = searchKeyAndValue(item, node, sequenceKey); value != nil {
+			return key, value, isItem
+		}
+	}
+
+	return nil, nil, false
+}
+
+func newKeyPattern(key string) *regexp.Regexp {
+	return regexp.MustCompile(`^\s*(-\s+)?["']?` + regexp.QuoteMeta(key) + `["']?\s*:`)
+}
+
+func newKeyValuePattern(key, value string) *regexp.Regexp {
+	return regexp.MustCompile(newKeyPattern(key).String() + `\s*["']?` + regexp.QuoteMeta(value) + `["']?\s*(#.*)?\r?$`)
+}
+
+func newItemPattern(value string) *regexp.Regexp {
+	return regexp.MustCompile(`^\s*-\s+["']?` + regexp.QuoteMeta(value) + `["']?\s*(#.*)?\r?$`)
+}
+
+func (f *File) getCodeSampleAndColumn(line int) (string, int) {
+	if line < 1 || line > len(f.Lines) {
+		return "", 0
+	}
+
+	content := strings.TrimRight(f.Lines[line-1], "\r")
+	codeSample := strings.TrimSpace(content)
+	return codeSample, strings.Index(content, codeSample)
+}
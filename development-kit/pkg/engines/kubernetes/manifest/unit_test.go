@@ -0,0 +1,166 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package manifest
+
+import (
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const exampleContent = `apiVersion: v1
+kind: List
+items:
+  - apiVersion: v1
+    kind: Pod
+    metadata:
+      name: first
+    spec:
+      hostPID: true
+---
+# document without kind is not a manifest
+key: value
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: second
+spec:
+  template:
+    spec:
+      hostPID: true
+      containers:
+        - name: app
+`
+
+func newHostPIDRule(kinds ...string) Rule {
+	return Rule{
+		Metadata: engine.Metadata{ID: "test", Name: "Host PID", Severity: "HIGH", Confidence: "LOW"},
+		Kinds:    kinds,
+		Check: func(resource *Resource) []*yaml.Node {
+			if IsTrue(GetValue(resource.GetPodSpec(), "hostPID")) {
+				return []*yaml.Node{GetKey(resource.GetPodSpec(), "hostPID")}
+			}
+
+			return nil
+		},
+	}
+}
+
+func TestNewFile(t *testing.T) {
+	t.Run("should parse resources of all documents and lists", func(t *testing.T) {
+		file, err := NewFile("pod.yaml", []byte(exampleContent))
+
+		assert.NoError(t, err)
+		assert.Len(t, file.Resources, 2)
+		assert.Equal(t, "Pod", file.Resources[0].Kind)
+		assert.Equal(t, "first", file.Resources[0].Name)
+		assert.Equal(t, "Deployment", file.Resources[1].Kind)
+		assert.Len(t, file.Resources[1].GetContainers(), 1)
+	})
+
+	t.Run("should return error with name of file when yaml is invalid", func(t *testing.T) {
+		_, err := NewFile("invalid.yaml", []byte("key: [\n"))
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid.yaml")
+	})
+}
+
+func TestUnit(t *testing.T) {
+	t.Run("should return findings of resources with the kinds of the rule", func(t *testing.T) {
+		file, err := NewFile("pod.yaml", []byte(exampleContent))
+		assert.NoError(t, err)
+
+		findings := Unit{Files: []*File{file}}.Eval(newHostPIDRule("Deployment"))
+
+		assert.Len(t, findings, 1)
+		assert.Equal(t, "test", findings[0].ID)
+		assert.Equal(t, "hostPID: true", findings[0].CodeSample)
+		assert.Equal(t, "pod.yaml", findings[0].SourceLocation.Filename)
+		assert.Equal(t, 21, findings[0].SourceLocation.Line)
+		assert.Equal(t, 6, findings[0].SourceLocation.Column)
+	})
+
+	t.Run("should return findings of all resources when rule has no kinds", func(t *testing.T) {
+		file, err := NewFile("pod.yaml", []byte(exampleContent))
+		assert.NoError(t, err)
+
+		findings := Unit{Files: []*File{file}}.Eval(newHostPIDRule())
+
+		assert.Len(t, findings, 2)
+		assert.Equal(t, 9, findings[0].SourceLocation.Line)
+	})
+
+	t.Run("should search line in source when resource was rendered", func(t *testing.T) {
+		resources, err := ParseResources([]byte(exampleContent))
+		assert.NoError(t, err)
+		for _, resource := range resources {
+			ClearLines(resource.Node)
+		}
+
+		source := "kind: Deployment\nspec:\n  hostPID: {{ .Values.hostPID }}\n"
+		findings := Unit{Files: []*File{NewRenderedFile("deployment.yaml", []byte(source), resources)}}.
+			Eval(newHostPIDRule("Deployment"))
+
+		assert.Len(t, findings, 1)
+		assert.Equal(t, 3, findings[0].SourceLocation.Line)
+		assert.Equal(t, "hostPID: {{ .Values.hostPID }}", findings[0].CodeSample)
+	})
+
+	t.Run("should search line of key and value when value is written before in source", func(t *testing.T) {
+		resources, err := ParseResources([]byte(exampleContent))
+		assert.NoError(t, err)
+		for _, resource := range resources {
+			ClearLines(resource.Node)
+		}
+
+		rule := newHostPIDRule("Deployment")
+		rule.Check = func(resource *Resource) []*yaml.Node {
+			return []*yaml.Node{GetValue(resource.GetPodSpec(), "hostPID")}
+		}
+		source := "kind: Deployment\nmetadata:\n  labels:\n    enabled: true\nspec:\n  hostPID: true\n"
+		findings := Unit{Files: []*File{NewRenderedFile("deployment.yaml", []byte(source), resources)}}.Eval(rule)
+
+		assert.Len(t, findings, 1)
+		assert.Equal(t, 6, findings[0].SourceLocation.Line)
+		assert.Equal(t, "hostPID: true", findings[0].CodeSample)
+	})
+
+	t.Run("should return line of kind when key is not in source", func(t *testing.T) {
+		resources, err := ParseResources([]byte(exampleContent))
+		assert.NoError(t, err)
+		for _, resource := range resources {
+			ClearLines(resource.Node)
+		}
+
+		source := "apiVersion: apps/v1\nkind: Deployment\nspec: {{ toYaml .Values.spec }}\n"
+		findings := Unit{Files: []*File{NewRenderedFile("deployment.yaml", []byte(source), resources)}}.
+			Eval(newHostPIDRule("Deployment"))
+
+		assert.Len(t, findings, 1)
+		assert.Equal(t, 2, findings[0].SourceLocation.Line)
+	})
+
+	t.Run("should ignore rules that are not manifest rules", func(t *testing.T) {
+		unit := Unit{}
+
+		assert.Equal(t, engine.StructuredDataUnit, unit.Type())
+		assert.Empty(t, unit.Eval(text.TextRule{}))
+	})
+}
@@ -0,0 +1,312 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	capabilityAll            = "ALL"
+	capabilityNetBindService = "NET_BIND_SERVICE"
+	appArmorAnnotationPrefix = "container.apparmor.security.beta.kubernetes.io/"
+	profileUnconfined        = "Unconfined"
+)
+
+var (
+	hostNamespaces       = []string{"hostNetwork", "hostPID", "hostIPC"}
+	baselineCapabilities = []string{
+		"AUDIT_WRITE", "CHOWN", "DAC_OVERRIDE", "FOWNER", "FSETID", "KILL", "MKNOD", capabilityNetBindService,
+		"SETFCAP", "SETGID", "SETPCAP", "SETUID", "SYS_CHROOT",
+	}
+	allowedSELinuxTypes = []string{"", "container_t", "container_init_t", "container_kvm_t"}
+	safeSysctls         = []string{
+		"kernel.shm_rmid_forced", "net.ipv4.ip_local_port_range", "net.ipv4.ip_unprivileged_port_start",
+		"net.ipv4.tcp_syncookies", "net.ipv4.ping_group_range",
+	}
+	restrictedVolumeTypes = []string{
+		"configMap", "csi", "downwardAPI", "emptyDir", "ephemeral", "persistentVolumeClaim", "projected", "secret",
+	}
+	allowedSeccompTypes = []string{"RuntimeDefault", "Localhost"}
+)
+
+func checkHostNamespaces(resource *manifest.Resource) (nodes []*yaml.Node) {
+	podSpec := resource.GetPodSpec()
+	for _, namespace := range hostNamespaces {
+		if manifest.IsTrue(manifest.GetValue(podSpec, namespace)) {
+			nodes = append(nodes, manifest.GetKey(podSpec, namespace))
+		}
+	}
+
+	return nodes
+}
+
+func checkPrivilegedContainer(resource *manifest.Resource) (nodes []*yaml.Node) {
+	nodes = getKeysIfTrue(resource.GetPodSpec(), "securityContext", "windowsOptions", "hostProcess")
+	for _, container := range resource.GetContainers() {
+		nodes = append(nodes, getKeysIfTrue(container, "securityContext", "privileged")...)
+		nodes = append(nodes, getKeysIfTrue(container, "securityContext", "windowsOptions", "hostProcess")...)
+	}
+
+	return nodes
+}
+
+func checkAddedCapabilities(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, container := range resource.GetContainers() {
+		for _, capability := range getAddedCapabilities(container) {
+			if !contains(baselineCapabilities, normalizeCapability(capability.Value)) {
+				nodes = append(nodes, capability)
+			}
+		}
+	}
+
+	return nodes
+}
+
+func checkHostPathVolume(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, volume := range manifest.GetItems(manifest.GetValue(resource.GetPodSpec(), "volumes")) {
+		if key := manifest.GetKey(volume, "hostPath"); key != nil {
+			nodes = append(nodes, key)
+		}
+	}
+
+	return nodes
+}
+
+func checkHostPort(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, container := range resource.GetContainers() {
+		for _, port := range manifest.GetItems(manifest.GetValue(container, "ports")) {
+			hostPort := manifest.GetValue(port, "hostPort")
+			if hostPort != nil && !isZero(hostPort.Value) {
+				nodes = append(nodes, manifest.GetKey(port, "hostPort"))
+			}
+		}
+	}
+
+	return nodes
+}
+
+func checkUnconfinedProfile(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, securityContext := range getSecurityContexts(resource) {
+		for _, profile := range []string{"seccompProfile", "appArmorProfile"} {
+			if profileType := manifest.GetValue(securityContext, profile, "type"); profileType != nil &&
+				profileType.Value == profileUnconfined {
+				nodes = append(nodes, manifest.GetKey(securityContext, profile, "type"))
+			}
+		}
+
+		if seLinuxType := manifest.GetValue(securityContext, "seLinuxOptions", "type"); seLinuxType != nil &&
+			!contains(allowedSELinuxTypes, seLinuxType.Value) {
+			nodes = append(nodes, manifest.GetKey(securityContext, "seLinuxOptions", "type"))
+		}
+	}
+
+	return append(nodes, getUnconfinedAppArmorAnnotations(resource)...)
+}
+
+func checkUnmaskedProcMount(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, container := range resource.GetContainers() {
+		if procMount := manifest.GetValue(container, "securityContext", "procMount"); procMount != nil &&
+			procMount.Value == "Unmasked" {
+			nodes = append(nodes, manifest.GetKey(container, "securityContext", "procMount"))
+		}
+	}
+
+	return nodes
+}
+
+func checkUnsafeSysctls(resource *manifest.Resource) (nodes []*yaml.Node) {
+	sysctls := manifest.GetValue(resource.GetPodSpec(), "securityContext", "sysctls")
+	for _, sysctl := range manifest.GetItems(sysctls) {
+		if name := manifest.GetValue(sysctl, "name"); name != nil && !contains(safeSysctls, name.Value) {
+			nodes = append(nodes, name)
+		}
+	}
+
+	return nodes
+}
+
+// checkRestrictedVolumeTypes ignores host path volumes, which are already found by the baseline rule
+func checkRestrictedVolumeTypes(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, volume := range manifest.GetItems(manifest.GetValue(resource.GetPodSpec(), "volumes")) {
+		for index := 0; index+1 < len(volume.Content); index += 2 {
+			key := volume.Content[index]
+			if key.Value != "name" && key.Value != "hostPath" && !contains(restrictedVolumeTypes, key.Value) {
+				nodes = append(nodes, key)
+			}
+		}
+	}
+
+	return nodes
+}
+
+func checkPrivilegeEscalationAllowed(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, container := range resource.GetContainers() {
+		allowPrivilegeEscalation := manifest.GetValue(container, "securityContext", "allowPrivilegeEscalation")
+		switch {
+		case allowPrivilegeEscalation == nil:
+			nodes = append(nodes, container)
+		case !manifest.IsFalse(allowPrivilegeEscalation):
+			nodes = append(nodes, manifest.GetKey(container, "securityContext", "allowPrivilegeEscalation"))
+		}
+	}
+
+	return nodes
+}
+
+// checkRunAsRoot uses the security context of pod when the container does not override it
+func checkRunAsRoot(resource *manifest.Resource) (nodes []*yaml.Node) {
+	podSpec := resource.GetPodSpec()
+	for _, container := range resource.GetContainers() {
+		runAsUser, runAsUserKey := getContainerOrPodValue(podSpec, container, "runAsUser")
+		runAsNonRoot, runAsNonRootKey := getContainerOrPodValue(podSpec, container, "runAsNonRoot")
+		switch {
+		case runAsUser != nil && isZero(runAsUser.Value):
+			nodes = append(nodes, runAsUserKey)
+		case runAsNonRoot == nil:
+			nodes = append(nodes, container)
+		case !manifest.IsTrue(runAsNonRoot):
+			nodes = append(nodes, runAsNonRootKey)
+		}
+	}
+
+	return removeDuplicated(nodes)
+}
+
+// checkSeccompProfileNotSet ignores the containers with unconfined profile, which are found by baseline rule
+func checkSeccompProfileNotSet(resource *manifest.Resource) (nodes []*yaml.Node) {
+	podSpec := resource.GetPodSpec()
+	for _, container := range resource.GetContainers() {
+		profileType, _ := getContainerOrPodValue(podSpec, container, "seccompProfile", "type")
+		if profileType == nil || (profileType.Value != profileUnconfined &&
+			!contains(allowedSeccompTypes, profileType.Value)) {
+			nodes = append(nodes, container)
+		}
+	}
+
+	return nodes
+}
+
+// checkCapabilitiesNotDropped ignores the added capabilities out of baseline, which are found by baseline rule
+func checkCapabilitiesNotDropped(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, container := range resource.GetContainers() {
+		dropped := manifest.GetValue(container, "securityContext", "capabilities", "drop")
+		if !contains(normalizeCapabilities(manifest.GetStrings(dropped)), capabilityAll) {
+			nodes = append(nodes, container)
+		}
+
+		for _, capability := range getAddedCapabilities(container) {
+			normalized := normalizeCapability(capability.Value)
+			if normalized != capabilityNetBindService && contains(baselineCapabilities, normalized) {
+				nodes = append(nodes, capability)
+			}
+		}
+	}
+
+	return nodes
+}
+
+func getSecurityContexts(resource *manifest.Resource) []*yaml.Node {
+	securityContexts := []*yaml.Node{manifest.GetValue(resource.GetPodSpec(), "securityContext")}
+	for _, container := range resource.GetContainers() {
+		securityContexts = append(securityContexts, manifest.GetValue(container, "securityContext"))
+	}
+
+	return securityContexts
+}
+
+func getUnconfinedAppArmorAnnotations(resource *manifest.Resource) (nodes []*yaml.Node) {
+	annotations := manifest.GetValue(resource.GetPodMetadata(), "annotations")
+	if annotations == nil {
+		return nil
+	}
+
+	for index := 0; index+1 < len(annotations.Content); index += 2 {
+		if strings.HasPrefix(annotations.Content[index].Value, appArmorAnnotationPrefix) &&
+			annotations.Content[index+1].Value == "unconfined" {
+			nodes = append(nodes, annotations.Content[index])
+		}
+	}
+
+	return nodes
+}
+
+func getAddedCapabilities(container *yaml.Node) []*yaml.Node {
+	return manifest.GetItems(manifest.GetValue(container, "securityContext", "capabilities", "add"))
+}
+
+// getContainerOrPodValue returns the value and key in the security context of container, or in the security
+// context of pod when the container does not set it
+func getContainerOrPodValue(podSpec, container *yaml.Node, keys ...string) (value, key *yaml.Node) {
+	path := append([]string{"securityContext"}, keys...)
+	if value = manifest.GetValue(container, path...); value != nil {
+		return value, manifest.GetKey(container, path...)
+	}
+
+	return manifest.GetValue(podSpec, path...), manifest.GetKey(podSpec, path...)
+}
+
+func getKeysIfTrue(node *yaml.Node, keys ...string) []*yaml.Node {
+	if manifest.IsTrue(manifest.GetValue(node, keys...)) {
+		return []*yaml.Node{manifest.GetKey(node, keys...)}
+	}
+
+	return nil
+}
+
+// removeDuplicated removes the keys of the security context of pod found in more than one container
+func removeDuplicated(nodes []*yaml.Node) (unique []*yaml.Node) {
+	found := map[*yaml.Node]bool{}
+	for _, node := range nodes {
+		if !found[node] {
+			found[node] = true
+			unique = append(unique, node)
+		}
+	}
+
+	return unique
+}
+
+// normalizeCapability removes the CAP_ prefix, which is accepted by some container runtimes
+func normalizeCapability(capability string) string {
+	return strings.TrimPrefix(strings.ToUpper(capability), "CAP_")
+}
+
+func normalizeCapabilities(capabilities []string) (normalized []string) {
+	for _, capability := range capabilities {
+		normalized = append(normalized, normalizeCapability(capability))
+	}
+
+	return normalized
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isZero(value string) bool {
+	number, err := strconv.Atoi(value)
+	return err == nil && number == 0
+}
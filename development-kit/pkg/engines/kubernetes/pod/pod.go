@@ -0,0 +1,205 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll descriptions of rules are not possible broken lines
+package pod
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewKubernetesPodHostNamespaces() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "9689aa3b-123a-467c-b4cd-e532d5713715",
+			Name:        "Host namespaces shared",
+			Description: "The pod shares the network, PID or IPC namespace of the host, allowing its processes to see and communicate with the processes and network interfaces of the node. Remove hostNetwork, hostPID and hostIPC from the pod spec. For more information checkout the CWE-653 (https://cwe.mitre.org/data/definitions/653.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkHostNamespaces,
+	}
+}
+
+func NewKubernetesPodPrivilegedContainer() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "f884d14b-fb1c-4d3a-9827-d79081b34cfb",
+			Name:        "Privileged container",
+			Description: "The container runs as privileged or as a windows host process, which disables almost all isolation from the node. Remove the privileged and hostProcess options of the security context. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkPrivilegedContainer,
+	}
+}
+
+func NewKubernetesPodAddedCapabilities() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "7e9417e5-eaca-4173-bfeb-7ceef93da8f5",
+			Name:        "Capabilities added beyond the baseline",
+			Description: "The container adds linux capabilities that are not allowed by the baseline pod security profile, like SYS_ADMIN and NET_ADMIN, which allows it to escape to the node. Add only the needed capabilities of the baseline profile. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkAddedCapabilities,
+	}
+}
+
+func NewKubernetesPodHostPathVolume() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "731fda23-aefa-4a25-a506-d71444f7c37f",
+			Name:        "Host path volume",
+			Description: "The pod mounts a path of the node, like the docker socket or the root filesystem, which allows it to read and change files of the node. Use persistent volume claims, config maps or empty dirs instead. For more information checkout the CWE-668 (https://cwe.mitre.org/data/definitions/668.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkHostPathVolume,
+	}
+}
+
+func NewKubernetesPodHostPort() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "557f215b-442a-4959-9fec-cdc213219b7d",
+			Name:        "Host port",
+			Description: "The container binds a port of the node, bypassing the network policies and limiting where the pod can be scheduled. Expose the container with a service instead. For more information checkout the CWE-668 (https://cwe.mitre.org/data/definitions/668.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkHostPort,
+	}
+}
+
+func NewKubernetesPodUnconfinedProfile() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "ab189856-58b4-4e66-ab13-e9f55f293acf",
+			Name:        "Unconfined seccomp, AppArmor or SELinux profile",
+			Description: "The pod or container disables the seccomp or AppArmor profile, or uses a custom SELinux type, which removes restrictions of the kernel calls made by the container. Use the RuntimeDefault profiles. For more information checkout the CWE-693 (https://cwe.mitre.org/data/definitions/693.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkUnconfinedProfile,
+	}
+}
+
+func NewKubernetesPodUnmaskedProcMount() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "f6a3fcca-5fdd-426d-bcc5-52d877c5c464",
+			Name:        "Unmasked proc mount",
+			Description: "The container uses the unmasked proc mount, exposing information and controls of the kernel of the node in /proc. Remove the procMount option or use Default. For more information checkout the CWE-668 (https://cwe.mitre.org/data/definitions/668.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkUnmaskedProcMount,
+	}
+}
+
+func NewKubernetesPodUnsafeSysctls() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "9868bdf1-4963-4e1c-b6e5-f514e56e79c7",
+			Name:        "Unsafe sysctls",
+			Description: "The pod sets sysctls that are not namespaced, changing the kernel parameters of all pods of the node. Set only the safe sysctls. For more information checkout the CWE-668 (https://cwe.mitre.org/data/definitions/668.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkUnsafeSysctls,
+	}
+}
+
+func NewKubernetesPodRestrictedVolumeTypes() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "1bbca97e-c4ac-49a9-9f3e-e77d24086eba",
+			Name:        "Volume type not allowed by restricted profile",
+			Description: "The pod uses a volume type that is not allowed by the restricted pod security profile. Use only configMap, csi, downwardAPI, emptyDir, ephemeral, persistentVolumeClaim, projected and secret volumes. For more information checkout the CWE-668 (https://cwe.mitre.org/data/definitions/668.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkRestrictedVolumeTypes,
+	}
+}
+
+func NewKubernetesPodPrivilegeEscalationAllowed() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "830280d5-5640-45c1-bcb1-90f6e0d537af",
+			Name:        "Privilege escalation allowed",
+			Description: "The container does not set allowPrivilegeEscalation to false, so its processes can gain more privileges than the parent process with setuid binaries. Set allowPrivilegeEscalation to false in the security context. For more information checkout the CWE-269 (https://cwe.mitre.org/data/definitions/269.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkPrivilegeEscalationAllowed,
+	}
+}
+
+func NewKubernetesPodRunAsRoot() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "e86adf4e-a47d-4414-9f23-533027731623",
+			Name:        "Container running as root",
+			Description: "The container can run as the root user, because runAsNonRoot is not true or runAsUser is 0. Set runAsNonRoot to true and a non zero runAsUser in the security context. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkRunAsRoot,
+	}
+}
+
+func NewKubernetesPodSeccompProfileNotSet() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "b9fbd5f8-07fb-481c-8d8c-e92a4c235703",
+			Name:        "Seccomp profile not set",
+			Description: "The container does not set a seccomp profile, so all kernel calls are allowed on runtimes without default profile. Set the seccompProfile type to RuntimeDefault or Localhost in the pod or container security context. For more information checkout the CWE-693 (https://cwe.mitre.org/data/definitions/693.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkSeccompProfileNotSet,
+	}
+}
+
+func NewKubernetesPodCapabilitiesNotDropped() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "c211ed48-5db5-46d3-ad9c-27ec6de66c9b",
+			Name:        "Capabilities not dropped",
+			Description: "The container does not drop all linux capabilities or adds capabilities other than NET_BIND_SERVICE, as required by the restricted pod security profile. Drop ALL capabilities and add only NET_BIND_SERVICE when needed. For more information checkout the CWE-250 (https://cwe.mitre.org/data/definitions/250.html) advisory.",
+			Severity:    severity.Low.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Kinds: manifest.GetWorkloadKinds(),
+		Check: checkCapabilitiesNotDropped,
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll descriptions of rules are not possible broken lines
+package rbac
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"gopkg.in/yaml.v3"
+)
+
+const wildcard = "*"
+
+func NewKubernetesRBACWildcard() manifest.Rule {
+	return manifest.Rule{
+		Metadata: engine.Metadata{
+			ID:          "04f881eb-7348-4611-b76c-51f76443a5cd",
+			Name:        "RBAC role with wildcard",
+			Description: "The role allows all verbs, resources or API groups (*), granting permissions that are not needed and that will also include the resources created in the future. List only the needed verbs, resources and API groups. For more information checkout the CWE-269 (https://cwe.mitre.org/data/definitions/269.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Kinds: []string{"Role", "ClusterRole"},
+		Check: checkWildcard,
+	}
+}
+
+func checkWildcard(resource *manifest.Resource) (nodes []*yaml.Node) {
+	for _, rule := range manifest.GetItems(manifest.GetValue(resource.Node, "rules")) {
+		for _, key := range []string{"verbs", "resources", "apiGroups"} {
+			for _, value := range manifest.GetItems(manifest.GetValue(rule, key)) {
+				if value.Value == wildcard {
+					nodes = append(nodes, manifest.GetKey(rule, key))
+					break
+				}
+			}
+		}
+	}
+
+	return nodes
+}
@@ -0,0 +1,575 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/pelletier/go-toml"
+	"gopkg.in/yaml.v3"
+)
+
+type mapValues = map[string]interface{}
+
+// newFuncMap returns the subset of sprig and helm functions used by most of charts. The functions that depend on
+// the cluster or on randomness, like lookup and randAlphaNum, return deterministic values. Keep the list
+// of functions in HOWTO.md updated when adding new ones
+//
+//nolint:funlen // map of functions is not possible broken
+func newFuncMap(tmpl *template.Template) template.FuncMap {
+	return template.FuncMap{
+		"include":         func(name string, data interface{}) (string, error) { return include(tmpl, name, data) },
+		"tpl":             func(text string, data interface{}) (string, error) { return tpl(tmpl, text, data) },
+		"required":        required,
+		"fail":            func(message string) (string, error) { return "", errors.New(message) },
+		"toYaml":          toYaml,
+		"fromYaml":        fromYaml,
+		"toJson":          toJSON,
+		"toToml":          toToml,
+		"fromJson":        fromJSON,
+		"default":         defaultValue,
+		"empty":           isEmpty,
+		"coalesce":        coalesce,
+		"ternary":         ternary,
+		"quote":           func(values ...interface{}) string { return wrap(strconv.Quote, values) },
+		"squote":          func(values ...interface{}) string { return wrap(singleQuote, values) },
+		"indent":          indent,
+		"nindent":         func(spaces int, value string) string { return "\n" + indent(spaces, value) },
+		"trim":            strings.TrimSpace,
+		"trimAll":         func(cutset, value string) string { return strings.Trim(value, cutset) },
+		"trimPrefix":      func(prefix, value string) string { return strings.TrimPrefix(value, prefix) },
+		"trimSuffix":      func(suffix, value string) string { return strings.TrimSuffix(value, suffix) },
+		"upper":           strings.ToUpper,
+		"lower":           strings.ToLower,
+		"title":           strings.Title,
+		"replace":         func(old, new, value string) string { return strings.ReplaceAll(value, old, new) },
+		"contains":        func(substr, value string) bool { return strings.Contains(value, substr) },
+		"hasPrefix":       func(prefix, value string) bool { return strings.HasPrefix(value, prefix) },
+		"hasSuffix":       func(suffix, value string) bool { return strings.HasSuffix(value, suffix) },
+		"trunc":           trunc,
+		"substr":          substr,
+		"repeat":          func(count int, value string) string { return strings.Repeat(value, count) },
+		"cat":             func(values ...interface{}) string { return strings.TrimSpace(fmt.Sprintln(values...)) },
+		"toString":        toString,
+		"splitList":       func(sep, value string) []string { return strings.Split(value, sep) },
+		"join":            join,
+		"b64enc":          func(value string) string { return base64.StdEncoding.EncodeToString([]byte(value)) },
+		"b64dec":          b64dec,
+		"sha256sum":       sha256sum,
+		"regexMatch":      regexMatch,
+		"regexReplaceAll": regexReplaceAll,
+		"int":             func(value interface{}) int { return int(toInt64(value)) },
+		"int64":           toInt64,
+		"float64":         toFloat64,
+		"atoi":            func(value string) int { number, _ := strconv.Atoi(value); return number },
+		"add":             func(values ...interface{}) int64 { return reduce(values, sumInt64) },
+		"add1":            func(value interface{}) int64 { return toInt64(value) + 1 },
+		"sub":             func(a, b interface{}) int64 { return toInt64(a) - toInt64(b) },
+		"mul":             func(values ...interface{}) int64 { return reduce(values, mulInt64) },
+		"div":             div,
+		"mod":             mod,
+		"max":             func(values ...interface{}) int64 { return reduce(values, maxInt64) },
+		"min":             func(values ...interface{}) int64 { return reduce(values, minInt64) },
+		"list":            func(values ...interface{}) []interface{} { return values },
+		"append":          func(list interface{}, value interface{}) []interface{} { return append(toList(list), value) },
+		"first":           first,
+		"last":            last,
+		"has":             has,
+		"uniq":            uniq,
+		"until":           until,
+		"sortAlpha":       sortAlpha,
+		"dict":            dict,
+		"hasKey":          func(values map[string]interface{}, key string) bool { _, ok := values[key]; return ok },
+		"get":             get,
+		"set":             set,
+		"unset":           unset,
+		"keys":            keys,
+		"merge":           func(dst mapValues, src ...mapValues) interface{} { return merge(dst, src, false) },
+		"mergeOverwrite":  func(dst mapValues, src ...mapValues) interface{} { return merge(dst, src, true) },
+		"kindIs":          func(kind string, value interface{}) bool { return kindOf(value) == kind },
+		"kindOf":          kindOf,
+		"typeOf":          func(value interface{}) string { return fmt.Sprintf("%T", value) },
+		"semverCompare":   func(constraint, version string) bool { return true },
+		"lookup":          lookup,
+		"randAlphaNum":    func(count int) string { return strings.Repeat("x", count) },
+		"uuidv4":          func() string { return "00000000-0000-0000-0000-000000000000" },
+	}
+}
+
+func include(tmpl *template.Template, name string, data interface{}) (string, error) {
+	buffer := &bytes.Buffer{}
+	err := tmpl.ExecuteTemplate(buffer, name, data)
+	return buffer.String(), err
+}
+
+func tpl(tmpl *template.Template, text string, data interface{}) (string, error) {
+	clone, err := tmpl.Clone()
+	if err != nil {
+		return "", err
+	}
+
+	parsed, err := clone.New("tpl").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	buffer := &bytes.Buffer{}
+	err = parsed.Execute(buffer, data)
+	return strings.ReplaceAll(buffer.String(), noValue, ""), err
+}
+
+func required(message string, value interface{}) (interface{}, error) {
+	if isEmpty(value) {
+		return nil, errors.New(message)
+	}
+
+	return value, nil
+}
+
+func toYaml(value interface{}) (string, error) {
+	buffer := &bytes.Buffer{}
+	encoder := yaml.NewEncoder(buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(value); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), encoder.Close()
+}
+
+func fromYaml(value string) map[string]interface{} {
+	result := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(value), &result); err != nil {
+		result["Error"] = err.Error()
+	}
+
+	return result
+}
+
+func toJSON(value interface{}) (string, error) {
+	content, err := json.Marshal(value)
+	return string(content), err
+}
+
+// toToml returns the error as the result like helm does, because the template can not fail in this function
+func toToml(value interface{}) string {
+	values, ok := value.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+
+	tree, err := toml.TreeFromMap(values)
+	if err != nil {
+		return err.Error()
+	}
+
+	return tree.String()
+}
+
+func fromJSON(value string) map[string]interface{} {
+	result := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		result["Error"] = err.Error()
+	}
+
+	return result
+}
+
+func defaultValue(value interface{}, given ...interface{}) interface{} {
+	if len(given) == 0 || isEmpty(given[0]) {
+		return value
+	}
+
+	return given[0]
+}
+
+func isEmpty(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return reflected.Len() == 0
+	case reflect.Bool:
+		return !reflected.Bool()
+	case reflect.Ptr, reflect.Interface:
+		return reflected.IsNil()
+	default:
+		return reflected.IsZero()
+	}
+}
+
+func coalesce(values ...interface{}) interface{} {
+	for _, value := range values {
+		if !isEmpty(value) {
+			return value
+		}
+	}
+
+	return nil
+}
+
+func ternary(valueIfTrue, valueIfFalse interface{}, condition bool) interface{} {
+	if condition {
+		return valueIfTrue
+	}
+
+	return valueIfFalse
+}
+
+// wrap quotes the values like sprig, where quote escapes the value as a go string and squote does not escape it
+func wrap(quote func(value string) string, values []interface{}) string {
+	var result []string
+	for _, value := range values {
+		if value != nil {
+			result = append(result, quote(toString(value)))
+		}
+	}
+
+	return strings.Join(result, " ")
+}
+
+func singleQuote(value string) string {
+	return "'" + value + "'"
+}
+
+func indent(spaces int, value string) string {
+	padding := strings.Repeat(" ", spaces)
+	return padding + strings.ReplaceAll(value, "\n", "\n"+padding)
+}
+
+func trunc(length int, value string) string {
+	if length < 0 && len(value)+length > 0 {
+		return value[len(value)+length:]
+	}
+
+	if length >= 0 && len(value) > length {
+		return value[:length]
+	}
+
+	return value
+}
+
+func substr(start, end int, value string) string {
+	if start < 0 || start > len(value) {
+		start = 0
+	}
+
+	if end < 0 || end > len(value) {
+		end = len(value)
+	}
+
+	if start > end {
+		return ""
+	}
+
+	return value[start:end]
+}
+
+func toString(value interface{}) string {
+	switch converted := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return converted
+	case []byte:
+		return string(converted)
+	case error:
+		return converted.Error()
+	case fmt.Stringer:
+		return converted.String()
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+func join(sep string, list interface{}) string {
+	var values []string
+	for _, value := range toList(list) {
+		values = append(values, toString(value))
+	}
+
+	return strings.Join(values, sep)
+}
+
+func b64dec(value string) string {
+	decoded, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return err.Error()
+	}
+
+	return string(decoded)
+}
+
+func sha256sum(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
+
+func regexMatch(regex, value string) (bool, error) {
+	return regexp.MatchString(regex, value)
+}
+
+func regexReplaceAll(regex, value, replacement string) (string, error) {
+	compiled, err := regexp.Compile(regex)
+	if err != nil {
+		return "", err
+	}
+
+	return compiled.ReplaceAllString(value, replacement), nil
+}
+
+func toInt64(value interface{}) int64 {
+	switch converted := value.(type) {
+	case string:
+		number, _ := strconv.ParseInt(converted, 10, 64)
+		return number
+	case bool:
+		if converted {
+			return 1
+		}
+
+		return 0
+	}
+
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return reflected.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(reflected.Uint())
+	case reflect.Float32, reflect.Float64:
+		return int64(reflected.Float())
+	default:
+		return 0
+	}
+}
+
+func toFloat64(value interface{}) float64 {
+	if converted, ok := value.(float64); ok {
+		return converted
+	}
+
+	if converted, ok := value.(string); ok {
+		number, _ := strconv.ParseFloat(converted, 64)
+		return number
+	}
+
+	return float64(toInt64(value))
+}
+
+func reduce(values []interface{}, operation func(a, b int64) int64) int64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	result := toInt64(values[0])
+	for _, value := range values[1:] {
+		result = operation(result, toInt64(value))
+	}
+
+	return result
+}
+
+func div(a, b interface{}) (int64, error) {
+	if toInt64(b) == 0 {
+		return 0, errors.New("division by zero")
+	}
+
+	return toInt64(a) / toInt64(b), nil
+}
+
+func mod(a, b interface{}) (int64, error) {
+	if toInt64(b) == 0 {
+		return 0, errors.New("division by zero")
+	}
+
+	return toInt64(a) % toInt64(b), nil
+}
+
+func sumInt64(a, b int64) int64 {
+	return a + b
+}
+
+func mulInt64(a, b int64) int64 {
+	return a * b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func toList(list interface{}) (values []interface{}) {
+	reflected := reflect.ValueOf(list)
+	if reflected.Kind() != reflect.Slice && reflected.Kind() != reflect.Array {
+		return nil
+	}
+
+	for index := 0; index < reflected.Len(); index++ {
+		values = append(values, reflected.Index(index).Interface())
+	}
+
+	return values
+}
+
+func first(list interface{}) interface{} {
+	if values := toList(list); len(values) > 0 {
+		return values[0]
+	}
+
+	return nil
+}
+
+func last(list interface{}) interface{} {
+	if values := toList(list); len(values) > 0 {
+		return values[len(values)-1]
+	}
+
+	return nil
+}
+
+func has(needle, list interface{}) bool {
+	for _, value := range toList(list) {
+		if reflect.DeepEqual(value, needle) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func uniq(list interface{}) (values []interface{}) {
+	for _, value := range toList(list) {
+		if !has(value, values) {
+			values = append(values, value)
+		}
+	}
+
+	return values
+}
+
+func until(count int) (values []int) {
+	for index := 0; index < count; index++ {
+		values = append(values, index)
+	}
+
+	return values
+}
+
+func sortAlpha(list interface{}) (values []string) {
+	for _, value := range toList(list) {
+		values = append(values, toString(value))
+	}
+
+	sort.Strings(values)
+	return values
+}
+
+func dict(pairs ...interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for index := 0; index+1 < len(pairs); index += 2 {
+		result[toString(pairs[index])] = pairs[index+1]
+	}
+
+	return result
+}
+
+func get(values map[string]interface{}, key string) interface{} {
+	if value, ok := values[key]; ok {
+		return value
+	}
+
+	return ""
+}
+
+func set(values map[string]interface{}, key string, value interface{}) map[string]interface{} {
+	values[key] = value
+	return values
+}
+
+func unset(values map[string]interface{}, key string) map[string]interface{} {
+	delete(values, key)
+	return values
+}
+
+func keys(maps ...map[string]interface{}) (result []string) {
+	for _, values := range maps {
+		for key := range values {
+			result = append(result, key)
+		}
+	}
+
+	return result
+}
+
+// lookup returns an empty object, like helm template does because it does not connect to the cluster
+func lookup(_, _, _, _ string) map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func kindOf(value interface{}) string {
+	if value == nil {
+		return "invalid"
+	}
+
+	return reflect.ValueOf(value).Kind().String()
+}
+
+// merge copies the keys of sources into destination, the values of destination are only replaced when overwrite is
+// true, but maps present in both are always merged
+func merge(dst map[string]interface{}, sources []map[string]interface{}, overwrite bool) map[string]interface{} {
+	for _, src := range sources {
+		for key, value := range src {
+			dstMap, isDstMap := dst[key].(map[string]interface{})
+			srcMap, isSrcMap := value.(map[string]interface{})
+			_, exists := dst[key]
+
+			switch {
+			case isDstMap && isSrcMap:
+				dst[key] = merge(dstMap, []map[string]interface{}{srcMap}, overwrite)
+			case !exists || overwrite:
+				dst[key] = value
+			}
+		}
+	}
+
+	return dst
+}
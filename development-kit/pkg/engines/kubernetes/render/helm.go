@@ -0,0 +1,299 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ChartFile       = "Chart.yaml"
+	valuesFile      = "values.yaml"
+	TemplatesFolder = "templates"
+	chartsFolder    = "charts"
+	globalValues    = "global"
+	noValue         = "<no value>"
+	releaseName     = "release-name"
+	kubeVersion     = "v1.20.0"
+)
+
+// IsHelmChart returns true when the directory is the root of a helm chart
+func IsHelmChart(path string) bool {
+	info, err := os.Stat(filepath.Join(path, ChartFile))
+	return err == nil && !info.IsDir()
+}
+
+// RenderHelmChart renders the templates of chart and of its sub charts with the default values, like helm template
+// does without connecting to the cluster. Each file has the resources rendered from one template file, so findings
+// are reported in the template source
+func RenderHelmChart(chartPath string) ([]*manifest.File, error) {
+	return renderChart(chartPath, map[string]interface{}{})
+}
+
+func renderChart(chartPath string, parentValues map[string]interface{}) ([]*manifest.File, error) {
+	metadata, err := readValues(filepath.Join(chartPath, ChartFile))
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := readValues(filepath.Join(chartPath, valuesFile))
+	if err != nil {
+		return nil, err
+	}
+
+	values = merge(parentValues, []map[string]interface{}{values}, false)
+	files, err := renderTemplates(chartPath, metadata, values)
+	if err != nil {
+		return nil, err
+	}
+
+	subChartFiles, err := renderSubCharts(chartPath, values)
+	return append(files, subChartFiles...), err
+}
+
+func renderSubCharts(chartPath string, values map[string]interface{}) (files []*manifest.File, err error) {
+	subCharts, _ := ioutil.ReadDir(filepath.Join(chartPath, chartsFolder))
+	for _, subChart := range subCharts {
+		subChartPath := filepath.Join(chartPath, chartsFolder, subChart.Name())
+		if !subChart.IsDir() || !IsHelmChart(subChartPath) {
+			continue
+		}
+
+		subChartFiles, err := renderChart(subChartPath, getSubChartValues(subChartPath, values))
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, subChartFiles...)
+	}
+
+	return files, nil
+}
+
+// getSubChartValues returns the values of parent chart in the key with the name of sub chart and the global values
+func getSubChartValues(subChartPath string, values map[string]interface{}) map[string]interface{} {
+	subChartValues := map[string]interface{}{}
+	if metadata, err := readValues(filepath.Join(subChartPath, ChartFile)); err == nil {
+		if parentValues, ok := values[toString(metadata["name"])].(map[string]interface{}); ok {
+			subChartValues = merge(subChartValues, []map[string]interface{}{parentValues}, true)
+		}
+	}
+
+	if global, ok := values[globalValues]; ok {
+		subChartValues[globalValues] = global
+	}
+
+	return subChartValues
+}
+
+func renderTemplates(chartPath string, metadata, values map[string]interface{}) ([]*manifest.File, error) {
+	chartName := toString(metadata["name"])
+	tmpl := template.New(chartName).Option("missingkey=zero")
+	tmpl.Funcs(newFuncMap(tmpl))
+
+	sources, err := parseTemplates(tmpl, chartPath, chartName)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*manifest.File
+	for _, name := range getSortedNames(sources) {
+		source := sources[name]
+		file, err := renderTemplate(tmpl, name, source, newTemplateData(chartPath, chartName, name, metadata, values))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", source, err)
+		}
+
+		if file != nil {
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
+
+// parseTemplates adds all files of templates folder into tmpl, including the helpers, and returns the source of the
+// templates that are manifests
+func parseTemplates(tmpl *template.Template, chartPath, chartName string) (map[string]string, error) {
+	sources := map[string]string{}
+	if _, err := os.Stat(filepath.Join(chartPath, TemplatesFolder)); os.IsNotExist(err) {
+		return sources, nil
+	}
+
+	err := filepath.Walk(filepath.Join(chartPath, TemplatesFolder), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		name, err := parseTemplate(tmpl, chartPath, chartName, path)
+		if err == nil && isManifestTemplate(path) {
+			sources[name] = path
+		}
+
+		return err
+	})
+
+	return sources, err
+}
+
+func parseTemplate(tmpl *template.Template, chartPath, chartName, path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	relative, err := filepath.Rel(chartPath, path)
+	if err != nil {
+		return "", err
+	}
+
+	name := filepath.ToSlash(filepath.Join(chartName, relative))
+	if _, err := tmpl.New(name).Parse(string(content)); err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+func getSortedNames(sources map[string]string) (names []string) {
+	for name := range sources {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func isManifestTemplate(path string) bool {
+	extension := filepath.Ext(path)
+	return !strings.HasPrefix(filepath.Base(path), "_") && (extension == ".yaml" || extension == ".yml")
+}
+
+func renderTemplate(tmpl *template.Template, name, source string, data interface{}) (*manifest.File, error) {
+	buffer := &bytes.Buffer{}
+	if err := tmpl.ExecuteTemplate(buffer, name, data); err != nil {
+		return nil, err
+	}
+
+	resources, err := manifest.ParseResources([]byte(strings.ReplaceAll(buffer.String(), noValue, "")))
+	if err != nil || len(resources) == 0 {
+		return nil, err
+	}
+
+	for _, resource := range resources {
+		manifest.ClearLines(resource.Node)
+	}
+
+	content, err := ioutil.ReadFile(source)
+	return manifest.NewRenderedFile(source, content, resources), err
+}
+
+func newTemplateData(chartPath, chartName, name string, metadata, values map[string]interface{}) interface{} {
+	return map[string]interface{}{
+		"Values":       values,
+		"Chart":        getChartObject(metadata),
+		"Files":        files{root: chartPath},
+		"Capabilities": getCapabilities(),
+		"Template":     map[string]interface{}{"Name": name, "BasePath": chartName + "/" + TemplatesFolder},
+		"Release": map[string]interface{}{
+			"Name":      releaseName,
+			"Namespace": "default",
+			"Service":   "Helm",
+			"IsInstall": true,
+			"IsUpgrade": false,
+			"Revision":  1,
+		},
+	}
+}
+
+// getChartObject returns the metadata of chart with the keys capitalized, like .Chart.Name and .Chart.AppVersion
+func getChartObject(metadata map[string]interface{}) map[string]interface{} {
+	chart := map[string]interface{}{}
+	for key, value := range metadata {
+		if key != "" {
+			runes := []rune(key)
+			runes[0] = unicode.ToUpper(runes[0])
+			chart[string(runes)] = value
+		}
+	}
+
+	return chart
+}
+
+func getCapabilities() map[string]interface{} {
+	return map[string]interface{}{
+		"APIVersions": apiVersions{},
+		"KubeVersion": map[string]interface{}{
+			"Version":    kubeVersion,
+			"GitVersion": kubeVersion,
+			"Major":      "1",
+			"Minor":      "20",
+		},
+	}
+}
+
+func readValues(path string) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) && filepath.Base(path) == valuesFile {
+			return values, nil
+		}
+
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(content, &values); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return values, nil
+}
+
+// apiVersions considers that all versions are available, so templates render the most recent apis
+type apiVersions struct{}
+
+func (a apiVersions) Has(_ string) bool {
+	return true
+}
+
+// files gives access to the non template files of chart, like .Files.Get "config.yaml"
+type files struct {
+	root string
+}
+
+func (f files) Get(name string) string {
+	content, err := ioutil.ReadFile(filepath.Join(f.root, filepath.FromSlash(name)))
+	if err != nil {
+		return ""
+	}
+
+	return string(content)
+}
+
+func (f files) GetBytes(name string) []byte {
+	return []byte(f.Get(name))
+}
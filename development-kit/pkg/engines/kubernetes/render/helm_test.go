@@ -0,0 +1,164 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"github.com/stretchr/testify/assert"
+)
+
+const deploymentTemplate = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ include "app.fullname" . }}
+  labels:
+    chart: {{ .Chart.Name }}-{{ .Chart.Version | replace "+" "_" }}
+spec:
+  replicas: {{ .Values.replicaCount }}
+  template:
+    spec:
+      hostNetwork: {{ .Values.hostNetwork }}
+      containers:
+        - name: {{ .Chart.Name }}
+          image: "{{ .Values.image.repository }}:{{ .Values.image.tag | default .Chart.AppVersion }}"
+          {{- with .Values.securityContext }}
+          securityContext:
+            {{- toYaml . | nindent 12 }}
+          {{- end }}
+          {{- if .Values.missing.enabled }}
+          args: ["--missing"]
+          {{- end }}
+`
+
+func createFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), os.ModePerm))
+	}
+
+	return dir
+}
+
+func TestIsHelmChart(t *testing.T) {
+	t.Run("should return true when directory has chart file", func(t *testing.T) {
+		dir := createFiles(t, map[string]string{"Chart.yaml": "name: app\n"})
+
+		assert.True(t, IsHelmChart(dir))
+		assert.False(t, IsHelmChart(filepath.Join(dir, "templates")))
+	})
+}
+
+func TestRenderHelmChart(t *testing.T) {
+	t.Run("should render templates with values and map resources to template", func(t *testing.T) {
+		dir := createFiles(t, map[string]string{
+			"Chart.yaml":                "apiVersion: v2\nname: app\nversion: 0.1.0+1\nappVersion: \"1.16\"\n",
+			"values.yaml":               "replicaCount: 2\nhostNetwork: true\nimage:\n  repository: nginx\nmissing: {}\nsecurityContext:\n  privileged: true\n",
+			"templates/_helpers.tpl":    `{{- define "app.fullname" -}}{{ .Release.Name }}-{{ .Chart.Name | trunc 63 }}{{- end }}`,
+			"templates/deployment.yaml": deploymentTemplate,
+			"templates/NOTES.txt":       "{{ .Release.Name }}",
+		})
+
+		files, err := RenderHelmChart(dir)
+
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.Equal(t, filepath.Join(dir, "templates", "deployment.yaml"), files[0].Name)
+		assert.Len(t, files[0].Resources, 1)
+
+		resource := files[0].Resources[0]
+		assert.Equal(t, "Deployment", resource.Kind)
+		assert.Equal(t, "release-name-app", resource.Name)
+		assert.Equal(t, 0, resource.Node.Line)
+		assert.Equal(t, "app-0.1.0_1", manifest.GetValue(resource.Node, "metadata", "labels", "chart").Value)
+		assert.True(t, manifest.IsTrue(manifest.GetValue(resource.GetPodSpec(), "hostNetwork")))
+
+		container := resource.GetContainers()[0]
+		assert.Equal(t, "nginx:1.16", manifest.GetValue(container, "image").Value)
+		assert.True(t, manifest.IsTrue(manifest.GetValue(container, "securityContext", "privileged")))
+		assert.Nil(t, manifest.GetValue(container, "args"))
+	})
+
+	t.Run("should render sub charts with values of parent", func(t *testing.T) {
+		dir := createFiles(t, map[string]string{
+			"Chart.yaml":                   "name: parent\n",
+			"values.yaml":                  "global:\n  namespace: global\nchild:\n  role: parent-role\n",
+			"charts/child/Chart.yaml":      "name: child\n",
+			"charts/child/values.yaml":     "role: child-role\n",
+			"charts/child/templates/a.yml": "apiVersion: v1\nkind: ServiceAccount\nmetadata:\n  name: {{ .Values.role }}\n  namespace: {{ .Values.global.namespace }}\n",
+		})
+
+		files, err := RenderHelmChart(dir)
+
+		assert.NoError(t, err)
+		assert.Len(t, files, 1)
+		assert.Equal(t, "parent-role", files[0].Resources[0].Name)
+		assert.Equal(t, "global", manifest.GetValue(files[0].Resources[0].Node, "metadata", "namespace").Value)
+	})
+
+	t.Run("should render functions of lists and toml", func(t *testing.T) {
+		dir := createFiles(t, map[string]string{
+			"Chart.yaml":  "name: app\n",
+			"values.yaml": "names: [b, a]\nconfig:\n  debug: true\n",
+			"templates/config.yaml": "{{- range $index := until 2 }}\n---\napiVersion: v1\nkind: ConfigMap\n" +
+				"metadata:\n  name: {{ index (sortAlpha $.Values.names) $index }}\ndata:\n" +
+				"  config.toml: {{ toToml $.Values.config | quote }}\n{{- end }}\n",
+		})
+
+		files, err := RenderHelmChart(dir)
+
+		assert.NoError(t, err)
+		assert.Len(t, files[0].Resources, 2)
+		assert.Equal(t, "a", files[0].Resources[0].Name)
+		assert.Equal(t, "b", files[0].Resources[1].Name)
+		assert.Equal(t, "debug = true\n",
+			manifest.GetValue(files[0].Resources[0].Node, "data", "config.toml").Value)
+	})
+
+	t.Run("should return error when template is invalid", func(t *testing.T) {
+		dir := createFiles(t, map[string]string{
+			"Chart.yaml":             "name: app\n",
+			"templates/invalid.yaml": "kind: {{ .Values.kind",
+		})
+
+		_, err := RenderHelmChart(dir)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should return error when required value is missing", func(t *testing.T) {
+		dir := createFiles(t, map[string]string{
+			"Chart.yaml":         "name: app\n",
+			"templates/pod.yaml": `name: {{ required "name is required" .Values.name }}`,
+		})
+
+		_, err := RenderHelmChart(dir)
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "name is required")
+	})
+
+	t.Run("should return error when chart file does not exist", func(t *testing.T) {
+		_, err := RenderHelmChart("./not-exists")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,650 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	ErrKustomizationNotFound = errors.New("kustomization file not found")
+	ErrPatchPathNotFound     = errors.New("path of patch not found")
+	ErrInvalidPatchOperation = errors.New("invalid patch operation")
+)
+
+// supportedFields are the fields of kustomization applied in the build, the others like generators, replicas and
+// replacements are ignored
+var supportedFields = map[string]bool{
+	"apiVersion": true, "kind": true, "resources": true, "bases": true, "namespace": true, "namePrefix": true,
+	"nameSuffix": true, "commonLabels": true, "commonAnnotations": true, "images": true,
+	"patchesStrategicMerge": true, "patches": true, "patchesJson6902": true,
+}
+
+// Kustomization is the result of the build of a kustomization directory
+type Kustomization struct {
+	Files []*manifest.File
+	// Used has the files consumed by the build, like resources, patches and kustomizations of bases
+	Used []string
+	// Ignored has the fields of kustomizations that are not supported and were not applied in the build
+	Ignored []string
+}
+
+type kustomizationConfig struct {
+	Resources             []string          `yaml:"resources"`
+	Bases                 []string          `yaml:"bases"`
+	Namespace             string            `yaml:"namespace"`
+	NamePrefix            string            `yaml:"namePrefix"`
+	NameSuffix            string            `yaml:"nameSuffix"`
+	CommonLabels          map[string]string `yaml:"commonLabels"`
+	CommonAnnotations     map[string]string `yaml:"commonAnnotations"`
+	Images                []image           `yaml:"images"`
+	PatchesStrategicMerge []string          `yaml:"patchesStrategicMerge"`
+	Patches               []patch           `yaml:"patches"`
+	PatchesJSON6902       []patch           `yaml:"patchesJson6902"`
+}
+
+type image struct {
+	Name    string `yaml:"name"`
+	NewName string `yaml:"newName"`
+	NewTag  string `yaml:"newTag"`
+	Digest  string `yaml:"digest"`
+}
+
+type patch struct {
+	Path   string  `yaml:"path"`
+	Patch  string  `yaml:"patch"`
+	Target *target `yaml:"target"`
+}
+
+type target struct {
+	Kind string `yaml:"kind"`
+	Name string `yaml:"name"`
+}
+
+type patchOperation struct {
+	Op    string    `yaml:"op"`
+	Path  string    `yaml:"path"`
+	Value yaml.Node `yaml:"value"`
+}
+
+type sourceResource struct {
+	source       string
+	originalName string
+	resource     *manifest.Resource
+}
+
+type kustomizationBuilder struct {
+	used    []string
+	ignored []string
+}
+
+// GetKustomizationFile returns the kustomization file of directory, or empty when the directory is not a kustomization
+func GetKustomizationFile(dir string) string {
+	for _, name := range []string{"kustomization.yaml", "kustomization.yml", "Kustomization"} {
+		if info, err := os.Stat(filepath.Join(dir, name)); err == nil && !info.IsDir() {
+			return filepath.Join(dir, name)
+		}
+	}
+
+	return ""
+}
+
+// RenderKustomization builds the kustomization like kustomize build does, without downloading remote bases. The
+// nodes of resources keep the lines of their files and the nodes added by patches are searched in the resource file
+func RenderKustomization(dir string) (*Kustomization, error) {
+	builder := &kustomizationBuilder{}
+	resources, err := builder.build(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(builder.ignored)
+	files, err := groupBySource(resources)
+	return &Kustomization{Files: files, Used: builder.used, Ignored: builder.ignored}, err
+}
+
+func (b *kustomizationBuilder) build(dir string) ([]*sourceResource, error) {
+	path := GetKustomizationFile(dir)
+	if path == "" {
+		return nil, fmt.Errorf("%s: %w", dir, ErrKustomizationNotFound)
+	}
+
+	config := &kustomizationConfig{}
+	if err := b.readYAML(path, config); err != nil {
+		return nil, err
+	}
+
+	b.addIgnoredFields(path)
+
+	resources, err := b.loadResources(dir, append(config.Resources, config.Bases...))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.applyPatches(dir, config, resources); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	config.transform(resources)
+	return resources, nil
+}
+
+func (b *kustomizationBuilder) readYAML(path string, value interface{}) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	b.used = append(b.used, path)
+	if err := yaml.Unmarshal(content, value); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// addIgnoredFields adds the fields of kustomization file that are not supported, prefixed by the file path
+func (b *kustomizationBuilder) addIgnoredFields(path string) {
+	fields := map[string]interface{}{}
+	content, _ := ioutil.ReadFile(path)
+	_ = yaml.Unmarshal(content, &fields)
+	for field := range fields {
+		if !supportedFields[field] {
+			b.ignored = append(b.ignored, path+": "+field)
+		}
+	}
+}
+
+func (b *kustomizationBuilder) loadResources(dir string, entries []string) (resources []*sourceResource, err error) {
+	for _, entry := range entries {
+		if isRemote(entry) {
+			continue
+		}
+
+		loaded, err := b.loadResource(filepath.Join(dir, entry))
+		if err != nil {
+			return nil, err
+		}
+
+		resources = append(resources, loaded...)
+	}
+
+	return resources, nil
+}
+
+func (b *kustomizationBuilder) loadResource(path string) ([]*sourceResource, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return b.build(path)
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	b.used = append(b.used, path)
+	resources, err := manifest.ParseResources(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return newSourceResources(path, resources), nil
+}
+
+func newSourceResources(source string, resources []*manifest.Resource) (sourceResources []*sourceResource) {
+	for _, resource := range resources {
+		sourceResources = append(sourceResources,
+			&sourceResource{source: source, originalName: resource.Name, resource: resource})
+	}
+
+	return sourceResources
+}
+
+func isRemote(entry string) bool {
+	return strings.Contains(entry, "://") || strings.Contains(entry, "?ref=") || strings.HasPrefix(entry, "github.com/")
+}
+
+func (b *kustomizationBuilder) applyPatches(dir string, config *kustomizationConfig,
+	resources []*sourceResource) error {
+	for _, entry := range config.PatchesStrategicMerge {
+		if err := b.applyPatch(dir, &patch{Path: entry}, resources); err != nil {
+			return err
+		}
+	}
+
+	patches := append(config.Patches, config.PatchesJSON6902...)
+	for index := range patches {
+		if err := b.applyPatch(dir, &patches[index], resources); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyPatch applies a strategic merge patch, or a json 6902 patch when its content is a list of operations. The
+// strategic merge patches without target are applied to the resources with the same kind and name of patch
+func (b *kustomizationBuilder) applyPatch(dir string, kustomizePatch *patch, resources []*sourceResource) error {
+	documents, err := b.loadPatchDocuments(dir, kustomizePatch)
+	if err != nil {
+		return err
+	}
+
+	for _, document := range documents {
+		manifest.ClearLines(document)
+		if err := applyPatchDocument(document, kustomizePatch.Target, resources); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *kustomizationBuilder) loadPatchDocuments(dir string, kustomizePatch *patch) ([]*yaml.Node, error) {
+	content := []byte(kustomizePatch.Patch)
+	if kustomizePatch.Path != "" && strings.Contains(kustomizePatch.Path, "\n") {
+		content = []byte(kustomizePatch.Path)
+	} else if kustomizePatch.Path != "" {
+		path := filepath.Join(dir, kustomizePatch.Path)
+		fileContent, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		b.used = append(b.used, path)
+		content = fileContent
+	}
+
+	return decodeDocuments(content)
+}
+
+func decodeDocuments(content []byte) (documents []*yaml.Node, err error) {
+	for _, part := range strings.Split(string(content), "\n---") {
+		document := &yaml.Node{}
+		if err := yaml.Unmarshal([]byte(part), document); err != nil {
+			return nil, err
+		}
+
+		if len(document.Content) > 0 {
+			documents = append(documents, document.Content[0])
+		}
+	}
+
+	return documents, nil
+}
+
+func applyPatchDocument(document *yaml.Node, patchTarget *target, resources []*sourceResource) error {
+	if patchTarget == nil {
+		patchResource := manifest.NewResource(document)
+		patchTarget = &target{Kind: patchResource.Kind, Name: patchResource.Name}
+	}
+
+	for _, resource := range resources {
+		if !patchTarget.match(resource) {
+			continue
+		}
+
+		if document.Kind != yaml.SequenceNode {
+			mergeNode(resource.resource.Node, document)
+			continue
+		}
+
+		if err := applyOperations(resource.resource.Node, document); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t *target) match(resource *sourceResource) bool {
+	return (t.Kind == "" || t.Kind == resource.resource.Kind) &&
+		(t.Name == "" || t.Name == resource.originalName || t.Name == resource.resource.Name)
+}
+
+// mergeNode merges src into dst like a strategic merge patch, the lists with items with name, like containers and
+// volumes, are merged by name and null values remove the keys
+func mergeNode(dst, src *yaml.Node) {
+	for index := 0; index+1 < len(src.Content); index += 2 {
+		key, value := src.Content[index], src.Content[index+1]
+		dstValue := manifest.GetValue(dst, key.Value)
+
+		switch {
+		case value.Tag == "!!null":
+			removeKey(dst, key.Value)
+		case dstValue == nil:
+			dst.Content = append(dst.Content, key, value)
+		case dstValue.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			mergeNode(dstValue, value)
+		case dstValue.Kind == yaml.SequenceNode && value.Kind == yaml.SequenceNode && isNamedList(value):
+			mergeNamedList(dstValue, value)
+		default:
+			*dstValue = *value
+		}
+	}
+}
+
+func isNamedList(node *yaml.Node) bool {
+	for _, item := range node.Content {
+		if manifest.GetValue(item, "name") == nil {
+			return false
+		}
+	}
+
+	return len(node.Content) > 0
+}
+
+func mergeNamedList(dst, src *yaml.Node) {
+	for _, item := range src.Content {
+		if dstItem := getItemByName(dst, manifest.GetValue(item, "name").Value); dstItem != nil {
+			mergeNode(dstItem, item)
+		} else {
+			dst.Content = append(dst.Content, item)
+		}
+	}
+}
+
+func getItemByName(node *yaml.Node, name string) *yaml.Node {
+	for _, item := range node.Content {
+		if itemName := manifest.GetValue(item, "name"); itemName != nil && itemName.Value == name {
+			return item
+		}
+	}
+
+	return nil
+}
+
+func removeKey(node *yaml.Node, key string) {
+	for index := 0; index+1 < len(node.Content); index += 2 {
+		if node.Content[index].Value == key {
+			node.Content = append(node.Content[:index], node.Content[index+2:]...)
+			return
+		}
+	}
+}
+
+func applyOperations(node, document *yaml.Node) error {
+	var operations []patchOperation
+	if err := document.Decode(&operations); err != nil {
+		return err
+	}
+
+	for index := range operations {
+		if err := applyOperation(node, &operations[index]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// applyOperation applies the add, replace and remove operations of json patch, which are used to change manifests
+func applyOperation(node *yaml.Node, operation *patchOperation) error {
+	keys := splitPatchPath(operation.Path)
+	parent := getNodeByPath(node, keys[:len(keys)-1])
+	if parent == nil {
+		return fmt.Errorf("%s: %w", operation.Path, ErrPatchPathNotFound)
+	}
+
+	value := &operation.Value
+	switch operation.Op {
+	case "add", "replace":
+		return setChild(parent, keys[len(keys)-1], value, operation.Op == "add")
+	case "remove":
+		return removeChild(parent, keys[len(keys)-1])
+	default:
+		return fmt.Errorf("%s: %w", operation.Op, ErrInvalidPatchOperation)
+	}
+}
+
+func splitPatchPath(path string) (keys []string) {
+	for _, key := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		keys = append(keys, strings.ReplaceAll(strings.ReplaceAll(key, "~1", "/"), "~0", "~"))
+	}
+
+	return keys
+}
+
+func getNodeByPath(node *yaml.Node, keys []string) *yaml.Node {
+	for _, key := range keys {
+		if node = getChild(node, key); node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
+
+func getChild(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind == yaml.MappingNode {
+		return manifest.GetValue(node, key)
+	}
+
+	if index, ok := getIndex(node, key); ok && index < len(node.Content) {
+		return node.Content[index]
+	}
+
+	return nil
+}
+
+func getIndex(node *yaml.Node, key string) (int, bool) {
+	if node.Kind != yaml.SequenceNode {
+		return 0, false
+	}
+
+	if key == "-" {
+		return len(node.Content), true
+	}
+
+	var index int
+	_, err := fmt.Sscanf(key, "%d", &index)
+	return index, err == nil && index >= 0 && index <= len(node.Content)
+}
+
+func setChild(parent *yaml.Node, key string, value *yaml.Node, isToInsert bool) error {
+	if parent.Kind == yaml.MappingNode {
+		if current := manifest.GetValue(parent, key); current != nil {
+			*current = *value
+		} else {
+			parent.Content = append(parent.Content, newScalar(key), value)
+		}
+
+		return nil
+	}
+
+	index, ok := getIndex(parent, key)
+	switch {
+	case !ok:
+		return fmt.Errorf("%s: %w", key, ErrPatchPathNotFound)
+	case isToInsert:
+		parent.Content = append(parent.Content[:index], append([]*yaml.Node{value}, parent.Content[index:]...)...)
+	case index < len(parent.Content):
+		parent.Content[index] = value
+	default:
+		return fmt.Errorf("%s: %w", key, ErrPatchPathNotFound)
+	}
+
+	return nil
+}
+
+func removeChild(parent *yaml.Node, key string) error {
+	if parent.Kind == yaml.MappingNode {
+		removeKey(parent, key)
+		return nil
+	}
+
+	index, ok := getIndex(parent, key)
+	if !ok || index >= len(parent.Content) {
+		return fmt.Errorf("%s: %w", key, ErrPatchPathNotFound)
+	}
+
+	parent.Content = append(parent.Content[:index], parent.Content[index+1:]...)
+	return nil
+}
+
+func newScalar(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+// transform applies the transformers of kustomization that are used by the rules, references between resources,
+// like the service account of pods, are not renamed by the name prefix and suffix
+func (c *kustomizationConfig) transform(resources []*sourceResource) {
+	for _, source := range resources {
+		resource := source.resource
+		c.transformName(resource)
+		if c.Namespace != "" && !isClusterScoped(resource.Kind) {
+			setScalar(resource.Node, c.Namespace, "metadata", "namespace")
+		}
+
+		c.transformMetadata(resource.Node)
+		if podTemplate := resource.GetPodTemplate(); podTemplate != nil && podTemplate != resource.Node {
+			c.transformMetadata(podTemplate)
+		}
+
+		c.transformImages(resource)
+	}
+}
+
+func (c *kustomizationConfig) transformName(resource *manifest.Resource) {
+	if (c.NamePrefix == "" && c.NameSuffix == "") || resource.Name == "" {
+		return
+	}
+
+	resource.Name = c.NamePrefix + resource.Name + c.NameSuffix
+	setScalar(resource.Node, resource.Name, "metadata", "name")
+}
+
+func (c *kustomizationConfig) transformMetadata(node *yaml.Node) {
+	for key, value := range c.CommonLabels {
+		setScalar(node, value, "metadata", "labels", key)
+	}
+
+	for key, value := range c.CommonAnnotations {
+		setScalar(node, value, "metadata", "annotations", key)
+	}
+}
+
+func (c *kustomizationConfig) transformImages(resource *manifest.Resource) {
+	for _, container := range resource.GetContainers() {
+		containerImage := manifest.GetValue(container, "image")
+		if containerImage == nil {
+			continue
+		}
+
+		for _, newImage := range c.Images {
+			if getImageName(containerImage.Value) == newImage.Name {
+				containerImage.Value = newImage.apply(containerImage.Value)
+			}
+		}
+	}
+}
+
+func getImageName(value string) string {
+	if index := strings.Index(value, "@"); index >= 0 {
+		value = value[:index]
+	}
+
+	if index := strings.LastIndex(value, ":"); index > strings.LastIndex(value, "/") {
+		value = value[:index]
+	}
+
+	return value
+}
+
+func (i *image) apply(value string) string {
+	name, tag := getImageName(value), strings.TrimPrefix(value, getImageName(value))
+	if i.NewName != "" {
+		name = i.NewName
+	}
+
+	switch {
+	case i.Digest != "":
+		tag = "@" + i.Digest
+	case i.NewTag != "":
+		tag = ":" + i.NewTag
+	}
+
+	return name + tag
+}
+
+func isClusterScoped(kind string) bool {
+	for _, clusterScopedKind := range []string{"Namespace", "ClusterRole", "ClusterRoleBinding", "PersistentVolume",
+		"StorageClass", "CustomResourceDefinition", "PodSecurityPolicy", "PriorityClass",
+		"MutatingWebhookConfiguration", "ValidatingWebhookConfiguration"} {
+		if kind == clusterScopedKind {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setScalar sets the value in the path of keys, creating the missing objects
+func setScalar(node *yaml.Node, value string, keys ...string) {
+	for _, key := range keys[:len(keys)-1] {
+		child := manifest.GetValue(node, key)
+		if child == nil || child.Kind != yaml.MappingNode {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			removeKey(node, key)
+			node.Content = append(node.Content, newScalar(key), child)
+		}
+
+		node = child
+	}
+
+	if current := manifest.GetValue(node, keys[len(keys)-1]); current != nil {
+		current.Kind, current.Tag, current.Value = yaml.ScalarNode, "!!str", value
+		return
+	}
+
+	node.Content = append(node.Content, newScalar(keys[len(keys)-1]), newScalar(value))
+}
+
+func groupBySource(resources []*sourceResource) (files []*manifest.File, err error) {
+	var sources []string
+	resourcesBySource := map[string][]*manifest.Resource{}
+	for _, resource := range resources {
+		if _, ok := resourcesBySource[resource.source]; !ok {
+			sources = append(sources, resource.source)
+		}
+
+		resourcesBySource[resource.source] = append(resourcesBySource[resource.source], resource.resource)
+	}
+
+	for _, source := range sources {
+		content, err := ioutil.ReadFile(source)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, manifest.NewRenderedFile(source, content, resourcesBySource[source]))
+	}
+
+	return files, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package render
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"github.com/stretchr/testify/assert"
+)
+
+const baseDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          image: nginx:1.19
+        - name: sidecar
+          image: busybox
+`
+
+func getKustomizationFiles() map[string]string {
+	return map[string]string{
+		"base/kustomization.yaml": "resources:\n  - deployment.yaml\n  - https://github.com/org/repo//base?ref=v1\n",
+		"base/deployment.yaml":    baseDeployment,
+		"overlay/kustomization.yaml": `namePrefix: prod-
+namespace: production
+commonLabels:
+  env: prod
+images:
+  - name: nginx
+    newTag: "1.20"
+bases:
+  - ../base
+patchesStrategicMerge:
+  - privileged.yaml
+patchesJson6902:
+  - target:
+      kind: Deployment
+      name: app
+    patch: |-
+      - op: add
+        path: /spec/template/spec/hostNetwork
+        value: true
+      - op: remove
+        path: /spec/template/spec/containers/1
+`,
+		"overlay/privileged.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+          securityContext:
+            privileged: true
+`,
+	}
+}
+
+func TestGetKustomizationFile(t *testing.T) {
+	t.Run("should return kustomization file of directory", func(t *testing.T) {
+		dir := createFiles(t, getKustomizationFiles())
+
+		assert.Equal(t, filepath.Join(dir, "base", "kustomization.yaml"), GetKustomizationFile(filepath.Join(dir, "base")))
+		assert.Empty(t, GetKustomizationFile(dir))
+	})
+}
+
+func TestRenderKustomization(t *testing.T) {
+	t.Run("should render overlay with patches and transformers keeping lines of base", func(t *testing.T) {
+		dir := createFiles(t, getKustomizationFiles())
+
+		kustomization, err := RenderKustomization(filepath.Join(dir, "overlay"))
+
+		assert.NoError(t, err)
+		assert.Len(t, kustomization.Files, 1)
+		assert.Equal(t, filepath.Join(dir, "base", "deployment.yaml"), kustomization.Files[0].Name)
+		assert.Contains(t, kustomization.Used, filepath.Join(dir, "base", "kustomization.yaml"))
+		assert.Contains(t, kustomization.Used, filepath.Join(dir, "overlay", "privileged.yaml"))
+
+		resource := kustomization.Files[0].Resources[0]
+		assert.Equal(t, "prod-app", resource.Name)
+		assert.Equal(t, 2, manifest.GetKey(resource.Node, "kind").Line)
+		assert.Equal(t, "production", manifest.GetValue(resource.Node, "metadata", "namespace").Value)
+		assert.Equal(t, "prod", manifest.GetValue(resource.GetPodMetadata(), "labels", "env").Value)
+		assert.True(t, manifest.IsTrue(manifest.GetValue(resource.GetPodSpec(), "hostNetwork")))
+
+		containers := resource.GetContainers()
+		assert.Len(t, containers, 1)
+		assert.Equal(t, "nginx:1.20", manifest.GetValue(containers[0], "image").Value)
+		privileged := manifest.GetKey(containers[0], "securityContext", "privileged")
+		assert.True(t, manifest.IsTrue(manifest.GetValue(containers[0], "securityContext", "privileged")))
+		assert.Equal(t, 0, privileged.Line)
+	})
+
+	t.Run("should return fields of kustomizations that are not supported", func(t *testing.T) {
+		files := getKustomizationFiles()
+		files["base/kustomization.yaml"] += "replicas:\n  - name: app\n    count: 3\n"
+		files["overlay/kustomization.yaml"] += "configMapGenerator:\n  - name: config\n"
+		dir := createFiles(t, files)
+
+		kustomization, err := RenderKustomization(filepath.Join(dir, "overlay"))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			filepath.Join(dir, "base", "kustomization.yaml") + ": replicas",
+			filepath.Join(dir, "overlay", "kustomization.yaml") + ": configMapGenerator",
+		}, kustomization.Ignored)
+	})
+
+	t.Run("should return no ignored fields when all fields are supported", func(t *testing.T) {
+		kustomization, err := RenderKustomization(filepath.Join(createFiles(t, getKustomizationFiles()), "overlay"))
+
+		assert.NoError(t, err)
+		assert.Empty(t, kustomization.Ignored)
+	})
+
+	t.Run("should return error when directory is not a kustomization", func(t *testing.T) {
+		_, err := RenderKustomization("./not-exists")
+
+		assert.ErrorIs(t, err, ErrKustomizationNotFound)
+	})
+
+	t.Run("should return error when path of json patch does not exist", func(t *testing.T) {
+		files := getKustomizationFiles()
+		files["overlay/privileged.yaml"] = "- op: replace\n  path: /spec/invalid/path\n  value: 1\n"
+		dir := createFiles(t, files)
+
+		_, err := RenderKustomization(filepath.Join(dir, "overlay"))
+
+		assert.ErrorIs(t, err, ErrPatchPathNotFound)
+	})
+}
@@ -15,16 +15,37 @@
 package kubernetes
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/and"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/or"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/pod"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/rbac"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/regular"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/render"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 )
 
+const maxFilesPerUnit = 5
+
 type Interface interface {
 	GetAllRules() (rules []engine.Rule)
+	GetManifestRules() (rules []engine.Rule)
 	GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error)
+	GetManifestUnits(projectPath string) (manifestUnits, textUnits []engine.Unit, err error)
+}
+
+// project has the paths of helm charts, kustomizations and yaml files that are not inside of charts
+type project struct {
+	charts         []string
+	kustomizations []string
+	yamlFiles      []string
 }
 
 type Rules struct{}
@@ -49,14 +70,234 @@ func (r *Rules) GetAllRules() (rules []engine.Rule) {
 	return rules
 }
 
+func (r *Rules) GetManifestRules() (rules []engine.Rule) {
+	for _, rule := range allRulesKubernetesManifest() {
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
 func (r *Rules) GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error) {
-	textUnits, err := text.LoadDirIntoMultiUnit(projectPath, 5, r.getExtensions())
+	textUnits, err := text.LoadDirIntoMultiUnit(projectPath, maxFilesPerUnit, r.getExtensions())
 	if err != nil {
 		return []engine.Unit{}, err
 	}
 	return r.parseTextUnitsToUnits(textUnits), nil
 }
 
+// GetManifestUnits returns the parsed manifests of project, rendering the helm charts and the kustomizations before.
+// The files consumed by kustomizations, like patches, are only analysed in the result of the build. The yaml files
+// that are not valid and the templates of charts that failed to render are returned as text units, so they can still
+// be analysed by the text rules
+func (r *Rules) GetManifestUnits(projectPath string) (manifestUnits, textUnits []engine.Unit, err error) {
+	projectFiles, err := r.walkProject(projectPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	files, chartTextFiles := r.renderHelmCharts(projectFiles.charts)
+	kustomizationFiles, used := r.renderKustomizations(projectFiles.kustomizations)
+	manifestFiles, textFiles := r.parseManifests(projectFiles.yamlFiles, used)
+	return r.newManifestUnits(append(append(files, kustomizationFiles...), manifestFiles...)),
+		r.newTextUnits(append(chartTextFiles, textFiles...)), nil
+}
+
+func (r *Rules) walkProject(projectPath string) (*project, error) {
+	projectFiles := &project{}
+	return projectFiles, filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case info.IsDir() && render.IsHelmChart(path):
+			projectFiles.charts = append(projectFiles.charts, path)
+			return filepath.SkipDir
+		case info.IsDir() && render.GetKustomizationFile(path) != "":
+			projectFiles.kustomizations = append(projectFiles.kustomizations, path)
+		case !info.IsDir() && r.isYAMLFile(path):
+			projectFiles.yamlFiles = append(projectFiles.yamlFiles, path)
+		}
+
+		return nil
+	})
+}
+
+func (r *Rules) isYAMLFile(path string) bool {
+	for _, extension := range r.getExtensions() {
+		if filepath.Ext(path) == extension {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renderHelmCharts returns the rendered files of charts and the template files of the charts that failed to render,
+// because the template functions supported are only part of the functions available in helm
+func (r *Rules) renderHelmCharts(charts []string) (files []*manifest.File, textFiles []string) {
+	for _, chart := range charts {
+		chartFiles, err := render.RenderHelmChart(chart)
+		if err != nil {
+			logger.LogWarnWithLevel("{HORUSEC_CLI} Analysing helm chart only with text rules, the manifest rules "+
+				"were not applied because its render failed: ", err.Error())
+			textFiles = append(textFiles, r.getChartTemplateFiles(chart)...)
+			continue
+		}
+
+		files = append(files, chartFiles...)
+	}
+
+	return files, textFiles
+}
+
+// getChartTemplateFiles returns the yaml files inside of templates folders of chart and of its sub charts
+func (r *Rules) getChartTemplateFiles(chart string) (paths []string) {
+	_ = filepath.Walk(chart, func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() && r.isYAMLFile(path) && r.isInsideTemplatesFolder(chart, path) {
+			paths = append(paths, path)
+		}
+
+		return nil
+	})
+
+	return paths
+}
+
+func (r *Rules) isInsideTemplatesFolder(chart, path string) bool {
+	relativePath, err := filepath.Rel(chart, path)
+	if err != nil {
+		return false
+	}
+
+	for _, folder := range strings.Split(filepath.Dir(relativePath), string(filepath.Separator)) {
+		if folder == render.TemplatesFolder {
+			return true
+		}
+	}
+
+	return false
+}
+
+// renderKustomizations builds only the kustomizations that are not bases of others, because the resources of bases
+// are analysed in the build of the overlays
+func (r *Rules) renderKustomizations(dirs []string) (files []*manifest.File, used map[string]bool) {
+	used = map[string]bool{}
+	var kustomizations []*render.Kustomization
+	for _, dir := range dirs {
+		if kustomization := r.renderKustomization(dir); kustomization != nil {
+			r.setUsedByOthers(used, kustomization, dir)
+			kustomizations = append(kustomizations, kustomization)
+		}
+	}
+
+	for index, kustomization := range kustomizations {
+		if !used[kustomization.Used[0]] {
+			files = append(files, kustomizations[index].Files...)
+		}
+	}
+
+	return files, r.setUsedByKustomizations(used, kustomizations)
+}
+
+// renderKustomization returns nil when the build fails, so its files are analysed as they are without the build
+func (r *Rules) renderKustomization(dir string) *render.Kustomization {
+	kustomization, err := render.RenderKustomization(dir)
+	if err != nil {
+		logger.LogWarnWithLevel("{HORUSEC_CLI} Analysing files of kustomization without its build, the patches "+
+			"and transformers were not applied because its build failed: ", err.Error())
+		return nil
+	}
+
+	if len(kustomization.Ignored) > 0 {
+		logger.LogWarnWithLevel("{HORUSEC_CLI} Fields of kustomization not supported were not applied in its "+
+			"build: ", strings.Join(kustomization.Ignored, ", "))
+	}
+
+	return kustomization
+}
+
+func (r *Rules) setUsedByOthers(used map[string]bool, kustomization *render.Kustomization, dir string) {
+	for _, path := range kustomization.Used {
+		if path != render.GetKustomizationFile(dir) {
+			used[path] = true
+		}
+	}
+}
+
+func (r *Rules) setUsedByKustomizations(used map[string]bool, kustomizations []*render.Kustomization) map[string]bool {
+	for _, kustomization := range kustomizations {
+		for _, path := range kustomization.Used {
+			used[path] = true
+		}
+	}
+
+	return used
+}
+
+func (r *Rules) parseManifests(paths []string, used map[string]bool) (files []*manifest.File, textFiles []string) {
+	for _, path := range paths {
+		if used[path] {
+			continue
+		}
+
+		file, err := r.parseManifest(path)
+		if err != nil {
+			logger.LogDebugWithLevel("{HORUSEC_CLI} Analysing yaml file only with text rules because it is invalid: ",
+				err.Error())
+			textFiles = append(textFiles, path)
+			continue
+		}
+
+		files = append(files, file)
+	}
+
+	return files, textFiles
+}
+
+func (r *Rules) parseManifest(path string) (*manifest.File, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return manifest.NewFile(path, content)
+}
+
+func (r *Rules) newManifestUnits(files []*manifest.File) (units []engine.Unit) {
+	for index := 0; index < len(files); index += maxFilesPerUnit {
+		end := index + maxFilesPerUnit
+		if end > len(files) {
+			end = len(files)
+		}
+
+		units = append(units, manifest.Unit{Files: files[index:end]})
+	}
+
+	return units
+}
+
+func (r *Rules) newTextUnits(paths []string) (units []engine.Unit) {
+	unit := text.TextUnit{}
+	for _, path := range paths {
+		file, err := text.ReadAndCreateTextFile(path)
+		if err != nil {
+			logger.LogWarnWithLevel("{HORUSEC_CLI} Error when read file in Kubernetes analysis: ", err.Error())
+			continue
+		}
+
+		if unit.Files = append(unit.Files, file); len(unit.Files) == maxFilesPerUnit {
+			units = append(units, unit)
+			unit = text.TextUnit{}
+		}
+	}
+
+	if len(unit.Files) > 0 {
+		units = append(units, unit)
+	}
+
+	return units
+}
+
 func (r *Rules) parseTextUnitsToUnits(textUnits []text.TextUnit) (units []engine.Unit) {
 	for index := range textUnits {
 		units = append(units, textUnits[index])
@@ -91,3 +332,22 @@ func allRulesKubernetesOr() []text.TextRule {
 		or.NewKubernetesOrSeccompUnconfined(),
 	}
 }
+
+func allRulesKubernetesManifest() []manifest.Rule {
+	return []manifest.Rule{
+		pod.NewKubernetesPodHostNamespaces(),
+		pod.NewKubernetesPodPrivilegedContainer(),
+		pod.NewKubernetesPodAddedCapabilities(),
+		pod.NewKubernetesPodHostPathVolume(),
+		pod.NewKubernetesPodHostPort(),
+		pod.NewKubernetesPodUnconfinedProfile(),
+		pod.NewKubernetesPodUnmaskedProcMount(),
+		pod.NewKubernetesPodUnsafeSysctls(),
+		pod.NewKubernetesPodRestrictedVolumeTypes(),
+		pod.NewKubernetesPodPrivilegeEscalationAllowed(),
+		pod.NewKubernetesPodRunAsRoot(),
+		pod.NewKubernetesPodSeccompProfileNotSet(),
+		pod.NewKubernetesPodCapabilitiesNotDropped(),
+		rbac.NewKubernetesRBACWildcard(),
+	}
+}
@@ -16,12 +16,43 @@ package kubernetes
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
 	"github.com/stretchr/testify/assert"
 )
 
+const safePod = `apiVersion: v1
+kind: Pod
+metadata:
+  name: safe
+spec:
+  securityContext:
+    runAsNonRoot: true
+    seccompProfile:
+      type: RuntimeDefault
+  volumes:
+    - name: data
+      emptyDir: {}
+  containers:
+    - name: app
+      image: nginx
+      ports:
+        - containerPort: 8080
+      securityContext:
+        allowPrivilegeEscalation: false
+        capabilities:
+          drop: ["ALL"]
+          add: ["NET_BIND_SERVICE"]
+`
+
+const podHeader = "apiVersion: v1\nkind: Pod\nmetadata:\n  name: a\nspec:\n"
+
 func TestNewRules(t *testing.T) {
 	assert.IsType(t, NewRules(), &Rules{})
 }
@@ -66,3 +97,223 @@ func TestRulesEnum(t *testing.T) {
 		assert.Equal(t, len(encountered), lenExpectedTotalRules, "encountered in kubernetes is not equal the expected")
 	})
 }
+
+func TestRules_GetManifestRules(t *testing.T) {
+	t.Run("should return all manifest rules", func(t *testing.T) {
+		rules := NewRules().GetManifestRules()
+
+		for i := range rules {
+			assert.NotNil(t, rules[i].(manifest.Rule).Check)
+			assert.True(t, rules[i].IsFor(engine.StructuredDataUnit))
+		}
+
+		assert.Len(t, rules, 14)
+	})
+}
+
+func TestManifestRulesEnum(t *testing.T) {
+	t.Run("should not exists duplicated ID between text and manifest rules", func(t *testing.T) {
+		encountered := map[string]bool{}
+		for _, rule := range allRulesKubernetesManifest() {
+			assert.False(t, encountered[rule.ID], rule.Name)
+			encountered[rule.ID] = true
+		}
+
+		for _, rule := range NewRules().GetAllRules() {
+			assert.False(t, encountered[rule.(text.TextRule).ID], rule.(text.TextRule).Name)
+		}
+	})
+}
+
+func findManifestRule(t *testing.T, content string, rule manifest.Rule) (lines []int) {
+	file, err := manifest.NewFile("pod.yaml", []byte(content))
+	assert.NoError(t, err)
+
+	findings := engine.Run([]engine.Unit{manifest.Unit{Files: []*manifest.File{file}}}, []engine.Rule{rule})
+	for index := range findings {
+		lines = append(lines, findings[index].SourceLocation.Line)
+	}
+
+	return lines
+}
+
+// nolint:funlen table of vulnerable and safe manifests of all rules
+func TestRules_ManifestFindings(t *testing.T) {
+	testcases := []struct {
+		rule       manifest.Rule
+		vulnerable string
+		lines      []int
+		safe       string
+	}{
+		{
+			rule:       allRulesKubernetesManifest()[0],
+			vulnerable: podHeader + "  hostNetwork: true\n  hostPID: false\n  hostIPC: true\n  containers:\n    - name: app\n",
+			lines:      []int{6, 8},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[1],
+			vulnerable: "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: a\nspec:\n  template:\n    spec:\n      containers:\n        - name: app\n          securityContext:\n            privileged: true\n      initContainers:\n        - name: init\n          securityContext:\n            windowsOptions:\n              hostProcess: true\n",
+			lines:      []int{11, 16},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[2],
+			vulnerable: podHeader + "  containers:\n    - name: app\n      securityContext:\n        capabilities:\n          add:\n            - CHOWN\n            - SYS_ADMIN\n            - cap_net_raw\n",
+			lines:      []int{12, 13},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[3],
+			vulnerable: podHeader + "  volumes:\n    - name: docker\n      hostPath:\n        path: /var/run/docker.sock\n    - name: tmp\n      emptyDir: {}\n",
+			lines:      []int{8},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[4],
+			vulnerable: podHeader + "  containers:\n    - name: app\n      ports:\n        - containerPort: 80\n          hostPort: 80\n        - containerPort: 443\n          hostPort: 0\n",
+			lines:      []int{10},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[5],
+			vulnerable: "apiVersion: v1\nkind: Pod\nmetadata:\n  name: a\n  annotations:\n    container.apparmor.security.beta.kubernetes.io/app: unconfined\nspec:\n  securityContext:\n    seccompProfile:\n      type: Unconfined\n  containers:\n    - name: app\n      securityContext:\n        seLinuxOptions:\n          type: spc_t\n",
+			lines:      []int{10, 15, 6},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[6],
+			vulnerable: podHeader + "  containers:\n    - name: app\n      securityContext:\n        procMount: Unmasked\n",
+			lines:      []int{9},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[7],
+			vulnerable: podHeader + "  securityContext:\n    sysctls:\n      - name: kernel.shm_rmid_forced\n        value: \"0\"\n      - name: net.core.somaxconn\n        value: \"1024\"\n",
+			lines:      []int{10},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[8],
+			vulnerable: podHeader + "  volumes:\n    - name: nfs\n      nfs:\n        server: example.com\n    - name: host\n      hostPath:\n        path: /tmp\n",
+			lines:      []int{8},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[9],
+			vulnerable: podHeader + "  containers:\n    - name: app\n    - name: sidecar\n      securityContext:\n        allowPrivilegeEscalation: true\n",
+			lines:      []int{7, 10},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[10],
+			vulnerable: podHeader + "  securityContext:\n    runAsUser: 0\n  containers:\n    - name: app\n    - name: sidecar\n      securityContext:\n        runAsUser: 1000\n    - name: other\n      securityContext:\n        runAsNonRoot: false\n",
+			lines:      []int{7, 10},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[11],
+			vulnerable: podHeader + "  containers:\n    - name: app\n    - name: sidecar\n      securityContext:\n        seccompProfile:\n          type: Localhost\n",
+			lines:      []int{7},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[12],
+			vulnerable: podHeader + "  containers:\n    - name: app\n      securityContext:\n        capabilities:\n          drop: [\"ALL\"]\n          add: [\"CHOWN\"]\n    - name: sidecar\n",
+			lines:      []int{11, 12},
+			safe:       safePod,
+		},
+		{
+			rule:       allRulesKubernetesManifest()[13],
+			vulnerable: "apiVersion: rbac.authorization.k8s.io/v1\nkind: ClusterRole\nmetadata:\n  name: a\nrules:\n  - apiGroups: [\"\"]\n    resources: [\"*\"]\n    verbs: [\"get\"]\n  - apiGroups: [\"*\"]\n    resources: [\"pods\"]\n    verbs: [\"*\"]\n",
+			lines:      []int{7, 11, 9},
+			safe:       "apiVersion: rbac.authorization.k8s.io/v1\nkind: Role\nmetadata:\n  name: a\nrules:\n  - apiGroups: [\"\"]\n    resources: [\"pods\"]\n    verbs: [\"get\", \"list\"]\n",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(fmt.Sprintf("should find %s", testcase.rule.Name), func(t *testing.T) {
+			assert.Equal(t, testcase.lines, findManifestRule(t, testcase.vulnerable, testcase.rule))
+		})
+
+		t.Run(fmt.Sprintf("should not find %s in safe manifest", testcase.rule.Name), func(t *testing.T) {
+			assert.Empty(t, findManifestRule(t, testcase.safe, testcase.rule))
+		})
+	}
+}
+
+func createProject(t *testing.T, files map[string]string) string {
+	projectPath := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(projectPath, name)), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, name), []byte(content), os.ModePerm))
+	}
+
+	return projectPath
+}
+
+func getFindingsByFile(units []engine.Unit, rules []engine.Rule) map[string][]int {
+	findingsByFile := map[string][]int{}
+	for _, finding := range engine.Run(units, rules) {
+		findingsByFile[finding.SourceLocation.Filename] = append(findingsByFile[finding.SourceLocation.Filename],
+			finding.SourceLocation.Line)
+	}
+
+	return findingsByFile
+}
+
+// nolint:funlen project with all kinds of manifests
+func TestRules_GetManifestUnits(t *testing.T) {
+	t.Run("should return units of manifests, charts and kustomizations mapped to source files", func(t *testing.T) {
+		projectPath := createProject(t, map[string]string{
+			"pod.yaml":                       podHeader + "  hostPID: true\n",
+			"invalid.yaml":                   "hostPID: true\n  invalid: [\n",
+			"config.yml":                     "key: value\n",
+			"chart/Chart.yaml":               "name: chart\n",
+			"chart/values.yaml":              "hostNetwork: true\n",
+			"chart/templates/pod.yaml":       "apiVersion: v1\nkind: Pod\nmetadata:\n  name: {{ .Release.Name }}\nspec:\n  hostNetwork: {{ .Values.hostNetwork }}\n",
+			"k8s/base/kustomization.yaml":    "resources:\n  - pod.yaml\n",
+			"k8s/base/pod.yaml":              podHeader + "  containers:\n    - name: app\n",
+			"k8s/overlay/kustomization.yaml": "resources:\n  - ../base\npatchesStrategicMerge:\n  - patch.yaml\n",
+			"k8s/overlay/patch.yaml":         podHeader + "  hostIPC: true\n",
+		})
+
+		manifestUnits, textUnits, err := NewRules().GetManifestUnits(projectPath)
+
+		assert.NoError(t, err)
+		assert.Len(t, manifestUnits, 1)
+		assert.Len(t, textUnits, 1)
+		assert.Equal(t, filepath.Join(projectPath, "invalid.yaml"), textUnits[0].(text.TextUnit).Files[0].PhysicalPath)
+		assert.Equal(t, map[string][]int{
+			filepath.Join(projectPath, "pod.yaml"):                 {6},
+			filepath.Join(projectPath, "chart/templates/pod.yaml"): {6},
+			filepath.Join(projectPath, "k8s/base/pod.yaml"):        {2},
+		}, getFindingsByFile(manifestUnits, []engine.Rule{allRulesKubernetesManifest()[0]}))
+	})
+
+	t.Run("should return templates as text units when helm chart render fails", func(t *testing.T) {
+		projectPath := createProject(t, map[string]string{
+			"chart/Chart.yaml":          "name: chart\n",
+			"chart/values.yaml":         "image: nginx\n",
+			"chart/templates/NOTES.txt": "{{ .Release.Name }}\n",
+			"chart/templates/pod.yaml": "apiVersion: v1\nkind: Pod\nmetadata:\n  name: {{ .Values.image | sha1sum }}\n" +
+				"spec:\n  containers:\n    - name: app\n      securityContext:\n        privileged: true\n",
+		})
+
+		manifestUnits, textUnits, err := NewRules().GetManifestUnits(projectPath)
+
+		assert.NoError(t, err)
+		assert.Empty(t, manifestUnits)
+		assert.Len(t, textUnits, 1)
+		assert.Len(t, textUnits[0].(text.TextUnit).Files, 1)
+		assert.Equal(t, filepath.Join(projectPath, "chart/templates/pod.yaml"),
+			textUnits[0].(text.TextUnit).Files[0].PhysicalPath)
+		assert.NotEmpty(t, engine.Run(textUnits, NewRules().GetAllRules()))
+	})
+
+	t.Run("should return error when path not exists", func(t *testing.T) {
+		_, _, err := NewRules().GetManifestUnits("./not-exists")
+
+		assert.Error(t, err)
+	})
+}
@@ -361,7 +361,7 @@ func NewLeaksRegularStripeAPIKey() text.TextRule {
 		},
 		Type: text.Regular,
 		Expressions: []*regexp.Regexp{
-			regexp.MustCompile(`(?i)stripe(.{0,20})?[sr]k_live_[0-9a-zA-Z]{24}`),
+			regexp.MustCompile(`(?i)stripe(.{0,20})?[sr]k_(live|test)_[0-9a-zA-Z]{24}`),
 		},
 	}
 }
@@ -481,3 +481,51 @@ func NewLeaksRegularWPConfig() text.TextRule {
 		},
 	}
 }
+
+func NewLeaksRegularGithubToken() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "37fd821b-81bd-47d2-a86a-1e568ec59b90",
+			Name:        "Github token",
+			Description: "A GitHub personal access, OAuth, user-to-server, server-to-server or refresh token was found. This pose a critical threat against your organization since it can give access to the platform and the source code of your applications. Revoke the token and load it from a vault or an environment variable. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:    severity.Critical.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\bgh[pousr]_[0-9A-Za-z]{36}\b`),
+		},
+	}
+}
+
+func NewLeaksRegularJWT() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "5ce1345f-9a7a-4381-a6e1-2e87a32b8e49",
+			Name:        "JSON Web Token",
+			Description: "A JSON Web Token was found. Tokens without expiration or with a long lifetime can be used by anyone who has access to the source code to authenticate in the application. Load it from a vault or an environment variable. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\beyJ[0-9A-Za-z_-]{10,}\.eyJ[0-9A-Za-z_-]{10,}\.[0-9A-Za-z_-]*`),
+		},
+	}
+}
+
+func NewLeaksRegularGenericHighEntropySecret() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "d012dc67-fc0c-4111-a235-3e8fc52c99b6",
+			Name:        "Generic high entropy secret",
+			Description: "A random looking value is assigned to a credential like identifier (secret, token, api key, access key, private key or credential). Values with high Shannon entropy are usually real secrets generated by a service. Load it from a vault or an environment variable. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)[\w.-]*(secret|token|api_?key|access_?key|private_?key|auth_?key|credential)[\w.-]*['"]?\s*(=|:=|:|=>)\s*['"](?P<secret>[0-9a-zA-Z+/=_.-]{16,128})['"]`),
+		},
+	}
+}
@@ -16,20 +16,37 @@
 package leaks
 
 import (
+	"regexp"
+
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks/regular"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks/validator"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
 )
 
+const secretGroupName = "secret"
+
+var quotedValueExpression = regexp.MustCompile(`['"]([^'"]*)['"]`)
+
 type Interface interface {
 	GetAllRules() (rules []engine.Rule)
 	GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error)
+	ValidateFindings(findings []engine.Finding) []engine.Finding
 }
 
-type Rules struct{}
+type Rules struct {
+	rulesByID     map[string]text.TextRule
+	providersByID map[string]*validator.Provider
+	entropyRuleID string
+}
 
 func NewRules() Interface {
-	return &Rules{}
+	return &Rules{
+		rulesByID:     mapRulesByID(),
+		providersByID: mapProvidersByRuleID(),
+		entropyRuleID: regular.NewLeaksRegularGenericHighEntropySecret().ID,
+	}
 }
 
 func (r *Rules) GetAllRules() (rules []engine.Rule) {
@@ -45,6 +62,81 @@ func (r *Rules) addLeaksRules(rules []engine.Rule) []engine.Rule {
 	return rules
 }
 
+// ValidateFindings removes findings of placeholder values and of generic secrets with low entropy, and changes
+// the confidence of the remaining ones according to the offline validation of the provider and the file location
+func (r *Rules) ValidateFindings(findings []engine.Finding) (validated []engine.Finding) {
+	for index := range findings {
+		rule, ok := r.rulesByID[findings[index].ID]
+		if !ok {
+			validated = append(validated, findings[index])
+			continue
+		}
+
+		secret := r.getSecret(rule, findings[index].CodeSample)
+		if validator.IsPlaceholder(secret) || (rule.ID == r.entropyRuleID && !r.isGenericSecret(secret)) {
+			continue
+		}
+
+		findings[index].Confidence = r.getConfidence(&findings[index])
+		validated = append(validated, findings[index])
+	}
+
+	return validated
+}
+
+// isGenericSecret checks if the value is random enough and is not a token already reported by a provider rule
+func (r *Rules) isGenericSecret(secret string) bool {
+	for _, provider := range r.providersByID {
+		if provider.Expression.MatchString(secret) {
+			return false
+		}
+	}
+
+	return validator.IsHighEntropy(secret)
+}
+
+func (r *Rules) getSecret(rule text.TextRule, codeSample string) string {
+	for _, expression := range rule.Expressions {
+		match := expression.FindStringSubmatch(codeSample)
+		if match == nil {
+			continue
+		}
+
+		if index := expression.SubexpIndex(secretGroupName); index > 0 {
+			return match[index]
+		}
+
+		return r.getLastQuotedValue(match[0])
+	}
+
+	return codeSample
+}
+
+func (r *Rules) getLastQuotedValue(match string) string {
+	quotedValues := quotedValueExpression.FindAllStringSubmatch(match, -1)
+	if len(quotedValues) == 0 {
+		return match
+	}
+
+	return quotedValues[len(quotedValues)-1][1]
+}
+
+func (r *Rules) getConfidence(finding *engine.Finding) string {
+	if provider, ok := r.providersByID[finding.ID]; ok {
+		if found, valid := provider.Validate(finding.CodeSample); found && valid {
+			return confidence.High.ToString()
+		} else if found {
+			return confidence.Low.ToString()
+		}
+	}
+
+	if validator.IsTestFixture(finding.SourceLocation.Filename) {
+		return confidence.Low.ToString()
+	}
+
+	return finding.Confidence
+}
+
 func (r *Rules) GetTextUnitByRulesExt(projectPath string) ([]engine.Unit, error) {
 	textUnits, err := text.LoadDirIntoMultiUnit(projectPath, 5, r.getExtensions())
 	units := r.parseTextUnitsToUnits(textUnits)
@@ -93,6 +185,9 @@ func allRulesLeaksRegular() []text.TextRule {
 		regular.NewLeaksRegularHardCodedPassword(),
 		regular.NewLeaksRegularPasswordExposedInHardcodedURL(),
 		regular.NewLeaksRegularWPConfig(),
+		regular.NewLeaksRegularGithubToken(),
+		regular.NewLeaksRegularJWT(),
+		regular.NewLeaksRegularGenericHighEntropySecret(),
 	}
 }
 
@@ -103,3 +198,25 @@ func allRulesLeaksAnd() []text.TextRule {
 func allRulesLeaksOr() []text.TextRule {
 	return []text.TextRule{}
 }
+
+func mapRulesByID() map[string]text.TextRule {
+	rulesByID := map[string]text.TextRule{}
+	for _, rule := range allRulesLeaksRegular() {
+		rulesByID[rule.ID] = rule
+	}
+
+	return rulesByID
+}
+
+func mapProvidersByRuleID() map[string]*validator.Provider {
+	github := validator.NewGithubProvider()
+
+	return map[string]*validator.Provider{
+		regular.NewLeaksRegularGithub().ID:       github,
+		regular.NewLeaksRegularGithubToken().ID:  github,
+		regular.NewLeaksRegularAWSManagerID().ID: validator.NewAWSAccessKeyIDProvider(),
+		regular.NewLeaksRegularSlack().ID:        validator.NewSlackProvider(),
+		regular.NewLeaksRegularStripeAPIKey().ID: validator.NewStripeProvider(),
+		regular.NewLeaksRegularJWT().ID:          validator.NewJWTProvider(),
+	}
+}
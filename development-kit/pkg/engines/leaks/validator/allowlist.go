@@ -0,0 +1,69 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var placeholders = []string{
+	"example", "sample", "dummy", "placeholder", "changeme", "change_me", "change-me", "your_", "your-",
+	"yourkey", "yoursecret", "yourtoken", "xxxx", "****", "redacted", "lorem", "foobar", "fake", "mock",
+	"<", "${", "{{", "%s",
+}
+
+var testFixturePaths = []string{
+	"/test/", "/tests/", "/testdata/", "/fixtures/", "/fixture/", "/__tests__/", "/__mocks__/", "/mocks/",
+	"/spec/", "/examples/", "/example/", "/samples/",
+}
+
+const maxDistinctCharsOfPlaceholder = 2
+
+var testFixtureFileExpression = regexp.MustCompile(`(?i)([._-](test|spec|mock|example|sample)s?\.\w+$|^test_\w+\.py$|\.(example|sample|template|dist)$)`)
+
+// IsPlaceholder checks if the value is a well known placeholder used in documentation and templates
+func IsPlaceholder(value string) bool {
+	lowerValue := strings.ToLower(value)
+	for _, placeholder := range placeholders {
+		if strings.Contains(lowerValue, placeholder) {
+			return true
+		}
+	}
+
+	return isRepeatedChars(value)
+}
+
+func isRepeatedChars(value string) bool {
+	distinctChars := map[rune]bool{}
+	for _, char := range value {
+		distinctChars[char] = true
+	}
+
+	return len(distinctChars) <= maxDistinctCharsOfPlaceholder
+}
+
+// IsTestFixture checks if the file is part of the tests, fixtures or examples of the project
+func IsTestFixture(filename string) bool {
+	path := "/" + strings.ToLower(filepath.ToSlash(filename))
+	for _, testPath := range testFixturePaths {
+		if strings.Contains(path, testPath) {
+			return true
+		}
+	}
+
+	return testFixtureFileExpression.MatchString(filepath.Base(path))
+}
@@ -0,0 +1,63 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"math"
+	"regexp"
+)
+
+const (
+	minHexEntropy    = 3.0
+	minBase64Entropy = 3.5
+)
+
+var (
+	hexExpression    = regexp.MustCompile(`^[0-9a-fA-F]+$`)
+	digitExpression  = regexp.MustCompile(`[0-9]`)
+	letterExpression = regexp.MustCompile(`[a-zA-Z]`)
+)
+
+// ShannonEntropy returns the amount of bits of information per character of the value
+func ShannonEntropy(value string) (entropy float64) {
+	if value == "" {
+		return 0
+	}
+
+	frequencies := map[rune]float64{}
+	for _, char := range value {
+		frequencies[char]++
+	}
+
+	length := float64(len([]rune(value)))
+	for _, frequency := range frequencies {
+		probability := frequency / length
+		entropy -= probability * math.Log2(probability)
+	}
+
+	return entropy
+}
+
+// IsHighEntropy checks if the value is random enough to be a secret, hexadecimal values have a lower
+// threshold since their alphabet only allows 4 bits of entropy per character. Other values must also mix
+// letters and digits, which avoids reporting identifiers and sentences written in natural language
+func IsHighEntropy(value string) bool {
+	if hexExpression.MatchString(value) {
+		return ShannonEntropy(value) > minHexEntropy
+	}
+
+	return digitExpression.MatchString(value) && letterExpression.MatchString(value) &&
+		ShannonEntropy(value) > minBase64Entropy
+}
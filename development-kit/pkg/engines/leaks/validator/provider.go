@@ -0,0 +1,173 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validator
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"hash/crc32"
+	"regexp"
+	"strings"
+)
+
+const (
+	base62Alphabet       = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+	githubChecksumLength = 6
+	githubPrefixLength   = 4
+	jwtSegmentsLength    = 3
+	awsAccessKeyIDLength = 20
+)
+
+var (
+	awsAccessKeyIDExpression = regexp.MustCompile(`^(AKIA|ASIA|AGPA|AIDA|AROA|AIPA|ANPA|ANVA|APKA|ABIA|ACCA)[A-Z2-7]{16}$`)
+	slackWebhookExpression   = regexp.MustCompile(`^https://hooks\.slack\.com/services/T[0-9A-Z]{8,}/B[0-9A-Z]{8,}/[0-9A-Za-z]{24}$`)
+	slackTokenExpression     = regexp.MustCompile(`^xox[baprs]-([0-9]{6,}-){1,3}[0-9A-Za-z]{24,}$`)
+	stripeLiveKeyExpression  = regexp.MustCompile(`^[sr]k_live_[0-9a-zA-Z]{24,}$`)
+)
+
+// Provider locates a token of a specific service inside a code sample and checks offline
+// if its structure or checksum is valid, without calling the service
+type Provider struct {
+	Name       string
+	Expression *regexp.Regexp
+	IsValid    func(token string) bool
+}
+
+// Validate returns if a token of the provider was found in the code sample and if it is valid
+func (p *Provider) Validate(codeSample string) (found, valid bool) {
+	token := p.Expression.FindString(codeSample)
+	if token == "" {
+		return false, false
+	}
+
+	return true, p.IsValid(token)
+}
+
+func NewGithubProvider() *Provider {
+	return &Provider{
+		Name:       "GitHub",
+		Expression: regexp.MustCompile(`gh[pousr]_[0-9A-Za-z]{36}`),
+		IsValid:    IsValidGithubToken,
+	}
+}
+
+func NewAWSAccessKeyIDProvider() *Provider {
+	return &Provider{
+		Name:       "AWS",
+		Expression: regexp.MustCompile(`(A3T[A-Z0-9]|AKIA|AGPA|AIDA|AROA|AIPA|ANPA|ANVA|ASIA|APKA|ABIA|ACCA)[A-Z0-9]{16}`),
+		IsValid:    IsValidAWSAccessKeyID,
+	}
+}
+
+func NewSlackProvider() *Provider {
+	return &Provider{
+		Name:       "Slack",
+		Expression: regexp.MustCompile(`xox[baprs]-[0-9A-Za-z-]+|https://hooks\.slack\.com/services/[0-9A-Za-z_/]+`),
+		IsValid:    IsValidSlackToken,
+	}
+}
+
+func NewStripeProvider() *Provider {
+	return &Provider{
+		Name:       "Stripe",
+		Expression: regexp.MustCompile(`[sr]k_(live|test)_[0-9a-zA-Z]+`),
+		IsValid:    IsValidStripeKey,
+	}
+}
+
+func NewJWTProvider() *Provider {
+	return &Provider{
+		Name:       "JWT",
+		Expression: regexp.MustCompile(`eyJ[0-9A-Za-z_-]+\.eyJ[0-9A-Za-z_-]+\.[0-9A-Za-z_-]*`),
+		IsValid:    IsValidJWT,
+	}
+}
+
+// IsValidGithubToken checks the CRC32 checksum present in the last 6 characters of the new github token formats
+func IsValidGithubToken(token string) bool {
+	if len(token) <= githubPrefixLength+githubChecksumLength {
+		return false
+	}
+
+	entropy := token[githubPrefixLength : len(token)-githubChecksumLength]
+	checksum := token[len(token)-githubChecksumLength:]
+
+	return checksum == encodeBase62(crc32.ChecksumIEEE([]byte(entropy)), githubChecksumLength)
+}
+
+func encodeBase62(value uint32, length int) string {
+	var encoded []byte
+	for value > 0 {
+		encoded = append([]byte{base62Alphabet[value%62]}, encoded...)
+		value /= 62
+	}
+
+	for len(encoded) < length {
+		encoded = append([]byte{'0'}, encoded...)
+	}
+
+	return string(encoded)
+}
+
+// IsValidAWSAccessKeyID checks if the key id has a known resource prefix followed by base32 characters
+func IsValidAWSAccessKeyID(token string) bool {
+	return len(token) == awsAccessKeyIDLength && awsAccessKeyIDExpression.MatchString(token)
+}
+
+// IsValidSlackToken checks if the token or webhook follows the segments used by slack
+func IsValidSlackToken(token string) bool {
+	if strings.HasPrefix(token, "https://") {
+		return slackWebhookExpression.MatchString(token)
+	}
+
+	return slackTokenExpression.MatchString(token)
+}
+
+// IsValidStripeKey checks if the key is a secret or restricted key of live mode, test mode keys
+// can't move real money and are considered not valid
+func IsValidStripeKey(token string) bool {
+	return stripeLiveKeyExpression.MatchString(token)
+}
+
+// IsValidJWT checks if the header and the payload of the token are base64url encoded json objects
+// and the header contains the algorithm used to sign it
+func IsValidJWT(token string) bool {
+	segments := strings.Split(token, ".")
+	if len(segments) != jwtSegmentsLength {
+		return false
+	}
+
+	header := map[string]interface{}{}
+	if err := decodeJWTSegment(segments[0], &header); err != nil {
+		return false
+	}
+
+	payload := map[string]interface{}{}
+	if err := decodeJWTSegment(segments[1], &payload); err != nil {
+		return false
+	}
+
+	_, hasAlgorithm := header["alg"]
+	return hasAlgorithm
+}
+
+func decodeJWTSegment(segment string, output *map[string]interface{}) error {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decoded, output)
+}
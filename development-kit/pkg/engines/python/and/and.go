@@ -0,0 +1,76 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package and
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewPythonAndFlaskDebugEnabled() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "6d04adbe-9ff7-431f-bf4b-f636fbe7ec35",
+			Name:        "Flask application running with debug enabled",
+			Description: "Running a Flask application with debug=True exposes the Werkzeug debugger, which allows the execution of arbitrary code from the browser. Never enable debug mode in production. For more information checkout the CWE-94 (https://cwe.mitre.org/data/definitions/94.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(from\s+flask\s+import|import\s+flask)`),
+			regexp.MustCompile(`\.run\(.*debug\s*=\s*True`),
+		},
+	}
+}
+
+func NewPythonAndJinja2AutoescapeDisabled() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "380652e0-793b-49a4-9791-e91cdcdb435f",
+			Name:        "Jinja2 autoescape disabled",
+			Description: "The Jinja2 environment is created with autoescape disabled, so values rendered in HTML templates are not escaped and may lead to Cross-Site Scripting (XSS). Use autoescape=True or select_autoescape(). For more information checkout the CWE-79 (https://cwe.mitre.org/data/definitions/79.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(from\s+jinja2\s+import|import\s+jinja2)`),
+			regexp.MustCompile(`Environment\(.*autoescape\s*=\s*False`),
+		},
+	}
+}
+
+func NewPythonAndInsecureRandomForSecrets() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "1181df6d-4acd-4d1e-96fd-1141f2c5981d",
+			Name:        "Insecure random number generator used for secrets",
+			Description: "The module random is used to generate a value that looks like a secret (token, password, key, nonce, salt or session). Its output is predictable and must not be used for security purposes. Use the secrets module instead. For more information checkout the CWE-338 (https://cwe.mitre.org/data/definitions/338.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.AndMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(import\s+random\b|from\s+random\s+import)`),
+			regexp.MustCompile(`(?i:(token|secret|passw(or)?d|key|nonce|salt|otp|session|csrf)\w*\s*(=|\+=)).*\brandom\.(random|randint|randrange|choice|choices|getrandbits|sample)\(`),
+		},
+	}
+}
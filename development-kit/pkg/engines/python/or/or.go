@@ -0,0 +1,63 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package or
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewPythonOrWeakHash() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "f0dee81a-647a-4abb-9db3-aba65c67832d",
+			Name:        "Weak hashing function md5 or sha1",
+			Description: "MD5 or SHA1 have known collision weaknesses and are no longer considered strong hashing algorithms. Use SHA-256 or higher, and bcrypt, scrypt or argon2 for passwords. For more information checkout the CWE-328 (https://cwe.mitre.org/data/definitions/328.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`hashlib\.(md5|sha1)\(`),
+			regexp.MustCompile(`hashlib\.new\(\s*["'](md4|md5|sha1)["']`),
+			regexp.MustCompile(`hashes\.(MD5|SHA1)\(`),
+			regexp.MustCompile(`(MD2|MD4|MD5|SHA)\.new\(`),
+		},
+	}
+}
+
+func NewPythonOrWeakCipher() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "011a5f75-2508-43b3-9343-14bd5dbc4e04",
+			Name:        "Weak cryptographic algorithm or mode",
+			Description: "DES, Triple DES, RC4, Blowfish and the ECB mode are considered weak. Use AES with an authenticated mode like GCM instead. For more information checkout the CWE-327 (https://cwe.mitre.org/data/definitions/327.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.OrMatch,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(DES|DES3|ARC2|ARC4|Blowfish|XOR)\.new\(`),
+			regexp.MustCompile(`algorithms\.(TripleDES|Blowfish|ARC4|IDEA|CAST5)\(`),
+			regexp.MustCompile(`modes\.ECB\(`),
+			regexp.MustCompile(`\.MODE_ECB\b`),
+		},
+	}
+}
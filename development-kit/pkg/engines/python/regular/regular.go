@@ -0,0 +1,176 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:lll multiple regex is not possible broken lines
+package regular
+
+import (
+	"regexp"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+func NewPythonRegularCodeInjectionWithEvalOrExec() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "bfe3b939-cdf2-44d3-96ab-ef4d3341e2bf",
+			Name:        "Use of eval or exec",
+			Description: "The functions eval and exec run arbitrary Python code. If any part of their argument comes from external input an attacker can execute code in the application. Use ast.literal_eval to parse literals or avoid dynamic evaluation. For more information checkout the CWE-95 (https://cwe.mitre.org/data/definitions/95.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(^|[^.\w])(eval|exec)\(\s*[^)\s]`),
+		},
+	}
+}
+
+func NewPythonRegularUnsafeDeserialization() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "14a64bc4-a903-43d1-ae20-eed2a4a60855",
+			Name:        "Deserialization of untrusted data with pickle",
+			Description: "The modules pickle, cPickle, dill, shelve and marshal can execute arbitrary code while deserializing data. Never deserialize data that comes from an untrusted source, use JSON instead. For more information checkout the CWE-502 (https://cwe.mitre.org/data/definitions/502.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\b(pickle|cPickle|_pickle|dill|marshal)\.(loads?|Unpickler)\(`),
+			regexp.MustCompile(`\bshelve\.open\(`),
+		},
+	}
+}
+
+func NewPythonRegularYamlLoadWithoutSafeLoader() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "136a5ea7-f773-4e70-8fac-1e33374c85fa",
+			Name:        "YAML load without SafeLoader",
+			Description: "yaml.load without yaml.SafeLoader can build arbitrary Python objects from the document, which allows code execution with untrusted input. Use yaml.safe_load or pass Loader=yaml.SafeLoader. For more information checkout the CWE-502 (https://cwe.mitre.org/data/definitions/502.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`yaml\.load(_all)?\(\s*[^,()]*(\([^()]*\))?[^,()]*\)`),
+			regexp.MustCompile(`yaml\.load(_all)?\(.*Loader\s*=\s*(yaml\.)?(Loader|UnsafeLoader|FullLoader|CLoader|CFullLoader|CUnsafeLoader)\b`),
+			regexp.MustCompile(`yaml\.(unsafe_load|unsafe_load_all|full_load|full_load_all)\(`),
+		},
+	}
+}
+
+func NewPythonRegularSubprocessWithShellTrue() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "46f1f10e-3d93-41e4-9bec-3ab44cb17385",
+			Name:        "Subprocess call with shell=True",
+			Description: "Running a subprocess with shell=True passes the command to the system shell. If any part of the command comes from external input an attacker can inject additional shell commands. Pass the arguments as a list and keep shell=False. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`subprocess\.(Popen|call|run|check_call|check_output)\(.*shell\s*=\s*True`),
+		},
+	}
+}
+
+func NewPythonRegularOSCommandExecution() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "3f414f3f-71e3-401e-8f2e-c714815f1958",
+			Name:        "OS command execution with non constant input",
+			Description: "A command built at runtime is executed through the system shell. If any part of it comes from external input an attacker can inject additional shell commands. Use subprocess with a list of arguments instead. For more information checkout the CWE-78 (https://cwe.mitre.org/data/definitions/78.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`os\.(system|popen|popen2|popen3|popen4)\(\s*([^"'\s)]|f["'])`),
+			regexp.MustCompile(`os\.(system|popen|popen2|popen3|popen4)\(\s*["'][^"']*["']\s*(%|\+|\.format\()`),
+			regexp.MustCompile(`subprocess\.(getoutput|getstatusoutput)\(`),
+		},
+	}
+}
+
+func NewPythonRegularHardcodedSecret() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "ce145456-9f26-43b4-ab46-37736f95d5d0",
+			Name:        "Hardcoded secret",
+			Description: "A password, token or key is assigned from a string literal. Anyone with access to the source code can read it. Load secrets from environment variables or a secret manager. For more information checkout the CWE-798 (https://cwe.mitre.org/data/definitions/798.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(?i)\b\w*(password|passwd|pwd|secret|api_?key|access_?key|auth_?token|private_?key)\w*\s*[:=]\s*["'][^"'\s]{4,}["']`),
+		},
+	}
+}
+
+func NewPythonRegularSQLInjection() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "54686133-f665-401e-bcf7-dc83fda04eef",
+			Name:        "SQL query built with string formatting",
+			Description: "A SQL query is built with string formatting or concatenation. If any part of the query comes from external input the application is vulnerable to SQL Injection. Pass the values as parameters of the execute call. For more information checkout the CWE-89 (https://cwe.mitre.org/data/definitions/89.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.Medium.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`\.(execute|executemany|raw)\(\s*f["']`),
+			regexp.MustCompile(`\.(execute|executemany|raw)\(\s*["'][^"']*["']\s*(%|\+|\.format\()`),
+		},
+	}
+}
+
+func NewPythonRegularRequestsWithoutCertificateValidation() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "bbfb2af6-53a9-467f-8ad1-402596a0d204",
+			Name:        "Request without certificate validation",
+			Description: "The request is made with verify=False, which disables the validation of the server certificate. This application is vulnerable to MITM attacks. For more information checkout the CWE-295 (https://cwe.mitre.org/data/definitions/295.html) advisory.",
+			Severity:    severity.High.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`(requests|httpx|session)\.(get|post|put|patch|delete|head|options|request)\(.*verify\s*=\s*False`),
+			regexp.MustCompile(`ssl\._create_unverified_context\(`),
+		},
+	}
+}
+
+func NewPythonRegularInsecureTempFile() text.TextRule {
+	return text.TextRule{
+		Metadata: engine.Metadata{
+			ID:          "7c11c802-b72b-4e6f-9a8b-514bea601fa7",
+			Name:        "Insecure temporary file",
+			Description: "tempfile.mktemp returns a file name that may be created by another process before it is used. Use tempfile.mkstemp or tempfile.NamedTemporaryFile instead. For more information checkout the CWE-377 (https://cwe.mitre.org/data/definitions/377.html) advisory.",
+			Severity:    severity.Medium.ToString(),
+			Confidence:  confidence.High.ToString(),
+		},
+		Type: text.Regular,
+		Expressions: []*regexp.Regexp{
+			regexp.MustCompile(`tempfile\.mktemp\(`),
+		},
+	}
+}
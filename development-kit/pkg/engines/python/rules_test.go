@@ -0,0 +1,146 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package python
+
+import (
+	"fmt"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRules(t *testing.T) {
+	assert.IsType(t, NewRules(), &Rules{})
+}
+
+func TestRules_GetAllRules(t *testing.T) {
+	t.Run("should return all rules enable", func(t *testing.T) {
+		rules := NewRules().GetAllRules()
+		totalRegexes := 0
+
+		for i := range rules {
+			textRule := rules[i].(text.TextRule)
+			totalRegexes += len(textRule.Expressions)
+		}
+
+		assert.Greater(t, len(rules), 0)
+		assert.Greater(t, totalRegexes, 0)
+	})
+}
+
+func TestRulesEnum(t *testing.T) {
+	var totalRules []text.TextRule
+
+	totalRules = append(totalRules, allRulesPythonAnd()...)
+	totalRules = append(totalRules, allRulesPythonOr()...)
+	totalRules = append(totalRules, allRulesPythonRegular()...)
+	lenExpectedTotalRules := 14
+
+	t.Run("should not exists duplicated ID in rules and return lenExpectedTotalRules in python", func(t *testing.T) {
+		encountered := map[string]bool{}
+
+		for v := range totalRules {
+			if encountered[totalRules[v].ID] == true {
+				msg := fmt.Sprintf("This rules in python is duplicated ID(%s) => Name: %s, Description: %s, Type: %v", totalRules[v].ID, totalRules[v].Name, totalRules[v].Description, totalRules[v].Type)
+				assert.False(t, encountered[totalRules[v].ID], msg)
+			} else {
+				// Record this element as an encountered element.
+				encountered[totalRules[v].ID] = true
+			}
+		}
+		assert.Equal(t, len(totalRules), lenExpectedTotalRules, "totalRules in python is not equal the expected")
+		assert.Equal(t, len(encountered), lenExpectedTotalRules, "encountered in python is not equal the expected")
+	})
+}
+
+func TestRules_FindVulnerabilities(t *testing.T) {
+	code := `import hashlib
+import pickle
+import subprocess
+import yaml
+from flask import Flask, request
+
+app = Flask(__name__)
+API_KEY = "9f3b2c1d7e6a"
+
+
+@app.route("/run")
+def run():
+    result = eval(request.args.get("expression"))
+    subprocess.call("ls " + request.args.get("path"), shell=True)
+    data = pickle.loads(request.data)
+    config = yaml.load(open("config.yml"))
+    digest = hashlib.md5(request.data).hexdigest()
+    return str(result) + str(data) + str(config) + digest
+
+
+if __name__ == "__main__":
+    app.run(host="0.0.0.0", debug=True)
+`
+	textFile, err := text.NewTextFile("app.py", []byte(code))
+	assert.NoError(t, err)
+
+	findings := engine.Run([]engine.Unit{text.TextUnit{Files: []text.TextFile{textFile}}}, NewRules().GetAllRules())
+
+	var names []string
+	for index := range findings {
+		names = append(names, findings[index].Name)
+	}
+
+	for _, rule := range []text.TextRule{
+		allRulesPythonRegular()[0], allRulesPythonRegular()[1], allRulesPythonRegular()[2],
+		allRulesPythonRegular()[3], allRulesPythonRegular()[5], allRulesPythonAnd()[0], allRulesPythonOr()[0],
+	} {
+		assert.Contains(t, names, rule.Name)
+	}
+}
+
+func TestRules_NotFindVulnerabilitiesInSafeCode(t *testing.T) {
+	code := `import ast
+import hashlib
+import json
+import os
+import subprocess
+import yaml
+from flask import Flask, request
+
+app = Flask(__name__)
+API_KEY = os.environ.get("API_KEY")
+
+
+@app.route("/run")
+def run():
+    result = ast.literal_eval(request.args.get("expression"))
+    subprocess.call(["ls", request.args.get("path")])
+    data = json.loads(request.data)
+    config = yaml.load(open("config.yml"), Loader=yaml.SafeLoader)
+    other = yaml.safe_load(open("other.yml"))
+    cursor.execute("SELECT * FROM users WHERE name = %s", (request.args.get("name"),))
+    digest = hashlib.sha256(request.data).hexdigest()
+    return str(result) + str(data) + str(config) + str(other) + digest
+
+
+if __name__ == "__main__":
+    app.run(host="127.0.0.1")
+`
+	textFile, err := text.NewTextFile("app.py", []byte(code))
+	assert.NoError(t, err)
+
+	findings := engine.Run([]engine.Unit{text.TextUnit{Files: []text.TextFile{textFile}}}, NewRules().GetAllRules())
+
+	assert.Empty(t, findings)
+}
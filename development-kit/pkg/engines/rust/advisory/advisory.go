@@ -0,0 +1,223 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package advisory
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/pelletier/go-toml"
+)
+
+const (
+	// cratesFolder has the advisories of crates.io, other folders like rust have advisories of the toolchain
+	cratesFolder = "crates"
+	tomlFence    = "```toml"
+	fence        = "```"
+	titlePrefix  = "# "
+	urlFormat    = "https://rustsec.org/advisories/%s.html"
+)
+
+var ErrFrontMatterNotFound = errors.New("toml front matter of advisory not found")
+
+// Advisory is a RustSec advisory, the informational advisories are about crates unmaintained or unsound
+type Advisory struct {
+	ID            string   `toml:"id"`
+	Package       string   `toml:"package"`
+	Title         string   `toml:"title"`
+	Description   string   `toml:"description"`
+	URL           string   `toml:"url"`
+	CVSS          string   `toml:"cvss"`
+	Informational string   `toml:"informational"`
+	Withdrawn     string   `toml:"withdrawn"`
+	Aliases       []string `toml:"aliases"`
+	Patched       []string `toml:"patched_versions"`
+	Unaffected    []string `toml:"unaffected_versions"`
+}
+
+// advisoryFile has the advisory and the versions, which are in the advisory table in the old format of database
+type advisoryFile struct {
+	Advisory Advisory `toml:"advisory"`
+	Versions struct {
+		Patched    []string `toml:"patched"`
+		Unaffected []string `toml:"unaffected"`
+	} `toml:"versions"`
+}
+
+// Database has the advisories of a local copy of the RustSec advisory database by crate name
+type Database struct {
+	advisories map[string][]*Advisory
+}
+
+// LoadDatabase reads the advisories of a RustSec database snapshot, like the repository cloned by cargo audit in
+// ~/.cargo/advisory-db. The advisories can be markdown files with toml front matter or toml files
+func LoadDatabase(path string) (*Database, error) {
+	root := filepath.Join(path, cratesFolder)
+	if _, err := os.Stat(root); err != nil {
+		root = path
+	}
+
+	database := &Database{advisories: map[string][]*Advisory{}}
+	return database, filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || !isAdvisoryFile(path) {
+			return err
+		}
+
+		advisory, err := parseAdvisoryFile(path)
+		if err != nil {
+			return err
+		}
+
+		if advisory.Withdrawn == "" {
+			database.advisories[advisory.Package] = append(database.advisories[advisory.Package], advisory)
+		}
+
+		return nil
+	})
+}
+
+func isAdvisoryFile(path string) bool {
+	return strings.HasPrefix(filepath.Base(path), "RUSTSEC-") &&
+		(filepath.Ext(path) == ".md" || filepath.Ext(path) == ".toml")
+}
+
+func parseAdvisoryFile(path string) (*Advisory, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	advisory, err := ParseAdvisory(content, filepath.Ext(path) == ".md")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return advisory, nil
+}
+
+// ParseAdvisory parses an advisory, in markdown the title is the first header and the description is the content
+// after it
+func ParseAdvisory(content []byte, isMarkdown bool) (*Advisory, error) {
+	frontMatter, markdown := string(content), ""
+	if isMarkdown {
+		var err error
+		if frontMatter, markdown, err = splitFrontMatter(frontMatter); err != nil {
+			return nil, err
+		}
+	}
+
+	file := &advisoryFile{}
+	if err := toml.Unmarshal([]byte(frontMatter), file); err != nil {
+		return nil, err
+	}
+
+	advisory := &file.Advisory
+	advisory.Patched = append(advisory.Patched, file.Versions.Patched...)
+	advisory.Unaffected = append(advisory.Unaffected, file.Versions.Unaffected...)
+	advisory.setMarkdown(markdown)
+	return advisory, nil
+}
+
+func splitFrontMatter(content string) (frontMatter, markdown string, err error) {
+	start := strings.Index(content, tomlFence)
+	if start < 0 {
+		return "", "", ErrFrontMatterNotFound
+	}
+
+	content = content[start+len(tomlFence):]
+	end := strings.Index(content, fence)
+	if end < 0 {
+		return "", "", ErrFrontMatterNotFound
+	}
+
+	return content[:end], content[end+len(fence):], nil
+}
+
+func (a *Advisory) setMarkdown(markdown string) {
+	for _, line := range strings.Split(strings.TrimSpace(markdown), "\n") {
+		if a.Title == "" && strings.HasPrefix(line, titlePrefix) {
+			a.Title = strings.TrimSpace(strings.TrimPrefix(line, titlePrefix))
+			markdown = markdown[strings.Index(markdown, line)+len(line):]
+			break
+		}
+	}
+
+	if a.Description == "" {
+		a.Description = strings.TrimSpace(markdown)
+	}
+}
+
+// GetAdvisories returns the advisories that affect the version of crate
+func (d *Database) GetAdvisories(name, version string) (advisories []*Advisory, err error) {
+	parsedVersion, err := ParseVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, advisory := range d.advisories[name] {
+		isAffected, err := advisory.IsAffected(parsedVersion)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", advisory.ID, err)
+		}
+
+		if isAffected {
+			advisories = append(advisories, advisory)
+		}
+	}
+
+	return advisories, nil
+}
+
+// IsAffected returns true when version is not patched and is not unaffected
+func (a *Advisory) IsAffected(version *Version) (bool, error) {
+	for _, requirement := range append(append([]string{}, a.Patched...), a.Unaffected...) {
+		matched, err := version.MatchRequirement(requirement)
+		if err != nil || matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// GetSeverity returns the severity of the CVSS, the informational advisories without CVSS are low because they are
+// not vulnerabilities but the crate should be replaced
+func (a *Advisory) GetSeverity() severity.Severity {
+	switch {
+	case a.CVSS != "":
+		return GetSeverityByCVSS(a.CVSS)
+	case a.Informational != "":
+		return severity.Low
+	default:
+		return severity.Medium
+	}
+}
+
+func (a *Advisory) GetURL() string {
+	return fmt.Sprintf(urlFormat, a.ID)
+}
+
+func (d *Database) Len() (total int) {
+	for _, advisories := range d.advisories {
+		total += len(advisories)
+	}
+
+	return total
+}
@@ -0,0 +1,162 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package advisory
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/stretchr/testify/assert"
+)
+
+const markdownAdvisory = "```toml" + `
+[advisory]
+id = "RUSTSEC-2020-0071"
+package = "time"
+date = "2020-11-18"
+url = "https://github.com/time-rs/time/issues/293"
+categories = ["code-execution", "memory-corruption"]
+aliases = ["CVE-2020-26235"]
+cvss = "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:N/I:N/A:H"
+
+[versions]
+patched = [">= 0.2.23"]
+unaffected = ["= 0.2.0", "= 0.2.1", "< 0.1.0"]
+` + "```" + `
+
+# Potential segfault in the time crate
+
+Unix-like operating systems may segfault due to dereferencing a dangling pointer.
+
+The affected functions are in the time module.
+`
+
+const tomlAdvisory = `[advisory]
+id = "RUSTSEC-2019-0001"
+package = "ammonia"
+title = "Uncontrolled recursion leads to abort in HTML serialization"
+description = "Affected versions of this crate did use recursion for serialization of HTML DOM trees."
+patched_versions = [">= 2.1.0"]
+informational = "unsound"
+`
+
+const withdrawnAdvisory = "```toml\n[advisory]\nid = \"RUSTSEC-2020-0001\"\npackage = \"time\"\n" +
+	"withdrawn = \"2020-12-01\"\n\n[versions]\npatched = []\n```\n\n# Withdrawn\n"
+
+func createDatabase(t *testing.T, files map[string]string) string {
+	path := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(path, name)), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, name), []byte(content), os.ModePerm))
+	}
+
+	return path
+}
+
+func TestParseAdvisory(t *testing.T) {
+	t.Run("should parse markdown advisory with front matter", func(t *testing.T) {
+		advisory, err := ParseAdvisory([]byte(markdownAdvisory), true)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "RUSTSEC-2020-0071", advisory.ID)
+		assert.Equal(t, "time", advisory.Package)
+		assert.Equal(t, "Potential segfault in the time crate", advisory.Title)
+		assert.Contains(t, advisory.Description, "dangling pointer")
+		assert.Equal(t, []string{"CVE-2020-26235"}, advisory.Aliases)
+		assert.Equal(t, []string{">= 0.2.23"}, advisory.Patched)
+		assert.Len(t, advisory.Unaffected, 3)
+		assert.Equal(t, severity.Medium, advisory.GetSeverity())
+		assert.Equal(t, "https://rustsec.org/advisories/RUSTSEC-2020-0071.html", advisory.GetURL())
+	})
+
+	t.Run("should parse toml advisory of old format", func(t *testing.T) {
+		advisory, err := ParseAdvisory([]byte(tomlAdvisory), false)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Uncontrolled recursion leads to abort in HTML serialization", advisory.Title)
+		assert.Equal(t, []string{">= 2.1.0"}, advisory.Patched)
+		assert.Equal(t, severity.Low, advisory.GetSeverity())
+	})
+
+	t.Run("should return error when front matter is invalid", func(t *testing.T) {
+		for _, content := range []string{"# Title\n", "```toml\n[advisory]\n", "```toml\n[advisory\n```\n"} {
+			_, err := ParseAdvisory([]byte(content), true)
+
+			assert.Error(t, err, content)
+		}
+	})
+}
+
+func TestLoadDatabase(t *testing.T) {
+	t.Run("should load advisories of crates ignoring withdrawn and other files", func(t *testing.T) {
+		path := createDatabase(t, map[string]string{
+			"crates/time/RUSTSEC-2020-0071.md":      markdownAdvisory,
+			"crates/time/RUSTSEC-2020-0001.md":      withdrawnAdvisory,
+			"crates/ammonia/RUSTSEC-2019-0001.toml": tomlAdvisory,
+			"rust/std/RUSTSEC-2021-0000.md":         "invalid",
+			"README.md":                             "# RustSec Advisory Database\n",
+		})
+
+		database, err := LoadDatabase(path)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, database.Len())
+	})
+
+	t.Run("should return error when advisory is invalid", func(t *testing.T) {
+		path := createDatabase(t, map[string]string{"crates/time/RUSTSEC-2020-0071.md": "invalid"})
+
+		_, err := LoadDatabase(path)
+
+		assert.ErrorIs(t, err, ErrFrontMatterNotFound)
+	})
+}
+
+func TestDatabase_GetAdvisories(t *testing.T) {
+	path := createDatabase(t, map[string]string{"crates/time/RUSTSEC-2020-0071.md": markdownAdvisory})
+	database, err := LoadDatabase(path)
+	assert.NoError(t, err)
+
+	t.Run("should return advisories of affected versions", func(t *testing.T) {
+		for _, version := range []string{"0.1.43", "0.2.22"} {
+			advisories, err := database.GetAdvisories("time", version)
+
+			assert.NoError(t, err)
+			assert.Len(t, advisories, 1, version)
+		}
+	})
+
+	t.Run("should not return advisories of patched, unaffected and other crates", func(t *testing.T) {
+		for _, version := range []string{"0.2.23", "0.3.0", "0.2.1", "0.0.9"} {
+			advisories, err := database.GetAdvisories("time", version)
+
+			assert.NoError(t, err)
+			assert.Empty(t, advisories, version)
+		}
+
+		advisories, err := database.GetAdvisories("chrono", "0.1.0")
+		assert.NoError(t, err)
+		assert.Empty(t, advisories)
+	})
+
+	t.Run("should return error when version is invalid", func(t *testing.T) {
+		_, err := database.GetAdvisories("time", "invalid")
+
+		assert.ErrorIs(t, err, ErrInvalidVersion)
+	})
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package advisory
+
+import (
+	"math"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
+
+const (
+	scopeChanged = "C"
+	maxScore     = 10
+)
+
+// cvssWeights are the weights of the base metrics of CVSS 3, the privileges required have other weights when the
+// scope is changed
+var cvssWeights = map[string]map[string]float64{
+	"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+	"AC": {"L": 0.77, "H": 0.44},
+	"PR": {"N": 0.85, "L": 0.62, "H": 0.27},
+	"UI": {"N": 0.85, "R": 0.62},
+	"C":  {"H": 0.56, "L": 0.22, "N": 0},
+	"I":  {"H": 0.56, "L": 0.22, "N": 0},
+	"A":  {"H": 0.56, "L": 0.22, "N": 0},
+}
+
+var privilegesRequiredScopeChanged = map[string]float64{"N": 0.85, "L": 0.68, "H": 0.5}
+
+// GetSeverityByCVSS returns the severity of the base score of CVSS 3 vector, like
+// CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H, or unknown when the vector is invalid
+func GetSeverityByCVSS(vector string) severity.Severity {
+	score, ok := getBaseScore(vector)
+	switch {
+	case !ok:
+		return severity.Unknown
+	case score >= 9:
+		return severity.Critical
+	case score >= 7:
+		return severity.High
+	case score >= 4:
+		return severity.Medium
+	case score > 0:
+		return severity.Low
+	default:
+		return severity.Info
+	}
+}
+
+func getBaseScore(vector string) (float64, bool) {
+	metrics, ok := parseCVSSVector(vector)
+	if !ok {
+		return 0, false
+	}
+
+	isScopeChanged := metrics["S"] == scopeChanged
+	weights := map[string]float64{}
+	for metric, values := range cvssWeights {
+		weight, ok := values[metrics[metric]]
+		if !ok {
+			return 0, false
+		}
+
+		weights[metric] = weight
+	}
+
+	if isScopeChanged {
+		weights["PR"] = privilegesRequiredScopeChanged[metrics["PR"]]
+	}
+
+	return calculateBaseScore(weights, isScopeChanged), true
+}
+
+func parseCVSSVector(vector string) (map[string]string, bool) {
+	parts := strings.Split(vector, "/")
+	if len(parts) < 2 || !strings.HasPrefix(parts[0], "CVSS:3") {
+		return nil, false
+	}
+
+	metrics := map[string]string{}
+	for _, part := range parts[1:] {
+		keyAndValue := strings.SplitN(part, ":", 2)
+		if len(keyAndValue) != 2 {
+			return nil, false
+		}
+
+		metrics[keyAndValue[0]] = keyAndValue[1]
+	}
+
+	return metrics, metrics["S"] == scopeChanged || metrics["S"] == "U"
+}
+
+func calculateBaseScore(weights map[string]float64, isScopeChanged bool) float64 {
+	impactSubScore := 1 - (1-weights["C"])*(1-weights["I"])*(1-weights["A"])
+	impact := 6.42 * impactSubScore
+	if isScopeChanged {
+		impact = 7.52*(impactSubScore-0.029) - 3.25*math.Pow(impactSubScore-0.02, 15)
+	}
+
+	if impact <= 0 {
+		return 0
+	}
+
+	score := impact + 8.22*weights["AV"]*weights["AC"]*weights["PR"]*weights["UI"]
+	if isScopeChanged {
+		score *= 1.08
+	}
+
+	return roundUp(math.Min(score, maxScore))
+}
+
+// roundUp returns the smallest number with one decimal that is equal or greater than value, as defined by CVSS 3.1
+func roundUp(value float64) float64 {
+	integer := math.Round(value * 100000)
+	if math.Mod(integer, 10000) == 0 {
+		return integer / 100000
+	}
+
+	return (math.Floor(integer/10000) + 1) / 10
+}
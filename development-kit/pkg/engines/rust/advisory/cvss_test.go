@@ -0,0 +1,52 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package advisory
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSeverityByCVSS(t *testing.T) {
+	t.Run("should return severity of base score", func(t *testing.T) {
+		vectors := map[string]severity.Severity{
+			"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H": severity.Critical,
+			"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:C/C:H/I:H/A:H": severity.Critical,
+			"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:H": severity.High,
+			"CVSS:3.0/AV:N/AC:H/PR:N/UI:N/S:U/C:L/I:L/A:N": severity.Medium,
+			"CVSS:3.1/AV:L/AC:H/PR:H/UI:R/S:U/C:L/I:N/A:N": severity.Low,
+			"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:N/I:N/A:N": severity.Info,
+		}
+
+		for vector, expected := range vectors {
+			assert.Equal(t, expected, GetSeverityByCVSS(vector), vector)
+		}
+	})
+
+	t.Run("should return base score rounded up", func(t *testing.T) {
+		score, ok := getBaseScore("CVSS:3.1/AV:N/AC:L/PR:L/UI:N/S:C/C:L/I:L/A:N")
+
+		assert.True(t, ok)
+		assert.Equal(t, 6.4, score)
+	})
+
+	t.Run("should return unknown when vector is invalid", func(t *testing.T) {
+		for _, vector := range []string{"", "CVSS:2.0/AV:N", "CVSS:3.1/AV:N/AC:L", "CVSS:3.1/AV:X/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"} {
+			assert.Equal(t, severity.Unknown, GetSeverityByCVSS(vector), vector)
+		}
+	})
+}
@@ -0,0 +1,258 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package advisory
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidVersion = errors.New("invalid version")
+
+// Version is a semantic version of a crate, the build metadata is ignored because it has no precedence
+type Version struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	PreRelease string
+}
+
+// partialVersion is the version of a comparator, where minor and patch can be omitted, like ^1.2
+type partialVersion struct {
+	major uint64
+	minor *uint64
+	patch *uint64
+	Version
+}
+
+func ParseVersion(value string) (*Version, error) {
+	partial, err := parsePartialVersion(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if partial.minor == nil || partial.patch == nil {
+		return nil, fmt.Errorf("%s: %w", value, ErrInvalidVersion)
+	}
+
+	return &partial.Version, nil
+}
+
+func parsePartialVersion(value string) (*partialVersion, error) {
+	value = strings.TrimSpace(value)
+	if index := strings.Index(value, "+"); index >= 0 {
+		value = value[:index]
+	}
+
+	partial := &partialVersion{}
+	if index := strings.Index(value, "-"); index >= 0 {
+		value, partial.PreRelease = value[:index], value[index+1:]
+	}
+
+	parts := strings.Split(value, ".")
+	numbers, err := parseNumbers(parts)
+	if err != nil || len(numbers) == 0 || len(parts) > 3 {
+		return nil, fmt.Errorf("%s: %w", value, ErrInvalidVersion)
+	}
+
+	partial.major, partial.Major = *numbers[0], *numbers[0]
+	partial.minor, partial.patch = getNumber(numbers, 1), getNumber(numbers, 2)
+	partial.Minor, partial.Patch = valueOrZero(partial.minor), valueOrZero(partial.patch)
+	return partial, nil
+}
+
+// parseNumbers returns the numbers of version until the first wildcard
+func parseNumbers(parts []string) (numbers []*uint64, err error) {
+	for _, part := range parts {
+		if part == "*" || part == "x" || part == "X" {
+			return numbers, nil
+		}
+
+		number, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		numbers = append(numbers, &number)
+	}
+
+	return numbers, nil
+}
+
+func getNumber(numbers []*uint64, index int) *uint64 {
+	if index < len(numbers) {
+		return numbers[index]
+	}
+
+	return nil
+}
+
+func valueOrZero(value *uint64) uint64 {
+	if value == nil {
+		return 0
+	}
+
+	return *value
+}
+
+// Compare returns -1, 0 or 1 when version is lower, equal or greater than other
+func (v *Version) Compare(other *Version) int {
+	for _, pair := range [][2]uint64{{v.Major, other.Major}, {v.Minor, other.Minor}, {v.Patch, other.Patch}} {
+		if pair[0] != pair[1] {
+			return compareNumbers(pair[0], pair[1])
+		}
+	}
+
+	return comparePreRelease(v.PreRelease, other.PreRelease)
+}
+
+func compareNumbers(value, other uint64) int {
+	switch {
+	case value < other:
+		return -1
+	case value > other:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// comparePreRelease considers that a version without pre release is greater than the same version with it
+func comparePreRelease(value, other string) int {
+	switch {
+	case value == other:
+		return 0
+	case value == "":
+		return 1
+	case other == "":
+		return -1
+	}
+
+	values, others := strings.Split(value, "."), strings.Split(other, ".")
+	for index := 0; index < len(values) && index < len(others); index++ {
+		if result := compareIdentifiers(values[index], others[index]); result != 0 {
+			return result
+		}
+	}
+
+	return compareNumbers(uint64(len(values)), uint64(len(others)))
+}
+
+func compareIdentifiers(value, other string) int {
+	number, errValue := strconv.ParseUint(value, 10, 64)
+	otherNumber, errOther := strconv.ParseUint(other, 10, 64)
+	switch {
+	case errValue == nil && errOther == nil:
+		return compareNumbers(number, otherNumber)
+	case errValue == nil:
+		return -1
+	case errOther == nil:
+		return 1
+	default:
+		return strings.Compare(value, other)
+	}
+}
+
+// MatchRequirement returns true when version matches all comparators of the requirement, which uses the syntax of
+// cargo, like ">= 1.2.3, < 2" or "^0.4"
+func (v *Version) MatchRequirement(requirement string) (bool, error) {
+	for _, comparator := range strings.Split(requirement, ",") {
+		matched, err := v.matchComparator(strings.TrimSpace(comparator))
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func (v *Version) matchComparator(comparator string) (bool, error) {
+	operator, value := splitOperator(comparator)
+	if value == "*" || value == "" {
+		return true, nil
+	}
+
+	partial, err := parsePartialVersion(value)
+	if err != nil {
+		return false, err
+	}
+
+	lower, upper := partial.getRange(operator)
+	return (lower == nil || v.Compare(lower) >= 0) && (upper == nil || v.Compare(upper) < 0), nil
+}
+
+func splitOperator(comparator string) (operator, value string) {
+	for _, operator := range []string{">=", "<=", ">", "<", "=", "^", "~"} {
+		if strings.HasPrefix(comparator, operator) {
+			return operator, strings.TrimSpace(strings.TrimPrefix(comparator, operator))
+		}
+	}
+
+	return "^", comparator
+}
+
+// getRange returns the versions that are included as lower bound and excluded as upper bound by the operator
+func (p *partialVersion) getRange(operator string) (lower, upper *Version) {
+	switch operator {
+	case ">=":
+		return &p.Version, nil
+	case ">":
+		return p.getUpperOfPartial(), nil
+	case "<":
+		return nil, &p.Version
+	case "<=":
+		return nil, p.getUpperOfPartial()
+	case "=":
+		return &p.Version, p.getUpperOfPartial()
+	case "~":
+		return &p.Version, p.getTildeUpper()
+	default:
+		return &p.Version, p.getCaretUpper()
+	}
+}
+
+// getUpperOfPartial returns the first version after the versions matched by the partial version
+func (p *partialVersion) getUpperOfPartial() *Version {
+	switch {
+	case p.minor == nil:
+		return &Version{Major: p.major + 1}
+	case p.patch == nil:
+		return &Version{Major: p.major, Minor: *p.minor + 1}
+	default:
+		return &Version{Major: p.major, Minor: *p.minor, Patch: *p.patch + 1}
+	}
+}
+
+func (p *partialVersion) getTildeUpper() *Version {
+	if p.minor == nil {
+		return &Version{Major: p.major + 1}
+	}
+
+	return &Version{Major: p.major, Minor: *p.minor + 1}
+}
+
+// getCaretUpper returns the next version that is not compatible, where the first non zero number is the major
+func (p *partialVersion) getCaretUpper() *Version {
+	switch {
+	case p.major > 0 || p.minor == nil:
+		return &Version{Major: p.major + 1}
+	case *p.minor > 0 || p.patch == nil:
+		return &Version{Minor: *p.minor + 1}
+	default:
+		return &Version{Patch: *p.patch + 1}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package advisory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Run("should parse version with pre release and build metadata", func(t *testing.T) {
+		version, err := ParseVersion("1.2.3-alpha.1+build.5")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Version{Major: 1, Minor: 2, Patch: 3, PreRelease: "alpha.1"}, version)
+	})
+
+	t.Run("should return error when version is invalid or partial", func(t *testing.T) {
+		for _, value := range []string{"", "a.b.c", "1.2", "1.2.3.4"} {
+			_, err := ParseVersion(value)
+
+			assert.ErrorIs(t, err, ErrInvalidVersion, value)
+		}
+	})
+}
+
+func TestVersion_Compare(t *testing.T) {
+	t.Run("should compare numbers and pre releases", func(t *testing.T) {
+		ordered := []string{"0.9.9", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta.2",
+			"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.10.0"}
+
+		for index := 0; index+1 < len(ordered); index++ {
+			lower, _ := ParseVersion(ordered[index])
+			greater, _ := ParseVersion(ordered[index+1])
+
+			assert.Equal(t, -1, lower.Compare(greater), ordered[index])
+			assert.Equal(t, 1, greater.Compare(lower), ordered[index])
+			assert.Equal(t, 0, lower.Compare(lower), ordered[index])
+		}
+	})
+}
+
+func TestVersion_MatchRequirement(t *testing.T) {
+	testcases := []struct {
+		requirement string
+		matched     []string
+		notMatched  []string
+	}{
+		{requirement: ">= 1.2.3", matched: []string{"1.2.3", "2.0.0"}, notMatched: []string{"1.2.2"}},
+		{requirement: "> 1.2", matched: []string{"1.3.0"}, notMatched: []string{"1.2.9"}},
+		{requirement: "< 1.2", matched: []string{"1.1.9"}, notMatched: []string{"1.2.0"}},
+		{requirement: "<= 1.2", matched: []string{"1.2.9"}, notMatched: []string{"1.3.0"}},
+		{requirement: "=1.2", matched: []string{"1.2.0", "1.2.5"}, notMatched: []string{"1.3.0"}},
+		{requirement: "~1.2.3", matched: []string{"1.2.9"}, notMatched: []string{"1.3.0", "1.2.2"}},
+		{requirement: "^1.2.3", matched: []string{"1.9.0"}, notMatched: []string{"2.0.0", "1.2.2"}},
+		{requirement: "^0.3.8", matched: []string{"0.3.9"}, notMatched: []string{"0.4.0"}},
+		{requirement: "^0.0.3", matched: []string{"0.0.3"}, notMatched: []string{"0.0.4"}},
+		{requirement: "0.2", matched: []string{"0.2.7"}, notMatched: []string{"0.3.0"}},
+		{requirement: ">= 0.4.3, < 0.5", matched: []string{"0.4.9"}, notMatched: []string{"0.5.0", "0.4.2"}},
+		{requirement: "*", matched: []string{"0.0.1", "9.0.0"}},
+		{requirement: "1.*", matched: []string{"1.5.0"}, notMatched: []string{"2.0.0"}},
+	}
+
+	for _, testcase := range testcases {
+		for _, value := range testcase.matched {
+			version, _ := ParseVersion(value)
+			matched, err := version.MatchRequirement(testcase.requirement)
+
+			assert.NoError(t, err)
+			assert.True(t, matched, "%s should match %s", value, testcase.requirement)
+		}
+
+		for _, value := range testcase.notMatched {
+			version, _ := ParseVersion(value)
+			matched, err := version.MatchRequirement(testcase.requirement)
+
+			assert.NoError(t, err)
+			assert.False(t, matched, "%s should not match %s", value, testcase.requirement)
+		}
+	}
+
+	t.Run("should return error when requirement is invalid", func(t *testing.T) {
+		version, _ := ParseVersion("1.0.0")
+		_, err := version.MatchRequirement(">= a.b")
+
+		assert.Error(t, err)
+	})
+}
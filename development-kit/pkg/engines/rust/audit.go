@@ -0,0 +1,229 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rust
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rust/advisory"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rust/cargo"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+)
+
+const (
+	// targetFolder contains the build of cargo, which can have lock files of downloaded crates
+	targetFolder = "target"
+	// defaultDatabaseFolder is where cargo audit clones the advisory database inside of cargo home
+	defaultDatabaseFolder = "advisory-db"
+	cargoHomeEnv          = "CARGO_HOME"
+)
+
+var ErrAdvisoryDatabaseNotFound = errors.New("RustSec advisory database not found, clone " +
+	"https://github.com/rustsec/advisory-db and set its path in the rust advisory db path configuration")
+
+// ErrAdvisoryDatabaseNotConfigured is returned when no path was configured and cargo audit has no database
+var ErrAdvisoryDatabaseNotConfigured = errors.New("RustSec advisory database not configured and not found " +
+	"in cargo home, clone https://github.com/rustsec/advisory-db and set its path in the rust advisory db path " +
+	"configuration")
+
+type Interface interface {
+	Audit(projectPath, databasePath string) ([]engine.Finding, error)
+	GetLockFiles(projectPath string) ([]string, error)
+}
+
+type Audit struct{}
+
+func NewAudit() Interface {
+	return &Audit{}
+}
+
+// Audit checks the versions of crates locked in all Cargo.lock files of project against the advisories of the local
+// RustSec database, when the path of database is empty the database of cargo audit is used. The error
+// ErrAdvisoryDatabaseNotConfigured is returned when the path is empty and cargo audit has no database, while
+// ErrAdvisoryDatabaseNotFound is returned when the configured path is not a database
+func (a *Audit) Audit(projectPath, databasePath string) (findings []engine.Finding, err error) {
+	lockFiles, err := a.GetLockFiles(projectPath)
+	if err != nil || len(lockFiles) == 0 {
+		return nil, err
+	}
+
+	database, err := a.loadDatabase(databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lockFile := range lockFiles {
+		lockFileFindings, err := a.auditLockFile(lockFile, database)
+		if err != nil {
+			return nil, err
+		}
+
+		findings = append(findings, lockFileFindings...)
+	}
+
+	return findings, nil
+}
+
+func (a *Audit) GetLockFiles(projectPath string) (lockFiles []string, err error) {
+	return lockFiles, filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		switch {
+		case err != nil:
+			return err
+		case info.IsDir() && info.Name() == targetFolder:
+			return filepath.SkipDir
+		case !info.IsDir() && info.Name() == cargo.LockFile:
+			lockFiles = append(lockFiles, path)
+		}
+
+		return nil
+	})
+}
+
+func (a *Audit) loadDatabase(databasePath string) (*advisory.Database, error) {
+	if databasePath == "" {
+		return a.loadDefaultDatabase()
+	}
+
+	if !a.isDirectory(databasePath) {
+		return nil, fmt.Errorf("%s: %w", databasePath, ErrAdvisoryDatabaseNotFound)
+	}
+
+	return advisory.LoadDatabase(databasePath)
+}
+
+func (a *Audit) loadDefaultDatabase() (*advisory.Database, error) {
+	databasePath := a.getDefaultDatabasePath()
+	if !a.isDirectory(databasePath) {
+		return nil, fmt.Errorf("%s: %w", databasePath, ErrAdvisoryDatabaseNotConfigured)
+	}
+
+	return advisory.LoadDatabase(databasePath)
+}
+
+func (a *Audit) isDirectory(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+func (a *Audit) getDefaultDatabasePath() string {
+	if cargoHome := os.Getenv(cargoHomeEnv); cargoHome != "" {
+		return filepath.Join(cargoHome, defaultDatabaseFolder)
+	}
+
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, ".cargo", defaultDatabaseFolder)
+}
+
+func (a *Audit) auditLockFile(lockFile string, database *advisory.Database) (findings []engine.Finding, err error) {
+	content, err := ioutil.ReadFile(lockFile)
+	if err != nil {
+		return nil, err
+	}
+
+	packages, err := cargo.ParseLockFile(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", lockFile, err)
+	}
+
+	lines := strings.Split(string(content), "\n")
+	for _, cratePackage := range packages {
+		packageFindings, err := a.auditPackage(cratePackage, database, lockFile, lines)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", lockFile, err)
+		}
+
+		findings = append(findings, packageFindings...)
+	}
+
+	return findings, nil
+}
+
+func (a *Audit) auditPackage(cratePackage *cargo.Package, database *advisory.Database, lockFile string,
+	lines []string) (findings []engine.Finding, err error) {
+	if !cratePackage.IsFromRegistry() {
+		return nil, nil
+	}
+
+	advisories, err := database.GetAdvisories(cratePackage.Name, cratePackage.Version)
+	for _, crateAdvisory := range advisories {
+		findings = append(findings, a.newFinding(crateAdvisory, cratePackage, lockFile, lines))
+	}
+
+	return findings, err
+}
+
+func (a *Audit) newFinding(crateAdvisory *advisory.Advisory, cratePackage *cargo.Package, lockFile string,
+	lines []string) engine.Finding {
+	codeSample, column := a.getCodeSampleAndColumn(lines, cratePackage.Line)
+
+	return engine.Finding{
+		ID:          crateAdvisory.ID,
+		Name:        fmt.Sprintf("%s: %s", crateAdvisory.ID, crateAdvisory.Title),
+		Severity:    crateAdvisory.GetSeverity().ToString(),
+		Confidence:  confidence.High.ToString(),
+		Description: a.getDescription(crateAdvisory, cratePackage),
+		CodeSample:  codeSample,
+		SourceLocation: engine.Location{
+			Filename: lockFile,
+			Line:     cratePackage.Line,
+			Column:   column,
+		},
+	}
+}
+
+func (a *Audit) getDescription(crateAdvisory *advisory.Advisory, cratePackage *cargo.Package) string {
+	affected := fmt.Sprintf("The crate %s %s is affected by the advisory %s", cratePackage.Name,
+		cratePackage.Version, crateAdvisory.ID)
+	if len(crateAdvisory.Aliases) > 0 {
+		affected += fmt.Sprintf(" (%s)", strings.Join(crateAdvisory.Aliases, ", "))
+	}
+
+	sentences := []string{affected + ".", a.getFirstParagraph(crateAdvisory.Description)}
+	if len(crateAdvisory.Patched) > 0 {
+		sentences = append(sentences, fmt.Sprintf("Upgrade to a patched version (%s).",
+			strings.Join(crateAdvisory.Patched, " or ")))
+	} else {
+		sentences = append(sentences, "There is no patched version, replace the crate.")
+	}
+
+	sentences = append(sentences, fmt.Sprintf("For more information checkout the %s (%s) advisory.",
+		crateAdvisory.ID, crateAdvisory.GetURL()))
+	return strings.Join(strings.Fields(strings.Join(sentences, " ")), " ")
+}
+
+func (a *Audit) getFirstParagraph(description string) string {
+	paragraph := strings.Join(strings.Fields(strings.Split(strings.TrimSpace(description), "\n\n")[0]), " ")
+	if paragraph != "" && !strings.HasSuffix(paragraph, ".") {
+		paragraph += "."
+	}
+
+	return paragraph
+}
+
+func (a *Audit) getCodeSampleAndColumn(lines []string, line int) (string, int) {
+	if line < 1 || line > len(lines) {
+		return "", 0
+	}
+
+	content := strings.TrimRight(lines[line-1], "\r")
+	codeSample := strings.TrimSpace(content)
+	return codeSample, strings.Index(content, codeSample)
+}
@@ -0,0 +1,129 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rust
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/stretchr/testify/assert"
+)
+
+const lockFile = `version = 3
+
+[[package]]
+name = "example"
+version = "0.1.0"
+
+[[package]]
+name = "time"
+version = "0.1.43"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[[package]]
+name = "time"
+version = "0.2.23"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+`
+
+const advisoryFile = "```toml" + `
+[advisory]
+id = "RUSTSEC-2020-0071"
+package = "time"
+aliases = ["CVE-2020-26235"]
+cvss = "CVSS:3.1/AV:N/AC:H/PR:N/UI:N/S:U/C:N/I:N/A:H"
+
+[versions]
+patched = [">= 0.2.23"]
+` + "```" + `
+
+# Potential segfault in the time crate
+
+Unix-like operating systems may segfault due to dereferencing a dangling pointer
+
+More details.
+`
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	path := t.TempDir()
+	for name, content := range files {
+		assert.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(path, name)), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, name), []byte(content), os.ModePerm))
+	}
+
+	return path
+}
+
+func TestAudit(t *testing.T) {
+	databasePath := writeFiles(t, map[string]string{"crates/time/RUSTSEC-2020-0071.md": advisoryFile})
+
+	t.Run("should return findings of vulnerable crates", func(t *testing.T) {
+		projectPath := writeFiles(t, map[string]string{
+			"Cargo.lock":                    lockFile,
+			"target/debug/build/Cargo.lock": lockFile,
+		})
+
+		findings, err := NewAudit().Audit(projectPath, databasePath)
+
+		assert.NoError(t, err)
+		assert.Len(t, findings, 1)
+		assert.Equal(t, "RUSTSEC-2020-0071", findings[0].ID)
+		assert.Equal(t, "RUSTSEC-2020-0071: Potential segfault in the time crate", findings[0].Name)
+		assert.Equal(t, severity.Medium.ToString(), findings[0].Severity)
+		assert.Equal(t, `name = "time"`, findings[0].CodeSample)
+		assert.Equal(t, filepath.Join(projectPath, "Cargo.lock"), findings[0].SourceLocation.Filename)
+		assert.Equal(t, 8, findings[0].SourceLocation.Line)
+		assert.Equal(t, "The crate time 0.1.43 is affected by the advisory RUSTSEC-2020-0071 (CVE-2020-26235). "+
+			"Unix-like operating systems may segfault due to dereferencing a dangling pointer. "+
+			"Upgrade to a patched version (>= 0.2.23). For more information checkout the RUSTSEC-2020-0071 "+
+			"(https://rustsec.org/advisories/RUSTSEC-2020-0071.html) advisory.", findings[0].Description)
+	})
+
+	t.Run("should return empty without loading database when there are no lock files", func(t *testing.T) {
+		findings, err := NewAudit().Audit(writeFiles(t, map[string]string{"main.rs": ""}), "invalid")
+
+		assert.NoError(t, err)
+		assert.Empty(t, findings)
+	})
+
+	t.Run("should return error when database not exists", func(t *testing.T) {
+		projectPath := writeFiles(t, map[string]string{"Cargo.lock": lockFile})
+
+		_, err := NewAudit().Audit(projectPath, filepath.Join(projectPath, "advisory-db"))
+
+		assert.ErrorIs(t, err, ErrAdvisoryDatabaseNotFound)
+	})
+
+	t.Run("should return not configured error when path is empty and cargo has no database", func(t *testing.T) {
+		projectPath := writeFiles(t, map[string]string{"Cargo.lock": lockFile})
+		_ = os.Setenv(cargoHomeEnv, t.TempDir())
+		defer func() { _ = os.Unsetenv(cargoHomeEnv) }()
+
+		_, err := NewAudit().Audit(projectPath, "")
+
+		assert.ErrorIs(t, err, ErrAdvisoryDatabaseNotConfigured)
+	})
+
+	t.Run("should return error when lock file is invalid", func(t *testing.T) {
+		projectPath := writeFiles(t, map[string]string{"Cargo.lock": "[[package]\n"})
+
+		_, err := NewAudit().Audit(projectPath, databasePath)
+
+		assert.Error(t, err)
+	})
+}
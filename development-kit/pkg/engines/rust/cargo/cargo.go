@@ -0,0 +1,70 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+const (
+	LockFile     = "Cargo.lock"
+	ManifestFile = "Cargo.toml"
+)
+
+// Package is a crate locked in Cargo.lock, the line is where its name is declared
+type Package struct {
+	Name    string `toml:"name"`
+	Version string `toml:"version"`
+	Source  string `toml:"source"`
+	Line    int    `toml:"-"`
+}
+
+// ParseLockFile returns the packages of all lock file versions, which share the package array
+func ParseLockFile(content []byte) ([]*Package, error) {
+	tree, err := toml.LoadBytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	trees, _ := tree.Get("package").([]*toml.Tree)
+	packages := make([]*Package, 0, len(trees))
+	for _, packageTree := range trees {
+		cratePackage := &Package{}
+		if err := packageTree.Unmarshal(cratePackage); err != nil {
+			return nil, fmt.Errorf("line %d: %w", packageTree.Position().Line, err)
+		}
+
+		cratePackage.Line = packageTree.GetPosition("name").Line
+		packages = append(packages, cratePackage)
+	}
+
+	return packages, nil
+}
+
+// IsCargoFile returns true for Cargo.toml and Cargo.lock, which identify a rust project even without sources
+func IsCargoFile(path string) bool {
+	name := filepath.Base(path)
+	return name == ManifestFile || name == LockFile
+}
+
+// IsFromRegistry returns false for the packages of workspace, path and git dependencies, which are not published
+// in crates.io, so the advisories of crates with the same name are not about them
+func (p *Package) IsFromRegistry() bool {
+	return strings.HasPrefix(p.Source, "registry+") || strings.HasPrefix(p.Source, "sparse+")
+}
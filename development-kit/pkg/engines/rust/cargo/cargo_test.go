@@ -0,0 +1,79 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cargo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const exampleLockFile = `# This file is automatically @generated by Cargo.
+# It is not intended for manual editing.
+version = 3
+
+[[package]]
+name = "example"
+version = "0.1.0"
+dependencies = [
+ "time",
+]
+
+[[package]]
+name = "time"
+version = "0.1.43"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+checksum = "ca8a50ef2360fbd1eeb0ecd46795a87a19024eb4b53c5dc916ca1fd95fe62438"
+
+[[package]]
+name = "local"
+version = "1.0.0"
+source = "git+https://github.com/example/local#a1b2c3"
+`
+
+func TestParseLockFile(t *testing.T) {
+	t.Run("should return packages with lines", func(t *testing.T) {
+		packages, err := ParseLockFile([]byte(exampleLockFile))
+
+		assert.NoError(t, err)
+		assert.Len(t, packages, 3)
+		assert.Equal(t, "time", packages[1].Name)
+		assert.Equal(t, "0.1.43", packages[1].Version)
+		assert.Equal(t, 13, packages[1].Line)
+		assert.False(t, packages[0].IsFromRegistry())
+		assert.True(t, packages[1].IsFromRegistry())
+		assert.False(t, packages[2].IsFromRegistry())
+	})
+
+	t.Run("should return empty when lock file has no packages", func(t *testing.T) {
+		packages, err := ParseLockFile([]byte("version = 3\n"))
+
+		assert.NoError(t, err)
+		assert.Empty(t, packages)
+	})
+
+	t.Run("should return error when lock file is invalid", func(t *testing.T) {
+		_, err := ParseLockFile([]byte("[[package]\nname = \"time\"\n"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestIsCargoFile(t *testing.T) {
+	assert.True(t, IsCargoFile("/project/Cargo.toml"))
+	assert.True(t, IsCargoFile("Cargo.lock"))
+	assert.False(t, IsCargoFile("/project/cargo.toml"))
+	assert.False(t, IsCargoFile("/project/src/main.rs"))
+}
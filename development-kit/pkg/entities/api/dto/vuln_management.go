@@ -20,6 +20,7 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type VulnManagement struct {
@@ -40,4 +41,8 @@ type Data struct {
 	SecurityTool    tools.Tool                     `json:"securityTool"`
 	Language        languages.Language             `json:"language"`
 	Severity        severity.Severity              `json:"severity"`
+	RuleID          string                         `json:"ruleID"`
+	Title           string                         `json:"title"`
+	Remediation     string                         `json:"remediation"`
+	References      pq.StringArray                 `json:"references" gorm:"Column:reference_urls;type:text[]"`
 }
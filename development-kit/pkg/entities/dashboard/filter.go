@@ -0,0 +1,56 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dashboard
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
+	"github.com/google/uuid"
+)
+
+type Filter struct {
+	CompanyID    uuid.UUID
+	RepositoryID uuid.UUID
+	InitialDate  time.Time
+	FinalDate    time.Time
+	CWE          string
+	OWASPTop10   string
+}
+
+func (f *Filter) HasDateRange() bool {
+	return !f.InitialDate.IsZero() || !f.FinalDate.IsZero()
+}
+
+// GetCWE returns the cwe filter in the same format of the stored cwes, so "89" and "cwe-89" find "CWE-89"
+func (f *Filter) GetCWE() string {
+	return classification.NormalizeCWE(f.CWE)
+}
+
+// GetOWASPTop10Pattern returns the pattern used to find the categories starting with the filter, so "A03" and the
+// complete category name find "A03:2021-Injection"
+func (f *Filter) GetOWASPTop10Pattern() string {
+	owasp := strings.TrimSpace(f.OWASPTop10)
+	if owasp == "" {
+		return ""
+	}
+
+	return strings.NewReplacer("%", "", "_", "").Replace(owasp) + "%"
+}
+
+func (f *Filter) HasClassification() bool {
+	return f.GetCWE() != "" || f.GetOWASPTop10Pattern() != ""
+}
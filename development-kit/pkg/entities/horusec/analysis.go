@@ -216,7 +216,9 @@ func (a *Analysis) getVulnerabilitiesByType(vulnType horusec.VulnerabilityType)
 
 func (a *Analysis) SetDefaultVulnerabilityType() *Analysis {
 	for key := range a.AnalysisVulnerabilities {
-		a.AnalysisVulnerabilities[key].Vulnerability.Type = horusec.Vulnerability
+		if a.AnalysisVulnerabilities[key].Vulnerability.Type == "" {
+			a.AnalysisVulnerabilities[key].Vulnerability.Type = horusec.Vulnerability
+		}
 	}
 	return a
 }
@@ -232,12 +234,36 @@ func (a *Analysis) SetFalsePositivesAndRiskAcceptInVulnerabilities(
 
 func (a *Analysis) setVulnerabilityType(keyAnalysisVulnerabilities int,
 	listToCheck []string, vulnerabilityType horusec.VulnerabilityType) {
-	currentHash := a.AnalysisVulnerabilities[keyAnalysisVulnerabilities].Vulnerability.VulnHash
+	vulnerability := &a.AnalysisVulnerabilities[keyAnalysisVulnerabilities].Vulnerability
 	for _, flagVulnerabilityHash := range listToCheck {
-		if flagVulnerabilityHash != "" && strings.TrimSpace(currentHash) == strings.TrimSpace(flagVulnerabilityHash) {
-			a.AnalysisVulnerabilities[keyAnalysisVulnerabilities].Vulnerability.Type = vulnerabilityType
+		if flagVulnerabilityHash != "" && vulnerability.HasHash(flagVulnerabilityHash) {
+			vulnerability.Type = vulnerabilityType
+		}
+	}
+}
+
+func (a *Analysis) SetPreExistingInVulnerabilities(baselineHashes []string) *Analysis {
+	hashes := map[string]bool{}
+	for _, baselineHash := range baselineHashes {
+		if strings.TrimSpace(baselineHash) != "" {
+			hashes[strings.TrimSpace(baselineHash)] = true
 		}
 	}
+
+	for key := range a.AnalysisVulnerabilities {
+		vulnerability := &a.AnalysisVulnerabilities[key].Vulnerability
+		vulnerability.PreExisting = hashes[strings.TrimSpace(vulnerability.VulnHash)] || hashes[strings.TrimSpace(vulnerability.StableVulnHash)]
+	}
+	return a
+}
+
+func (a *Analysis) GetTotalPreExistingVulnerabilities() (total int) {
+	for index := range a.AnalysisVulnerabilities {
+		if a.AnalysisVulnerabilities[index].Vulnerability.PreExisting {
+			total++
+		}
+	}
+	return total
 }
 
 func (a *Analysis) ParseResponseBytesToAnalysis(body []byte) (analysis *Analysis, err error) {
@@ -275,6 +275,19 @@ func TestSetDefaultVulnerabilityType(t *testing.T) {
 		analysis.SetDefaultVulnerabilityType()
 		assert.Equal(t, analysis.AnalysisVulnerabilities[0].Vulnerability.Type, horusecEnum.Vulnerability)
 	})
+
+	t.Run("should keep vuln type already set", func(t *testing.T) {
+		analysis := &Analysis{
+			AnalysisVulnerabilities: []AnalysisVulnerabilities{
+				{
+					Vulnerability: Vulnerability{Type: horusecEnum.FalsePositive},
+				},
+			},
+		}
+
+		analysis.SetDefaultVulnerabilityType()
+		assert.Equal(t, horusecEnum.FalsePositive, analysis.AnalysisVulnerabilities[0].Vulnerability.Type)
+	})
 }
 
 func TestSetFalsePositivesAndRiskAcceptInVulnerabilities(t *testing.T) {
@@ -300,6 +313,30 @@ func TestSetFalsePositivesAndRiskAcceptInVulnerabilities(t *testing.T) {
 	})
 }
 
+func TestSetPreExistingInVulnerabilities(t *testing.T) {
+	t.Run("should success set pre existing vulnerabilities found in baseline", func(t *testing.T) {
+		analysis := &Analysis{
+			AnalysisVulnerabilities: []AnalysisVulnerabilities{
+				{
+					Vulnerability: Vulnerability{
+						VulnHash: "1",
+					},
+				},
+				{
+					Vulnerability: Vulnerability{
+						VulnHash: "2",
+					},
+				},
+			},
+		}
+
+		analysis.SetPreExistingInVulnerabilities([]string{" 1 ", ""})
+		assert.True(t, analysis.AnalysisVulnerabilities[0].Vulnerability.PreExisting)
+		assert.False(t, analysis.AnalysisVulnerabilities[1].Vulnerability.PreExisting)
+		assert.Equal(t, 1, analysis.GetTotalPreExistingVulnerabilities())
+	})
+}
+
 func TestParseResponseBytesToAnalysis(t *testing.T) {
 	t.Run("Should ParseResponseBytesToAnalysis without errors", func(t *testing.T) {
 		analysis := &Analysis{
@@ -15,31 +15,45 @@
 package horusec
 
 import (
+	"strings"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	severityEnum "github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/remediation"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type Vulnerability struct {
-	VulnerabilityID uuid.UUID                 `json:"vulnerabilityID" gorm:"Column:vulnerability_id"`
-	Line            string                    `json:"line" gorm:"Column:line"`
-	Column          string                    `json:"column" gorm:"Column:column"`
-	Confidence      string                    `json:"confidence" gorm:"Column:confidence"`
-	File            string                    `json:"file" gorm:"Column:file"`
-	Code            string                    `json:"code" gorm:"Column:code"`
-	Details         string                    `json:"details" gorm:"Column:details"`
-	SecurityTool    tools.Tool                `json:"securityTool" gorm:"Column:security_tool"`
-	Language        languages.Language        `json:"language" gorm:"Column:language"`
-	Severity        severityEnum.Severity     `json:"severity" gorm:"Column:severity"`
-	VulnHash        string                    `json:"vulnHash" gorm:"Column:vuln_hash"`
-	Type            horusec.VulnerabilityType `json:"type" gorm:"Column:type"`
-	CommitAuthor    string                    `json:"commitAuthor" gorm:"Column:commit_author"`
-	CommitEmail     string                    `json:"commitEmail" gorm:"Column:commit_email"`
-	CommitHash      string                    `json:"commitHash" gorm:"Column:commit_hash"`
-	CommitMessage   string                    `json:"commitMessage" gorm:"Column:commit_message"`
-	CommitDate      string                    `json:"commitDate" gorm:"Column:commit_date"`
+	VulnerabilityID   uuid.UUID                 `json:"vulnerabilityID" gorm:"Column:vulnerability_id"`
+	Line              string                    `json:"line" gorm:"Column:line"`
+	Column            string                    `json:"column" gorm:"Column:column"`
+	Confidence        string                    `json:"confidence" gorm:"Column:confidence"`
+	File              string                    `json:"file" gorm:"Column:file"`
+	Code              string                    `json:"code" gorm:"Column:code"`
+	Details           string                    `json:"details" gorm:"Column:details"`
+	SecurityTool      tools.Tool                `json:"securityTool" gorm:"Column:security_tool"`
+	Language          languages.Language        `json:"language" gorm:"Column:language"`
+	Severity          severityEnum.Severity     `json:"severity" gorm:"Column:severity"`
+	VulnHash          string                    `json:"vulnHash" gorm:"Column:vuln_hash"`
+	StableVulnHash    string                    `json:"stableVulnHash" gorm:"Column:stable_vuln_hash"`
+	Type              horusec.VulnerabilityType `json:"type" gorm:"Column:type"`
+	CommitAuthor      string                    `json:"commitAuthor" gorm:"Column:commit_author"`
+	CommitEmail       string                    `json:"commitEmail" gorm:"Column:commit_email"`
+	CommitHash        string                    `json:"commitHash" gorm:"Column:commit_hash"`
+	CommitMessage     string                    `json:"commitMessage" gorm:"Column:commit_message"`
+	CommitDate        string                    `json:"commitDate" gorm:"Column:commit_date"`
+	CWEs              pq.StringArray            `json:"cwes,omitempty" gorm:"Column:cwes;type:text[]"`
+	OWASPTop10        pq.StringArray            `json:"owaspTop10,omitempty" gorm:"Column:owasp_top10;type:text[]"`
+	RuleID            string                    `json:"ruleID,omitempty" gorm:"Column:rule_id"`
+	Title             string                    `json:"title,omitempty" gorm:"Column:title"`
+	Remediation       string                    `json:"remediation,omitempty" gorm:"Column:remediation"`
+	References        pq.StringArray            `json:"references,omitempty" gorm:"Column:reference_urls;type:text[]"`
+	PreExisting       bool                      `json:"preExisting,omitempty" gorm:"-"`
+	SuppressionReason string                    `json:"suppressionReason,omitempty" gorm:"-"`
 }
 
 func (v *Vulnerability) GetTable() string {
@@ -61,3 +75,45 @@ func (v *Vulnerability) SetType(vulnType horusec.VulnerabilityType) {
 func (v *Vulnerability) SetSeverity(severity severityEnum.Severity) {
 	v.Severity = severity
 }
+
+// HasHash checks the legacy hash and the stable fingerprint, so hashes generated before the stable fingerprint
+// was introduced keep being recognized
+func (v *Vulnerability) HasHash(vulnHash string) bool {
+	vulnHash = strings.TrimSpace(vulnHash)
+	if vulnHash == "" {
+		return false
+	}
+
+	return strings.TrimSpace(v.VulnHash) == vulnHash || strings.TrimSpace(v.StableVulnHash) == vulnHash
+}
+
+// SetClassification normalizes the CWEs of the vulnerability and sets the OWASP Top 10 categories related to them
+func (v *Vulnerability) SetClassification(cwes ...string) {
+	v.CWEs = classification.NormalizeCWEs(cwes...)
+	v.OWASPTop10 = classification.GetOWASPTop10(v.CWEs...)
+}
+
+func (v *Vulnerability) HasClassification() bool {
+	return len(v.CWEs) > 0
+}
+
+func (v *Vulnerability) SetRule(ruleID, title string) {
+	v.RuleID = strings.TrimSpace(ruleID)
+	v.Title = strings.TrimSpace(title)
+}
+
+// SetRemediation sets the remediation and the reference URLs given by the rule or tool, when remediation is empty is
+// used the generic remediation of the CWEs, so it must be called after SetClassification. The definitions of the CWEs
+// are always added in the references
+func (v *Vulnerability) SetRemediation(remediationText string, references ...string) {
+	v.Remediation = strings.TrimSpace(remediationText)
+	if v.Remediation == "" {
+		v.Remediation = remediation.GetByCWEs(v.CWEs...)
+	}
+
+	v.References = remediation.NormalizeReferences(append(references, remediation.GetCWEReferences(v.CWEs...)...)...)
+}
+
+func (v *Vulnerability) HasRuleInformation() bool {
+	return v.RuleID != "" || v.Title != "" || v.Remediation != "" || len(v.References) > 0
+}
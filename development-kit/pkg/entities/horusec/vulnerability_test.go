@@ -16,6 +16,7 @@ package horusec
 
 import (
 	horusecEnum "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"testing"
 )
@@ -48,3 +49,71 @@ func TestSetType(t *testing.T) {
 		assert.Equal(t, horusecEnum.Vulnerability, vulnerability.Type)
 	})
 }
+
+func TestHasHash(t *testing.T) {
+	t.Run("should match legacy and stable hashes", func(t *testing.T) {
+		vulnerability := &Vulnerability{VulnHash: "legacy", StableVulnHash: "stable"}
+		assert.True(t, vulnerability.HasHash("legacy"))
+		assert.True(t, vulnerability.HasHash(" stable "))
+	})
+
+	t.Run("should not match empty or unknown hashes", func(t *testing.T) {
+		vulnerability := &Vulnerability{VulnHash: "legacy"}
+		assert.False(t, vulnerability.HasHash(""))
+		assert.False(t, vulnerability.HasHash("unknown"))
+	})
+}
+
+func TestSetClassification(t *testing.T) {
+	t.Run("should normalize cwes and set owasp top 10 categories", func(t *testing.T) {
+		vulnerability := &Vulnerability{}
+		vulnerability.SetClassification("89", "CWE-89", "cwe-798")
+
+		assert.True(t, vulnerability.HasClassification())
+		assert.Equal(t, pq.StringArray{"CWE-89", "CWE-798"}, vulnerability.CWEs)
+		assert.Equal(t, pq.StringArray{"A03:2021-Injection", "A07:2021-Identification and Authentication Failures"},
+			vulnerability.OWASPTop10)
+	})
+
+	t.Run("should not classify when cwes are invalid", func(t *testing.T) {
+		vulnerability := &Vulnerability{}
+		vulnerability.SetClassification("invalid")
+
+		assert.False(t, vulnerability.HasClassification())
+		assert.Empty(t, vulnerability.OWASPTop10)
+	})
+}
+
+func TestSetRuleAndRemediation(t *testing.T) {
+	t.Run("should set rule and remediation with references of tool and cwes", func(t *testing.T) {
+		vulnerability := &Vulnerability{}
+		vulnerability.SetClassification("CWE-89")
+		vulnerability.SetRule(" G201 ", "SQL query construction using format string")
+		vulnerability.SetRemediation("Use prepared statements", "https://example.com/g201", "",
+			"https://cwe.mitre.org/data/definitions/89.html")
+
+		assert.True(t, vulnerability.HasRuleInformation())
+		assert.Equal(t, "G201", vulnerability.RuleID)
+		assert.Equal(t, "SQL query construction using format string", vulnerability.Title)
+		assert.Equal(t, "Use prepared statements", vulnerability.Remediation)
+		assert.Equal(t, pq.StringArray{"https://example.com/g201", "https://cwe.mitre.org/data/definitions/89.html"},
+			vulnerability.References)
+	})
+
+	t.Run("should use remediation of cwes when tool not provides remediation", func(t *testing.T) {
+		vulnerability := &Vulnerability{}
+		vulnerability.SetClassification("CWE-798")
+		vulnerability.SetRemediation("")
+
+		assert.Contains(t, vulnerability.Remediation, "hard-coded credential")
+		assert.Equal(t, pq.StringArray{"https://cwe.mitre.org/data/definitions/798.html"}, vulnerability.References)
+	})
+
+	t.Run("should not have rule information when nothing is set", func(t *testing.T) {
+		vulnerability := &Vulnerability{}
+		vulnerability.SetRemediation("")
+
+		assert.False(t, vulnerability.HasRuleInformation())
+		assert.Empty(t, vulnerability.References)
+	})
+}
@@ -37,6 +37,8 @@ const (
 	Yaml       Language = "YAML"
 	Elixir     Language = "Elixir"
 	Shell      Language = "Shell"
+	Dockerfile Language = "Dockerfile"
+	Rust       Language = "Rust"
 	Unknown    Language = "Unknown"
 )
 
@@ -68,6 +70,8 @@ func SupportedLanguages() []Language {
 		PHP,
 		Elixir,
 		Shell,
+		Dockerfile,
+		Rust,
 		Unknown,
 	}
 }
@@ -91,6 +95,8 @@ func (l Language) MapEnableLanguages() map[string]Language {
 		PHP.ToString():        PHP,
 		Elixir.ToString():     Elixir,
 		Shell.ToString():      Shell,
+		Dockerfile.ToString(): Dockerfile,
+		Rust.ToString():       Rust,
 	}
 }
 
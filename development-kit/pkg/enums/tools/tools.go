@@ -30,7 +30,7 @@ const (
 	HorusecKotlin     Tool = "HorusecKotlin"
 	HorusecJava       Tool = "HorusecJava"
 	HorusecLeaks      Tool = "HorusecLeaks"
-	GitLeaks          Tool = "GitLeaks"
+	GitLeaks          Tool = "GitLeaks" // deprecated
 	TfSec             Tool = "TfSec"
 	Semgrep           Tool = "Semgrep"
 	HorusecCsharp     Tool = "HorusecCsharp"
@@ -44,6 +44,14 @@ const (
 	Sobelow           Tool = "Sobelow"
 	ShellCheck        Tool = "ShellCheck"
 	BundlerAudit      Tool = "BundlerAudit"
+	HorusecGo         Tool = "HorusecGo"
+	HorusecPython     Tool = "HorusecPython"
+	HorusecGitHistory Tool = "HorusecGitHistory"
+	HorusecDockerfile Tool = "HorusecDockerfile"
+	HorusecHCL        Tool = "HorusecHCL"
+	HorusecRust       Tool = "HorusecRust"
+	HorusecCustom     Tool = "HorusecCustom"
+	External          Tool = "External" // tools declared in config, the name of tool is in details
 )
 
 func (t Tool) ToString() string {
@@ -186,6 +186,14 @@ func (au *UseCases) sliceTools() []interface{} {
 		tools.BundlerAudit,
 		tools.Sobelow,
 		tools.MixAudit,
+		tools.HorusecGo,
+		tools.HorusecPython,
+		tools.HorusecGitHistory,
+		tools.HorusecDockerfile,
+		tools.HorusecHCL,
+		tools.HorusecRust,
+		tools.HorusecCustom,
+		tools.External,
 	}
 }
 
@@ -209,6 +217,8 @@ func (au *UseCases) sliceLanguages() []interface{} {
 		languages.Generic,
 		languages.Yaml,
 		languages.Shell,
+		languages.Dockerfile,
+		languages.Rust,
 		languages.Elixir,
 		languages.Unknown,
 	}
@@ -122,6 +122,34 @@ func TestDecodeAnalysisFromIoRead(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, result)
 	})
+	t.Run("should parse io read to analysis with vulnerability of external tool", func(t *testing.T) {
+		useCases := NewAnalysisUseCases()
+
+		analysisData := apiEntities.AnalysisData{
+			Analysis: &horusecEntities.Analysis{
+				Status:     horusec.Success,
+				CreatedAt:  time.Now(),
+				FinishedAt: time.Now(),
+				AnalysisVulnerabilities: []horusecEntities.AnalysisVulnerabilities{
+					{
+						Vulnerability: horusecEntities.Vulnerability{
+							SecurityTool: tools.External,
+							Language:     languages.Go,
+							Severity:     severity.High,
+							Type:         horusec.Vulnerability,
+							Details:      "InternalLinter\nhardcoded password",
+							VulnHash:     uuid.New().String(),
+						},
+					},
+				},
+			},
+		}
+		bytes, _ := json.Marshal(analysisData)
+
+		result, err := useCases.DecodeAnalysisDataFromIoRead(ioutil.NopCloser(strings.NewReader(string(bytes))))
+		assert.NoError(t, err)
+		assert.Equal(t, tools.External, result.Analysis.AnalysisVulnerabilities[0].Vulnerability.SecurityTool)
+	})
 	t.Run("should return error parse io read to analysis", func(t *testing.T) {
 		useCases := NewAnalysisUseCases()
 
@@ -0,0 +1,143 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package classification
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	OWASPBrokenAccessControl         = "A01:2021-Broken Access Control"
+	OWASPCryptographicFailures       = "A02:2021-Cryptographic Failures"
+	OWASPInjection                   = "A03:2021-Injection"
+	OWASPInsecureDesign              = "A04:2021-Insecure Design"
+	OWASPSecurityMisconfiguration    = "A05:2021-Security Misconfiguration"
+	OWASPVulnerableComponents        = "A06:2021-Vulnerable and Outdated Components"
+	OWASPAuthenticationFailures      = "A07:2021-Identification and Authentication Failures"
+	OWASPDataIntegrityFailures       = "A08:2021-Software and Data Integrity Failures"
+	OWASPLoggingMonitoringFailures   = "A09:2021-Security Logging and Monitoring Failures"
+	OWASPServerSideRequestForgery    = "A10:2021-Server-Side Request Forgery"
+	CWEPrefix                        = "CWE-"
+	CWEVulnerableThirdPartyComponent = "CWE-1395"
+)
+
+var cweRegex = regexp.MustCompile(`(?i)\bCWE[-_: ]?(\d+)\b`)
+
+// NormalizeCWE returns the CWE in the "CWE-<number>" form, it accept values as "89", "cwe-89" or "CWE-89: SQL Injection"
+// and returns empty string when the value not contains a CWE
+func NormalizeCWE(value string) string {
+	value = strings.TrimSpace(value)
+	if number, err := strconv.Atoi(value); err == nil {
+		return formatCWE(number)
+	}
+
+	match := cweRegex.FindStringSubmatch(value)
+	if len(match) < 2 {
+		return ""
+	}
+
+	number, _ := strconv.Atoi(match[1])
+	return formatCWE(number)
+}
+
+func formatCWE(number int) string {
+	if number <= 0 {
+		return ""
+	}
+
+	return CWEPrefix + strconv.Itoa(number)
+}
+
+// NormalizeCWEs normalizes all values removing the invalid and duplicated ones, keeping the original order
+func NormalizeCWEs(values ...string) []string {
+	cwes := []string{}
+	for _, value := range values {
+		if cwe := NormalizeCWE(value); cwe != "" && !contains(cwes, cwe) {
+			cwes = append(cwes, cwe)
+		}
+	}
+
+	return cwes
+}
+
+// GetCWEsFromText returns all CWEs mentioned in a text, as the descriptions of the rules
+func GetCWEsFromText(text string) []string {
+	values := []string{}
+	for _, match := range cweRegex.FindAllString(text, -1) {
+		values = append(values, match)
+	}
+
+	return NormalizeCWEs(values...)
+}
+
+// GetOWASPTop10 returns the OWASP Top 10 2021 categories of the CWEs sorted by category
+func GetOWASPTop10(cwes ...string) []string {
+	categories := []string{}
+	for _, cwe := range NormalizeCWEs(cwes...) {
+		if category, ok := owaspTop10ByCWE()[cwe]; ok && !contains(categories, category) {
+			categories = append(categories, category)
+		}
+	}
+
+	sort.Strings(categories)
+	return categories
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func owaspTop10ByCWE() map[string]string {
+	categories := map[string]string{}
+	for category, numbers := range owaspTop10CWEs() {
+		for _, number := range numbers {
+			categories[formatCWE(number)] = category
+		}
+	}
+
+	return categories
+}
+
+// nolint:funlen mapping table of OWASP Top 10 is necessary more 15 lines
+func owaspTop10CWEs() map[string][]int {
+	return map[string][]int{
+		OWASPBrokenAccessControl: {22, 23, 35, 59, 200, 201, 219, 264, 275, 276, 284, 285, 352, 359, 377, 402, 425, 441,
+			497, 538, 540, 548, 552, 566, 601, 639, 651, 668, 706, 862, 863, 913, 922, 1275},
+		OWASPCryptographicFailures: {261, 296, 310, 319, 321, 322, 323, 324, 325, 326, 327, 328, 329, 330, 331, 335,
+			336, 337, 338, 340, 347, 523, 720, 757, 759, 760, 780, 818, 916},
+		OWASPInjection: {20, 74, 75, 77, 78, 79, 80, 83, 87, 88, 89, 90, 91, 93, 94, 95, 96, 97, 98, 99, 100, 113, 116,
+			138, 184, 470, 471, 564, 610, 643, 644, 652, 917},
+		OWASPInsecureDesign: {73, 183, 209, 213, 235, 256, 257, 266, 269, 280, 311, 312, 313, 316, 419, 430, 434, 444,
+			451, 472, 501, 522, 525, 539, 579, 598, 602, 642, 646, 650, 653, 656, 657, 799, 807, 840, 841, 927, 1021,
+			1173},
+		OWASPSecurityMisconfiguration: {2, 11, 13, 15, 16, 260, 315, 520, 526, 537, 541, 547, 611, 614, 756, 776, 942,
+			1004, 1032, 1174},
+		OWASPVulnerableComponents: {937, 1035, 1104, 1395},
+		OWASPAuthenticationFailures: {255, 259, 287, 288, 290, 294, 295, 297, 300, 302, 304, 306, 307, 346, 384, 521,
+			613, 620, 640, 798, 940, 1216},
+		OWASPDataIntegrityFailures:     {345, 353, 426, 494, 502, 565, 784, 829, 830, 915},
+		OWASPLoggingMonitoringFailures: {117, 223, 532, 778},
+		OWASPServerSideRequestForgery:  {918},
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package classification
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCWE(t *testing.T) {
+	t.Run("should normalize the cwe values", func(t *testing.T) {
+		assert.Equal(t, "CWE-89", NormalizeCWE("89"))
+		assert.Equal(t, "CWE-89", NormalizeCWE("cwe-89"))
+		assert.Equal(t, "CWE-89", NormalizeCWE(" CWE-89: Improper Neutralization of Special Elements "))
+		assert.Equal(t, "CWE-798", NormalizeCWE("CWE 798"))
+	})
+
+	t.Run("should return empty when value not contains a cwe", func(t *testing.T) {
+		assert.Empty(t, NormalizeCWE(""))
+		assert.Empty(t, NormalizeCWE("0"))
+		assert.Empty(t, NormalizeCWE("SQL Injection"))
+	})
+}
+
+func TestNormalizeCWEs(t *testing.T) {
+	t.Run("should remove invalid and duplicated values keeping the order", func(t *testing.T) {
+		assert.Equal(t, []string{"CWE-79", "CWE-89"}, NormalizeCWEs("79", "", "CWE-89", "cwe-79", "invalid"))
+	})
+}
+
+func TestGetCWEsFromText(t *testing.T) {
+	t.Run("should return the cwes mentioned in the text", func(t *testing.T) {
+		text := "SQL Injection. For more information checkout the CWE-89 (https://cwe.mitre.org/data/definitions/89.html)" +
+			" and CWE-564 advisories. See also CWE-89."
+
+		assert.Equal(t, []string{"CWE-89", "CWE-564"}, GetCWEsFromText(text))
+	})
+
+	t.Run("should return empty when text not contains cwe", func(t *testing.T) {
+		assert.Empty(t, GetCWEsFromText("Hardcoded password"))
+	})
+}
+
+func TestGetOWASPTop10(t *testing.T) {
+	t.Run("should return the categories sorted and without duplications", func(t *testing.T) {
+		assert.Equal(t, []string{OWASPInjection, OWASPAuthenticationFailures},
+			GetOWASPTop10("CWE-798", "89", "CWE-79", "CWE-1"))
+	})
+
+	t.Run("should return empty when cwe is not mapped", func(t *testing.T) {
+		assert.Empty(t, GetOWASPTop10("CWE-1"))
+	})
+}
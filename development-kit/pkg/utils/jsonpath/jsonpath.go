@@ -0,0 +1,151 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidPath = errors.New("{JSON_PATH} invalid json path")
+
+const wildcard = "*"
+
+// Get return all values found in data decoded from json by a path with the subset of JSONPath:
+// $ as root, .key or ['key'] to access an object, [n] to access an array index and [*] or .* to access all items
+func Get(data interface{}, path string) ([]interface{}, error) {
+	tokens, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := []interface{}{data}
+	for _, token := range tokens {
+		values = getValuesByToken(values, token)
+	}
+
+	return values, nil
+}
+
+// GetFirstString return the first value found by path as string or empty when path is empty or nothing was found
+func GetFirstString(data interface{}, path string) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+
+	values, err := Get(data, path)
+	if err != nil || len(values) == 0 || values[0] == nil {
+		return "", err
+	}
+
+	return toString(values[0]), nil
+}
+
+func toString(value interface{}) string {
+	switch typed := value.(type) {
+	case string:
+		return typed
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", typed)
+	}
+}
+
+func getValuesByToken(values []interface{}, token string) (result []interface{}) {
+	for _, value := range values {
+		switch typed := value.(type) {
+		case map[string]interface{}:
+			result = append(result, getValuesFromMap(typed, token)...)
+		case []interface{}:
+			result = append(result, getValuesFromSlice(typed, token)...)
+		}
+	}
+
+	return result
+}
+
+func getValuesFromMap(value map[string]interface{}, token string) []interface{} {
+	if token == wildcard {
+		result := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			result = append(result, item)
+		}
+
+		return result
+	}
+
+	if item, ok := value[token]; ok {
+		return []interface{}{item}
+	}
+
+	return nil
+}
+
+func getValuesFromSlice(value []interface{}, token string) []interface{} {
+	if token == wildcard {
+		return value
+	}
+
+	index, err := strconv.Atoi(token)
+	if err != nil || index < 0 || index >= len(value) {
+		return nil
+	}
+
+	return []interface{}{value[index]}
+}
+
+func parsePath(path string) (tokens []string, err error) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "$")
+	for path != "" {
+		var token string
+		if token, path, err = nextToken(path); err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func nextToken(path string) (token, rest string, err error) {
+	switch path[0] {
+	case '.':
+		end := strings.IndexAny(path[1:], ".[")
+		if end == -1 {
+			end = len(path) - 1
+		}
+
+		token, rest = path[1:end+1], path[end+1:]
+	case '[':
+		end := strings.Index(path, "]")
+		if end == -1 {
+			return "", "", fmt.Errorf("%w: %s", ErrInvalidPath, path)
+		}
+
+		token, rest = strings.Trim(path[1:end], `'"`), path[end+1:]
+	default:
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidPath, path)
+	}
+
+	if token == "" {
+		return "", "", fmt.Errorf("%w: %s", ErrInvalidPath, path)
+	}
+
+	return token, rest, nil
+}
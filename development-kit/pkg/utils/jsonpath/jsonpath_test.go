@@ -0,0 +1,93 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jsonpath
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func getData(t *testing.T) (data interface{}) {
+	content := `{"issues": [{"file": "main.go", "line": 10, "rule": {"id": "G101"}},
+		{"file": "api.go", "line": 20, "rule": {"id": "G102"}}], "tool.name": "linter"}`
+	assert.NoError(t, json.Unmarshal([]byte(content), &data))
+	return data
+}
+
+func TestGet(t *testing.T) {
+	t.Run("should return all items of array with wildcard", func(t *testing.T) {
+		values, err := Get(getData(t), "$.issues[*]")
+
+		assert.NoError(t, err)
+		assert.Len(t, values, 2)
+	})
+
+	t.Run("should return nested values of all items", func(t *testing.T) {
+		values, err := Get(getData(t), "$.issues[*].rule.id")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"G101", "G102"}, values)
+	})
+
+	t.Run("should return value by index and bracket key", func(t *testing.T) {
+		values, err := Get(getData(t), "$['issues'][1]['file']")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"api.go"}, values)
+	})
+
+	t.Run("should return key with dot using bracket notation", func(t *testing.T) {
+		values, err := Get(getData(t), `$["tool.name"]`)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []interface{}{"linter"}, values)
+	})
+
+	t.Run("should return empty when not found", func(t *testing.T) {
+		values, err := Get(getData(t), "$.issues[5].file")
+
+		assert.NoError(t, err)
+		assert.Empty(t, values)
+	})
+
+	t.Run("should return error when path is invalid", func(t *testing.T) {
+		_, err := Get(getData(t), "$.issues[0")
+		assert.ErrorIs(t, err, ErrInvalidPath)
+
+		_, err = Get(getData(t), "issues")
+		assert.ErrorIs(t, err, ErrInvalidPath)
+
+		_, err = Get(getData(t), "$..issues")
+		assert.ErrorIs(t, err, ErrInvalidPath)
+	})
+}
+
+func TestGetFirstString(t *testing.T) {
+	t.Run("should return number as string", func(t *testing.T) {
+		value, err := GetFirstString(map[string]interface{}{"line": float64(10)}, "$.line")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "10", value)
+	})
+
+	t.Run("should return empty when path is empty", func(t *testing.T) {
+		value, err := GetFirstString(map[string]interface{}{"line": float64(10)}, "")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "", value)
+	})
+}
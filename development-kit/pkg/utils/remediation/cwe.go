@@ -0,0 +1,88 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+// nolint:funlen,lll mapping table of remediations is necessary more 15 lines
+func remediationsByCWE() map[string]string {
+	return map[string]string{
+		"CWE-20":   "Validate all input against the expected type, length, format and range before using it.",
+		"CWE-22":   "Do not build file paths with user input. Validate the input against an allow list and resolve the canonical path to check it is inside the expected directory.",
+		"CWE-77":   "Avoid building commands with user input. Use APIs that receive the command and its arguments separately and validate the arguments against an allow list.",
+		"CWE-78":   "Avoid calling the shell with user input. Use APIs that receive the command and its arguments separately and validate the arguments against an allow list.",
+		"CWE-79":   "Encode the user input according to the context where it is rendered and prefer template engines that escape the output by default.",
+		"CWE-88":   "Validate the arguments against an allow list and separate the options from the arguments with \"--\" when the command supports it.",
+		"CWE-89":   "Use parameterized queries or prepared statements instead of concatenating user input in the SQL query.",
+		"CWE-90":   "Escape the special characters of LDAP in the user input or use APIs which build the filters safely.",
+		"CWE-94":   "Do not evaluate code built with user input. Replace the dynamic evaluation with explicit logic or a safe parser.",
+		"CWE-95":   "Do not evaluate code built with user input. Replace the dynamic evaluation with explicit logic or a safe parser.",
+		"CWE-113":  "Remove the line breaks of the user input before writing it in the HTTP headers.",
+		"CWE-117":  "Neutralize the line breaks and the special characters of the user input before writing it in the logs.",
+		"CWE-190":  "Check the bounds of the values before the arithmetic operations and conversions between integer types.",
+		"CWE-200":  "Review which data is exposed and remove the sensitive information from responses, logs and error messages.",
+		"CWE-209":  "Show generic error messages to users and keep the details of the errors only in the internal logs.",
+		"CWE-250":  "Run the process with the least privileges needed and drop the privileges as soon as they are not needed.",
+		"CWE-259":  "Remove the hard-coded password and load it from a secret manager or from the environment at runtime.",
+		"CWE-269":  "Grant only the privileges needed and check the privileges before each sensitive operation.",
+		"CWE-276":  "Set restrictive permissions on the files and directories created, granting access only to the owner.",
+		"CWE-284":  "Restrict the access to the resource to the users and services which need it.",
+		"CWE-285":  "Check the authorization of the user in the server side before each sensitive operation.",
+		"CWE-287":  "Authenticate all requests to sensitive resources using the authentication mechanism of the framework.",
+		"CWE-295":  "Enable the validation of certificates and host names in the TLS connections.",
+		"CWE-297":  "Validate the host name of the certificate in the TLS connections.",
+		"CWE-306":  "Require authentication before the critical functions.",
+		"CWE-307":  "Limit the number of failed authentication attempts, as locking the account or adding delays.",
+		"CWE-310":  "Use the cryptographic APIs with modern algorithms and secure defaults.",
+		"CWE-311":  "Encrypt the sensitive data at rest and in transit.",
+		"CWE-312":  "Do not store sensitive data in clear text, encrypt it or store only a salted hash when possible.",
+		"CWE-319":  "Use encrypted protocols as HTTPS and TLS to transmit the data.",
+		"CWE-326":  "Use keys of adequate length, as RSA keys of at least 2048 bits.",
+		"CWE-327":  "Replace the broken or risky algorithm by a modern one, as AES-GCM for encryption and SHA-256 or better for hashing.",
+		"CWE-328":  "Replace the weak hash by SHA-256 or better, and use a password hashing function as bcrypt, scrypt or Argon2 for passwords.",
+		"CWE-330":  "Use a cryptographically secure random number generator for security sensitive values.",
+		"CWE-338":  "Use a cryptographically secure random number generator for security sensitive values.",
+		"CWE-347":  "Verify the signatures of the data before trusting it, using a modern algorithm.",
+		"CWE-352":  "Enable the CSRF protection of the framework and validate a CSRF token in all state changing requests.",
+		"CWE-377":  "Create temporary files with the secure APIs of the language, which generate unique names and restrictive permissions.",
+		"CWE-400":  "Limit the resources that each request can consume, as size, time and number of operations.",
+		"CWE-409":  "Limit the size of the decompressed data and the number of files extracted.",
+		"CWE-434":  "Validate the type and size of uploaded files, rename them and store them outside of the web root.",
+		"CWE-489":  "Disable the debug mode and remove the debug code before deploying the application.",
+		"CWE-502":  "Do not deserialize untrusted data, or use formats which not allow to instantiate arbitrary types and validate the data.",
+		"CWE-521":  "Require strong passwords, with a minimum length and checked against lists of common passwords.",
+		"CWE-522":  "Protect the credentials in storage and in transit and never expose them in code or logs.",
+		"CWE-532":  "Remove the sensitive information from the messages written in the logs.",
+		"CWE-552":  "Do not expose files and directories which are not intended to be accessed by users.",
+		"CWE-601":  "Do not redirect to URLs built with user input, or validate the destination against an allow list.",
+		"CWE-611":  "Disable the external entities and the DTD processing in the XML parser.",
+		"CWE-613":  "Set a short expiration for the sessions and invalidate them in the logout.",
+		"CWE-614":  "Set the Secure flag in cookies with sensitive information.",
+		"CWE-643":  "Use parameterized XPath queries or escape the user input before building the query.",
+		"CWE-668":  "Restrict the access to the resource to the intended sphere, avoiding to expose it to other applications or users.",
+		"CWE-676":  "Replace the dangerous function by a safe alternative which checks the bounds of the buffers.",
+		"CWE-693":  "Enable the protection mechanisms of the platform and of the framework.",
+		"CWE-703":  "Check and handle all errors returned by the functions.",
+		"CWE-732":  "Grant the permissions only to the users and services which need them.",
+		"CWE-749":  "Do not expose dangerous methods or functions to untrusted code.",
+		"CWE-770":  "Limit the size and the number of the resources allocated by each request.",
+		"CWE-798":  "Remove the hard-coded credential, revoke it and load the new one from a secret manager or from the environment at runtime.",
+		"CWE-829":  "Load only code from trusted sources and do not build the module or file to include with user input.",
+		"CWE-915":  "Allow only the expected attributes to be changed by user input.",
+		"CWE-918":  "Validate the destination of the requests against an allow list and do not build URLs with user input.",
+		"CWE-942":  "Restrict the origins allowed by the CORS policy to the trusted ones.",
+		"CWE-1004": "Set the HttpOnly flag in cookies with sensitive information.",
+		"CWE-1333": "Avoid regular expressions with nested quantifiers and do not build regular expressions with user input.",
+		"CWE-1395": "Update the dependency to a version where the vulnerability is fixed, or replace it when there is no fix.",
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
+)
+
+const cweDefinitionURL = "https://cwe.mitre.org/data/definitions/%s.html"
+
+var urlRegex = regexp.MustCompile(`https?://[^\s<>"'\[\]{}]+`)
+
+// GetByCWEs returns the generic remediation of the first CWE with known remediation, it is used when the rule or
+// the tool not provides a remediation of its own
+func GetByCWEs(cwes ...string) string {
+	remediations := remediationsByCWE()
+	for _, cwe := range classification.NormalizeCWEs(cwes...) {
+		if remediation, ok := remediations[cwe]; ok {
+			return remediation
+		}
+	}
+
+	return ""
+}
+
+// GetCWEReferences returns the URLs of the definitions of the CWEs in the MITRE site
+func GetCWEReferences(cwes ...string) []string {
+	references := []string{}
+	for _, cwe := range classification.NormalizeCWEs(cwes...) {
+		references = append(references, fmt.Sprintf(cweDefinitionURL, strings.TrimPrefix(cwe, classification.CWEPrefix)))
+	}
+
+	return references
+}
+
+// GetURLsFromText returns all URLs mentioned in a text, as the descriptions of the rules
+func GetURLsFromText(text string) []string {
+	return NormalizeReferences(urlRegex.FindAllString(text, -1)...)
+}
+
+// NormalizeReferences removes the values which are not URLs and the duplicated ones, keeping the original order
+func NormalizeReferences(values ...string) []string {
+	references := []string{}
+	for _, value := range values {
+		value = trimURL(value)
+		if value != "" && urlRegex.FindString(value) == value && !contains(references, value) {
+			references = append(references, value)
+		}
+	}
+
+	return references
+}
+
+// trimURL removes the punctuation after the URL in the text, the closing parentheses are removed only when they are
+// not opened in the URL, as in "(https://cwe.mitre.org/data/definitions/89.html)"
+func trimURL(value string) string {
+	value = strings.TrimRight(strings.TrimSpace(value), ".,;:")
+	for strings.HasSuffix(value, ")") && strings.Count(value, ")") > strings.Count(value, "(") {
+		value = strings.TrimRight(strings.TrimSuffix(value, ")"), ".,;:")
+	}
+
+	return value
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remediation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetByCWEs(t *testing.T) {
+	t.Run("should return remediation of first cwe with known remediation", func(t *testing.T) {
+		assert.Equal(t, remediationsByCWE()["CWE-89"], GetByCWEs("CWE-99999", "89", "CWE-79"))
+	})
+
+	t.Run("should return empty when no cwe has remediation", func(t *testing.T) {
+		assert.Empty(t, GetByCWEs("CWE-99999"))
+		assert.Empty(t, GetByCWEs())
+	})
+}
+
+func TestGetCWEReferences(t *testing.T) {
+	t.Run("should return the mitre urls of the cwes", func(t *testing.T) {
+		assert.Equal(t, []string{"https://cwe.mitre.org/data/definitions/89.html",
+			"https://cwe.mitre.org/data/definitions/79.html"}, GetCWEReferences("CWE-89", "79", "invalid"))
+	})
+}
+
+func TestGetURLsFromText(t *testing.T) {
+	t.Run("should return the urls mentioned in the text without duplications", func(t *testing.T) {
+		text := "For more information checkout the CWE-611 (https://cwe.mitre.org/data/definitions/611.html) " +
+			"advisory. See https://owasp.org/www-community/vulnerabilities/XML_External_Entity_(XXE)_Processing. " +
+			"https://cwe.mitre.org/data/definitions/611.html"
+
+		assert.Equal(t, []string{"https://cwe.mitre.org/data/definitions/611.html",
+			"https://owasp.org/www-community/vulnerabilities/XML_External_Entity_(XXE)_Processing"}, GetURLsFromText(text))
+	})
+
+	t.Run("should return empty when text not contains urls", func(t *testing.T) {
+		assert.Empty(t, GetURLsFromText("Hard-coded password"))
+	})
+}
+
+func TestNormalizeReferences(t *testing.T) {
+	t.Run("should remove invalid and duplicated values keeping the order", func(t *testing.T) {
+		assert.Equal(t, []string{"https://example.com/b", "http://example.com/a"},
+			NormalizeReferences(" https://example.com/b ", "", "not url", "http://example.com/a.", "https://example.com/b"))
+	})
+}
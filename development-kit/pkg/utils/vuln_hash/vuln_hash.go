@@ -16,6 +16,8 @@ package vulnhash
 
 import (
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
@@ -35,6 +37,52 @@ func Bind(vuln *horusec.Vulnerability) *horusec.Vulnerability {
 	return vuln
 }
 
+// BindStable generates for each vulnerability of the analysis a fingerprint that does not depend on the line
+// where the vulnerability was found. It is built from the normalized code, the rule (details and tool), the file
+// and the occurrence index of the same finding inside the file, so unrelated edits that only move code around
+// keep the same fingerprint.
+func BindStable(analysis *horusec.Analysis) *horusec.Analysis {
+	occurrences := map[string]int{}
+	for _, index := range sortIndexesByFileAndLine(analysis) {
+		vuln := &analysis.AnalysisVulnerabilities[index].Vulnerability
+		key := stableKey(vuln)
+		vuln.StableVulnHash, _ = hash.GenerateSHA256(key, strconv.Itoa(occurrences[key]))
+		occurrences[key]++
+	}
+
+	return analysis
+}
+
+func stableKey(vuln *horusec.Vulnerability) string {
+	return strings.Join([]string{toOneLine(vuln.Code), vuln.Details, vuln.SecurityTool.ToString(), vuln.File}, "|")
+}
+
+func sortIndexesByFileAndLine(analysis *horusec.Analysis) []int {
+	indexes := make([]int, len(analysis.AnalysisVulnerabilities))
+	for index := range indexes {
+		indexes[index] = index
+	}
+
+	sort.SliceStable(indexes, func(i, j int) bool {
+		first := analysis.AnalysisVulnerabilities[indexes[i]].Vulnerability
+		second := analysis.AnalysisVulnerabilities[indexes[j]].Vulnerability
+		if first.File != second.File {
+			return first.File < second.File
+		}
+		if toInt(first.Line) != toInt(second.Line) {
+			return toInt(first.Line) < toInt(second.Line)
+		}
+		return toInt(first.Column) < toInt(second.Column)
+	})
+
+	return indexes
+}
+
+func toInt(value string) int {
+	number, _ := strconv.Atoi(strings.TrimSpace(value))
+	return number
+}
+
 func toOneLine(code string) string {
 	re := regexp.MustCompile(`\r?\n?\t`)
 	// remove line break
@@ -45,6 +45,44 @@ func TestBind(t *testing.T) {
 	})
 }
 
+func TestBindStable(t *testing.T) {
+	newAnalysis := func(lines ...string) *horusec.Analysis {
+		analysis := &horusec.Analysis{}
+		for _, line := range lines {
+			analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities, horusec.AnalysisVulnerabilities{
+				Vulnerability: horusec.Vulnerability{Code: "test", File: "test.go", Details: "rule", Line: line},
+			})
+		}
+		return analysis
+	}
+
+	t.Run("should bind the same stable hash when vulnerability changes the line", func(t *testing.T) {
+		first := BindStable(newAnalysis("10"))
+		second := BindStable(newAnalysis("42"))
+
+		assert.NotEmpty(t, first.AnalysisVulnerabilities[0].Vulnerability.StableVulnHash)
+		assert.Equal(t, first.AnalysisVulnerabilities[0].Vulnerability.StableVulnHash,
+			second.AnalysisVulnerabilities[0].Vulnerability.StableVulnHash)
+	})
+
+	t.Run("should bind different stable hashes to repeated occurrences in the same file", func(t *testing.T) {
+		analysis := BindStable(newAnalysis("20", "10"))
+
+		assert.NotEqual(t, analysis.AnalysisVulnerabilities[0].Vulnerability.StableVulnHash,
+			analysis.AnalysisVulnerabilities[1].Vulnerability.StableVulnHash)
+	})
+
+	t.Run("should bind stable hashes ordered by line independent of the order found", func(t *testing.T) {
+		first := BindStable(newAnalysis("10", "20"))
+		second := BindStable(newAnalysis("25", "15"))
+
+		assert.Equal(t, first.AnalysisVulnerabilities[0].Vulnerability.StableVulnHash,
+			second.AnalysisVulnerabilities[1].Vulnerability.StableVulnHash)
+		assert.Equal(t, first.AnalysisVulnerabilities[1].Vulnerability.StableVulnHash,
+			second.AnalysisVulnerabilities[0].Vulnerability.StableVulnHash)
+	})
+}
+
 func TestToOneLine(t *testing.T) {
 	t.Run("should compress an string to a one line string wihtout whitespaces", func(t *testing.T) {
 		str := "func() {" +
@@ -0,0 +1,4 @@
+fn main() {
+    let now = time::now_utc();
+    println!("started at {}", time::strftime("%Y-%m-%dT%H:%M:%S", &now).unwrap());
+}
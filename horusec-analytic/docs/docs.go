@@ -67,6 +67,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -181,6 +193,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -240,6 +264,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -299,6 +335,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -358,6 +406,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -417,6 +477,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -476,6 +548,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -535,6 +619,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -587,6 +683,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -639,6 +747,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -691,6 +811,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -743,6 +875,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -795,6 +939,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -847,6 +1003,18 @@ var doc = `{
                         "description": "finalDate query string",
                         "name": "finalDate",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "cwe query string",
+                        "name": "cwe",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "owaspTop10 query string",
+                        "name": "owaspTop10",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -15,34 +15,24 @@
 package dashboard
 
 import (
-	"time"
-
 	"github.com/ZupIT/horusec/development-kit/pkg/databases/relational"
 	analysisRepository "github.com/ZupIT/horusec/development-kit/pkg/databases/relational/repository/analysis"
 	dashboardEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/dashboard"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/errors"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	dashboardUseCases "github.com/ZupIT/horusec/horusec-analytic/internal/usecases/dashboard"
-	"github.com/google/uuid"
 	"github.com/graphql-go/graphql"
 )
 
 type IController interface {
 	GetVulnerabilitiesByAuthor(query string, page, size int) (*graphql.Result, error)
-	GetTotalDevelopers(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (int, error)
-	GetTotalRepositories(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) (int, error)
-	GetVulnBySeverity(companyID, repositoryID uuid.UUID, initialDate,
-		finalDate time.Time) ([]dashboardEntities.VulnBySeverity, error)
-	GetVulnByDeveloper(companyID, repositoryID uuid.UUID,
-		initialDate, finalDate time.Time) ([]dashboardEntities.VulnByDeveloper, error)
-	GetVulnByLanguage(companyID, repositoryID uuid.UUID,
-		initialDate, finalDate time.Time) ([]dashboardEntities.VulnByLanguage, error)
-	GetVulnByTime(companyID, repositoryID uuid.UUID,
-		initialDate, finalDate time.Time) ([]dashboardEntities.VulnByTime, error)
-	GetVulnByRepository(companyID, repositoryID uuid.UUID,
-		initialDate, finalDate time.Time) ([]dashboardEntities.VulnByRepository, error)
+	GetTotalDevelopers(filter *dashboardEntities.Filter) (int, error)
+	GetTotalRepositories(filter *dashboardEntities.Filter) (int, error)
+	GetVulnBySeverity(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnBySeverity, error)
+	GetVulnByDeveloper(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByDeveloper, error)
+	GetVulnByLanguage(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByLanguage, error)
+	GetVulnByTime(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByTime, error)
+	GetVulnByRepository(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByRepository, error)
 }
 
 type Controller struct {
@@ -82,9 +72,7 @@ func (c *Controller) createQueryType(page, size int) graphql.ObjectConfig {
 
 func (c *Controller) getVulnDetails(params *graphql.ResolveParams,
 	page, size int) ([]dashboardEntities.VulnDetails, error) {
-	result, err := c.repository.GetDetailsPaginated(c.useCases.GetCompanyIDByParams(params),
-		c.useCases.GetRepositoryIDByParams(params), page, size, c.useCases.GetInitialDateByParams(params),
-		c.useCases.GetFinalDateByParams(params))
+	result, err := c.repository.GetDetailsPaginated(c.useCases.GetFilterByParams(params), page, size)
 
 	logger.LogError("{GetVulnDetails} something went wrong ->", err)
 
@@ -92,72 +80,63 @@ func (c *Controller) getVulnDetails(params *graphql.ResolveParams,
 }
 
 func (c *Controller) getVulnDetailsCount(params *graphql.ResolveParams) (int, error) {
-	result, err := c.repository.GetDetailsCount(c.useCases.GetCompanyIDByParams(params),
-		c.useCases.GetRepositoryIDByParams(params), c.useCases.GetInitialDateByParams(params),
-		c.useCases.GetFinalDateByParams(params))
+	result, err := c.repository.GetDetailsCount(c.useCases.GetFilterByParams(params))
 
 	logger.LogError("{GetVulnDetailsCount} something went wrong ->", err)
 
 	return result, err
 }
 
-func (c *Controller) GetTotalDevelopers(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (int, error) {
-	result, err := c.repository.GetDeveloperCount(companyID, repositoryID, initialDate, finalDate)
+func (c *Controller) GetTotalDevelopers(filter *dashboardEntities.Filter) (int, error) {
+	result, err := c.repository.GetDeveloperCount(filter)
 
 	logger.LogError("{GetTotalDevelopers} something went wrong ->", err)
 
 	return result, err
 }
 
-func (c *Controller) GetTotalRepositories(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) (int, error) {
-	result, err := c.repository.GetRepositoryCount(companyID, repositoryID, initialDate, finalDate)
+func (c *Controller) GetTotalRepositories(filter *dashboardEntities.Filter) (int, error) {
+	result, err := c.repository.GetRepositoryCount(filter)
 
 	logger.LogError("{GetTotalRepositories} something went wrong ->", err)
 
 	return result, err
 }
 
-func (c *Controller) GetVulnBySeverity(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) ([]dashboardEntities.VulnBySeverity, error) {
-	result, err := c.repository.GetVulnBySeverity(companyID, repositoryID, initialDate, finalDate)
+func (c *Controller) GetVulnBySeverity(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnBySeverity, error) {
+	result, err := c.repository.GetVulnBySeverity(filter)
 
 	logger.LogError("{GetVulnBySeverity} something went wrong ->", err)
 
 	return result, err
 }
 
-func (c *Controller) GetVulnByDeveloper(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByDeveloper, error) {
-	result, err := c.repository.GetVulnByDeveloper(companyID, repositoryID, initialDate, finalDate)
+func (c *Controller) GetVulnByDeveloper(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByDeveloper, error) {
+	result, err := c.repository.GetVulnByDeveloper(filter)
 
 	logger.LogError("{GetVulnByDeveloper} something went wrong ->", err)
 
 	return result, err
 }
 
-func (c *Controller) GetVulnByLanguage(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByLanguage, error) {
-	result, err := c.repository.GetVulnByLanguage(companyID, repositoryID, initialDate, finalDate)
+func (c *Controller) GetVulnByLanguage(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByLanguage, error) {
+	result, err := c.repository.GetVulnByLanguage(filter)
 
 	logger.LogError("{GetVulnByLanguage} something went wrong ->", err)
 
 	return result, err
 }
 
-func (c *Controller) GetVulnByTime(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByTime, error) {
-	result, err := c.repository.GetVulnByTime(companyID, repositoryID, initialDate, finalDate)
+func (c *Controller) GetVulnByTime(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByTime, error) {
+	result, err := c.repository.GetVulnByTime(filter)
 
 	logger.LogError("{GetVulnByTime} something went wrong ->", err)
 
 	return result, err
 }
 
-func (c *Controller) GetVulnByRepository(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByRepository, error) {
-	result, err := c.repository.GetVulnByRepository(companyID, repositoryID, initialDate, finalDate)
+func (c *Controller) GetVulnByRepository(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByRepository, error) {
+	result, err := c.repository.GetVulnByRepository(filter)
 
 	logger.LogError("{GetVulnByRepository} something went wrong ->", err)
 
@@ -15,11 +15,8 @@
 package dashboard
 
 import (
-	"time"
-
 	dashboardEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/dashboard"
 	mockUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
-	"github.com/google/uuid"
 	"github.com/graphql-go/graphql"
 	"github.com/stretchr/testify/mock"
 )
@@ -33,42 +30,37 @@ func (m *Mock) GetVulnerabilitiesByAuthor(query string, page, size int) (*graphq
 	return args.Get(0).(*graphql.Result), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetTotalDevelopers(companyID, repositoryID uuid.UUID, initialDate, finalDate time.Time) (int, error) {
+func (m *Mock) GetTotalDevelopers(filter *dashboardEntities.Filter) (int, error) {
 	args := m.MethodCalled("GetTotalDevelopers")
 	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetTotalRepositories(companyID, repositoryID uuid.UUID, initialDate, finalDate time.Time) (int, error) {
+func (m *Mock) GetTotalRepositories(filter *dashboardEntities.Filter) (int, error) {
 	args := m.MethodCalled("GetTotalRepositories")
 	return args.Get(0).(int), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnBySeverity(companyID, repositoryID uuid.UUID, initialDate,
-	finalDate time.Time) ([]dashboardEntities.VulnBySeverity, error) {
+func (m *Mock) GetVulnBySeverity(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnBySeverity, error) {
 	args := m.MethodCalled("GetVulnBySeverity")
 	return args.Get(0).([]dashboardEntities.VulnBySeverity), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByDeveloper(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByDeveloper, error) {
+func (m *Mock) GetVulnByDeveloper(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByDeveloper, error) {
 	args := m.MethodCalled("GetVulnByDeveloper")
 	return args.Get(0).([]dashboardEntities.VulnByDeveloper), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByLanguage(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByLanguage, error) {
+func (m *Mock) GetVulnByLanguage(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByLanguage, error) {
 	args := m.MethodCalled("GetVulnByLanguage")
 	return args.Get(0).([]dashboardEntities.VulnByLanguage), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByTime(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByTime, error) {
+func (m *Mock) GetVulnByTime(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByTime, error) {
 	args := m.MethodCalled("GetVulnByTime")
 	return args.Get(0).([]dashboardEntities.VulnByTime), mockUtils.ReturnNilOrError(args, 1)
 }
 
-func (m *Mock) GetVulnByRepository(companyID, repositoryID uuid.UUID,
-	initialDate, finalDate time.Time) ([]dashboardEntities.VulnByRepository, error) {
+func (m *Mock) GetVulnByRepository(filter *dashboardEntities.Filter) ([]dashboardEntities.VulnByRepository, error) {
 	args := m.MethodCalled("GetVulnByRepository")
 	return args.Get(0).([]dashboardEntities.VulnByRepository), mockUtils.ReturnNilOrError(args, 1)
 }
@@ -122,7 +122,7 @@ func TestGetTotalDevelopers(t *testing.T) {
 			repository: analysisMock,
 		}
 
-		result, err := controller.GetTotalDevelopers(uuid.Nil, uuid.Nil, time.Now(), time.Now())
+		result, err := controller.GetTotalDevelopers(&dashboard.Filter{})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
@@ -141,7 +141,7 @@ func TestGetTotalRepositories(t *testing.T) {
 			repository: analysisMock,
 		}
 
-		result, err := controller.GetTotalRepositories(uuid.Nil, uuid.Nil, time.Now(), time.Now())
+		result, err := controller.GetTotalRepositories(&dashboard.Filter{})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
@@ -160,7 +160,7 @@ func TestGetVulnBySeverity(t *testing.T) {
 			repository: analysisMock,
 		}
 
-		result, err := controller.GetVulnBySeverity(uuid.Nil, uuid.Nil, time.Now(), time.Now())
+		result, err := controller.GetVulnBySeverity(&dashboard.Filter{})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
@@ -179,7 +179,7 @@ func TestGetVulnByDeveloper(t *testing.T) {
 			repository: analysisMock,
 		}
 
-		result, err := controller.GetVulnByDeveloper(uuid.Nil, uuid.Nil, time.Now(), time.Now())
+		result, err := controller.GetVulnByDeveloper(&dashboard.Filter{})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
@@ -198,7 +198,7 @@ func TestGetVulnByLanguage(t *testing.T) {
 			repository: analysisMock,
 		}
 
-		result, err := controller.GetVulnByLanguage(uuid.Nil, uuid.Nil, time.Now(), time.Now())
+		result, err := controller.GetVulnByLanguage(&dashboard.Filter{})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
@@ -217,7 +217,7 @@ func TestGetVulnByTime(t *testing.T) {
 			repository: analysisMock,
 		}
 
-		result, err := controller.GetVulnByTime(uuid.Nil, uuid.Nil, time.Now(), time.Now())
+		result, err := controller.GetVulnByTime(&dashboard.Filter{})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
@@ -236,7 +236,7 @@ func TestGetVulnByRepository(t *testing.T) {
 			repository: analysisMock,
 		}
 
-		result, err := controller.GetVulnByRepository(uuid.Nil, uuid.Nil, time.Now(), time.Now())
+		result, err := controller.GetVulnByRepository(&dashboard.Filter{})
 
 		assert.NoError(t, err)
 		assert.NotEmpty(t, result)
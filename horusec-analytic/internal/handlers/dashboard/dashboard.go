@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/databases/relational"
+	dashboardEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/dashboard"
 	"github.com/go-chi/chi"
 	"github.com/google/uuid"
 
@@ -82,6 +83,8 @@ func (h *Handler) GetVulnDetails(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -89,13 +92,13 @@ func (h *Handler) GetVulnDetails(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 // @Security ApiKeyAuth
 func (h *Handler) GetCompanyTotalDevelopers(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	companyID, _ := uuid.Parse(chi.URLParam(r, "companyID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetTotalDevelopers(companyID, uuid.Nil, *initialDate, *finalDate)
+	result, err := h.controller.GetTotalDevelopers(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -112,6 +115,8 @@ func (h *Handler) GetCompanyTotalDevelopers(w netHTTP.ResponseWriter, r *netHTTP
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -119,13 +124,13 @@ func (h *Handler) GetCompanyTotalDevelopers(w netHTTP.ResponseWriter, r *netHTTP
 // @Security ApiKeyAuth
 func (h *Handler) GetCompanyTotalRepositories(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	companyID, _ := uuid.Parse(chi.URLParam(r, "companyID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetTotalRepositories(companyID, uuid.Nil, *initialDate, *finalDate)
+	result, err := h.controller.GetTotalRepositories(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -142,6 +147,8 @@ func (h *Handler) GetCompanyTotalRepositories(w netHTTP.ResponseWriter, r *netHT
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -149,13 +156,13 @@ func (h *Handler) GetCompanyTotalRepositories(w netHTTP.ResponseWriter, r *netHT
 // @Security ApiKeyAuth
 func (h *Handler) GetCompanyVulnByDeveloper(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	companyID, _ := uuid.Parse(chi.URLParam(r, "companyID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByDeveloper(companyID, uuid.Nil, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByDeveloper(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -172,6 +179,8 @@ func (h *Handler) GetCompanyVulnByDeveloper(w netHTTP.ResponseWriter, r *netHTTP
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -179,13 +188,13 @@ func (h *Handler) GetCompanyVulnByDeveloper(w netHTTP.ResponseWriter, r *netHTTP
 // @Security ApiKeyAuth
 func (h *Handler) GetCompanyVulnByLanguage(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	companyID, _ := uuid.Parse(chi.URLParam(r, "companyID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByLanguage(companyID, uuid.Nil, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByLanguage(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -202,6 +211,8 @@ func (h *Handler) GetCompanyVulnByLanguage(w netHTTP.ResponseWriter, r *netHTTP.
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -209,13 +220,13 @@ func (h *Handler) GetCompanyVulnByLanguage(w netHTTP.ResponseWriter, r *netHTTP.
 // @Security ApiKeyAuth
 func (h *Handler) GetCompanyVulnByRepository(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	companyID, _ := uuid.Parse(chi.URLParam(r, "companyID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByRepository(companyID, uuid.Nil, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByRepository(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -232,6 +243,8 @@ func (h *Handler) GetCompanyVulnByRepository(w netHTTP.ResponseWriter, r *netHTT
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -239,13 +252,13 @@ func (h *Handler) GetCompanyVulnByRepository(w netHTTP.ResponseWriter, r *netHTT
 // @Security ApiKeyAuth
 func (h *Handler) GetCompanyVulnByTime(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	companyID, _ := uuid.Parse(chi.URLParam(r, "companyID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByTime(companyID, uuid.Nil, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByTime(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -262,6 +275,8 @@ func (h *Handler) GetCompanyVulnByTime(w netHTTP.ResponseWriter, r *netHTTP.Requ
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -269,13 +284,13 @@ func (h *Handler) GetCompanyVulnByTime(w netHTTP.ResponseWriter, r *netHTTP.Requ
 // @Security ApiKeyAuth
 func (h *Handler) GetCompanyVulnBySeverity(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	companyID, _ := uuid.Parse(chi.URLParam(r, "companyID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnBySeverity(companyID, uuid.Nil, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnBySeverity(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -293,6 +308,8 @@ func (h *Handler) GetCompanyVulnBySeverity(w netHTTP.ResponseWriter, r *netHTTP.
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -300,13 +317,13 @@ func (h *Handler) GetCompanyVulnBySeverity(w netHTTP.ResponseWriter, r *netHTTP.
 // @Security ApiKeyAuth
 func (h *Handler) GetRepositoryTotalDevelopers(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	repositoryID, _ := uuid.Parse(chi.URLParam(r, "repositoryID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, uuid.Nil, repositoryID)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetTotalDevelopers(uuid.Nil, repositoryID, *initialDate, *finalDate)
+	result, err := h.controller.GetTotalDevelopers(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -324,6 +341,8 @@ func (h *Handler) GetRepositoryTotalDevelopers(w netHTTP.ResponseWriter, r *netH
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -331,13 +350,13 @@ func (h *Handler) GetRepositoryTotalDevelopers(w netHTTP.ResponseWriter, r *netH
 // @Security ApiKeyAuth
 func (h *Handler) GetRepositoryTotalRepositories(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	repositoryID, _ := uuid.Parse(chi.URLParam(r, "repositoryID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, uuid.Nil, repositoryID)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetTotalRepositories(uuid.Nil, repositoryID, *initialDate, *finalDate)
+	result, err := h.controller.GetTotalRepositories(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -355,6 +374,8 @@ func (h *Handler) GetRepositoryTotalRepositories(w netHTTP.ResponseWriter, r *ne
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -362,13 +383,13 @@ func (h *Handler) GetRepositoryTotalRepositories(w netHTTP.ResponseWriter, r *ne
 // @Security ApiKeyAuth
 func (h *Handler) GetRepositoryVulnByDeveloper(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	repositoryID, _ := uuid.Parse(chi.URLParam(r, "repositoryID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, uuid.Nil, repositoryID)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByDeveloper(uuid.Nil, repositoryID, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByDeveloper(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -386,6 +407,8 @@ func (h *Handler) GetRepositoryVulnByDeveloper(w netHTTP.ResponseWriter, r *netH
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -393,13 +416,13 @@ func (h *Handler) GetRepositoryVulnByDeveloper(w netHTTP.ResponseWriter, r *netH
 // @Security ApiKeyAuth
 func (h *Handler) GetRepositoryVulnByLanguage(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	repositoryID, _ := uuid.Parse(chi.URLParam(r, "repositoryID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, uuid.Nil, repositoryID)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByLanguage(uuid.Nil, repositoryID, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByLanguage(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -417,6 +440,8 @@ func (h *Handler) GetRepositoryVulnByLanguage(w netHTTP.ResponseWriter, r *netHT
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -424,13 +449,13 @@ func (h *Handler) GetRepositoryVulnByLanguage(w netHTTP.ResponseWriter, r *netHT
 // @Security ApiKeyAuth
 func (h *Handler) GetRepositoryVulnByRepository(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	repositoryID, _ := uuid.Parse(chi.URLParam(r, "repositoryID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, uuid.Nil, repositoryID)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByRepository(uuid.Nil, repositoryID, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByRepository(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -448,6 +473,8 @@ func (h *Handler) GetRepositoryVulnByRepository(w netHTTP.ResponseWriter, r *net
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -455,13 +482,13 @@ func (h *Handler) GetRepositoryVulnByRepository(w netHTTP.ResponseWriter, r *net
 // @Security ApiKeyAuth
 func (h *Handler) GetRepositoryVulnByTime(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	repositoryID, _ := uuid.Parse(chi.URLParam(r, "repositoryID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, uuid.Nil, repositoryID)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnByTime(uuid.Nil, repositoryID, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnByTime(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -479,6 +506,8 @@ func (h *Handler) GetRepositoryVulnByTime(w netHTTP.ResponseWriter, r *netHTTP.R
 // @Param companyID path string true "companyID of the company"
 // @Param initialDate query string false "initialDate query string"
 // @Param finalDate query string false "finalDate query string"
+// @Param cwe query string false "cwe query string"
+// @Param owaspTop10 query string false "owaspTop10 query string"
 // @Success 200 "OK"
 // @Failure 400 "BAD REQUEST"
 // @Failure 500 "INTERNAL SERVER ERROR"
@@ -486,13 +515,13 @@ func (h *Handler) GetRepositoryVulnByTime(w netHTTP.ResponseWriter, r *netHTTP.R
 // @Security ApiKeyAuth
 func (h *Handler) GetRepositoryVulnBySeverity(w netHTTP.ResponseWriter, r *netHTTP.Request) {
 	repositoryID, _ := uuid.Parse(chi.URLParam(r, "repositoryID"))
-	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	filter, err := getFilterFromRequestQuery(r, uuid.Nil, repositoryID)
 	if err != nil {
 		httpUtil.StatusUnprocessableEntity(w, err)
 		return
 	}
 
-	result, err := h.controller.GetVulnBySeverity(uuid.Nil, repositoryID, *initialDate, *finalDate)
+	result, err := h.controller.GetVulnBySeverity(filter)
 	if err != nil {
 		httpUtil.StatusInternalServerError(w, err)
 		return
@@ -501,6 +530,23 @@ func (h *Handler) GetRepositoryVulnBySeverity(w netHTTP.ResponseWriter, r *netHT
 	httpUtil.StatusOK(w, result)
 }
 
+func getFilterFromRequestQuery(r *netHTTP.Request,
+	companyID, repositoryID uuid.UUID) (*dashboardEntities.Filter, error) {
+	initialDate, finalDate, err := getDateRangeFromRequestQuery(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dashboardEntities.Filter{
+		CompanyID:    companyID,
+		RepositoryID: repositoryID,
+		InitialDate:  *initialDate,
+		FinalDate:    *finalDate,
+		CWE:          r.URL.Query().Get("cwe"),
+		OWASPTop10:   r.URL.Query().Get("owaspTop10"),
+	}, nil
+}
+
 func getDateRangeFromRequestQuery(r *netHTTP.Request) (*time.Time, *time.Time, error) {
 	initial, err := getDateFromRequestQuery(r, "initialDate")
 	if err != nil {
@@ -840,3 +840,29 @@ func TestGetRepositoryVulnBySeverity(t *testing.T) {
 		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
 	})
 }
+
+func TestGetFilterFromRequestQuery(t *testing.T) {
+	t.Run("should return filter with classification and date range", func(t *testing.T) {
+		companyID := uuid.New()
+		r, _ := http.NewRequest(http.MethodGet,
+			"api/dashboard?initialDate=2006-01-02T15:04:05Z&cwe=cwe-89&owaspTop10=A03", nil)
+
+		filter, err := getFilterFromRequestQuery(r, companyID, uuid.Nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, companyID, filter.CompanyID)
+		assert.Equal(t, uuid.Nil, filter.RepositoryID)
+		assert.True(t, filter.HasDateRange())
+		assert.Equal(t, "CWE-89", filter.GetCWE())
+		assert.Equal(t, "A03%", filter.GetOWASPTop10Pattern())
+	})
+
+	t.Run("should return error when invalid date", func(t *testing.T) {
+		r, _ := http.NewRequest(http.MethodGet, "api/dashboard?initialDate=invalid", nil)
+
+		filter, err := getFilterFromRequestQuery(r, uuid.New(), uuid.Nil)
+
+		assert.Error(t, err)
+		assert.Nil(t, filter)
+	})
+}
@@ -15,9 +15,11 @@
 package dashboard
 
 import (
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/dashboard"
 	"github.com/google/uuid"
 	"github.com/graphql-go/graphql"
-	"time"
 )
 
 type IUseCases interface {
@@ -27,6 +29,7 @@ type IUseCases interface {
 	GetRepositoryIDByParams(params *graphql.ResolveParams) uuid.UUID
 	GetInitialDateByParams(params *graphql.ResolveParams) time.Time
 	GetFinalDateByParams(params *graphql.ResolveParams) time.Time
+	GetFilterByParams(params *graphql.ResolveParams) *dashboard.Filter
 }
 
 type UseCases struct {
@@ -62,6 +65,20 @@ func (u *UseCases) GetFinalDateByParams(params *graphql.ResolveParams) time.Time
 	return finalDate
 }
 
+func (u *UseCases) GetFilterByParams(params *graphql.ResolveParams) *dashboard.Filter {
+	cwe, _ := params.Args["cwe"].(string)
+	owasp, _ := params.Args["owaspTop10"].(string)
+
+	return &dashboard.Filter{
+		CompanyID:    u.GetCompanyIDByParams(params),
+		RepositoryID: u.GetRepositoryIDByParams(params),
+		InitialDate:  u.GetInitialDateByParams(params),
+		FinalDate:    u.GetFinalDateByParams(params),
+		CWE:          cwe,
+		OWASPTop10:   owasp,
+	}
+}
+
 func (u *UseCases) CreateQueryTypeArgs() graphql.FieldConfigArgument {
 	return graphql.FieldConfigArgument{
 		"companyID": &graphql.ArgumentConfig{
@@ -76,6 +93,12 @@ func (u *UseCases) CreateQueryTypeArgs() graphql.FieldConfigArgument {
 		"finalDate": &graphql.ArgumentConfig{
 			Type: graphql.DateTime,
 		},
+		"cwe": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
+		"owaspTop10": &graphql.ArgumentConfig{
+			Type: graphql.String,
+		},
 	}
 }
 
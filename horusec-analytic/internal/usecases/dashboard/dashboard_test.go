@@ -112,6 +112,31 @@ func TestGetFinalDateByParams(t *testing.T) {
 	})
 }
 
+func TestGetFilterByParams(t *testing.T) {
+	t.Run("should get filter by params", func(t *testing.T) {
+		useCases := NewDashboardUseCases()
+
+		id := uuid.New()
+		date := time.Now()
+
+		params := &graphql.ResolveParams{
+			Args: map[string]interface{}{
+				"companyID":   id.String(),
+				"initialDate": date,
+				"cwe":         "89",
+				"owaspTop10":  "A03",
+			},
+		}
+
+		filter := useCases.GetFilterByParams(params)
+		assert.Equal(t, id, filter.CompanyID)
+		assert.Equal(t, uuid.Nil, filter.RepositoryID)
+		assert.Equal(t, date, filter.InitialDate)
+		assert.Equal(t, "CWE-89", filter.GetCWE())
+		assert.Equal(t, "A03%", filter.GetOWASPTop10Pattern())
+	})
+}
+
 //func TestParseResponseToVulnDetails(t *testing.T) {
 //	t.Run("should success parse response to vulnerabilities", func(t *testing.T) {
 //		useCases := NewDashboardUseCases()
@@ -96,5 +96,11 @@ func createVulnerabilityType() *graphql.Object {
 		"commitDate": &graphql.Field{
 			Type: graphql.String,
 		},
+		"cwes": &graphql.Field{
+			Type: graphql.NewList(graphql.String),
+		},
+		"owaspTop10": &graphql.Field{
+			Type: graphql.NewList(graphql.String),
+		},
 	}})
 }
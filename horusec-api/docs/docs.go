@@ -1493,12 +1493,27 @@ var doc = `{
                 "line": {
                     "type": "string"
                 },
+                "references": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "remediation": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "type": "string"
+                },
                 "securityTool": {
                     "type": "string"
                 },
                 "severity": {
                     "type": "string"
                 },
+                "title": {
+                    "type": "string"
+                },
                 "type": {
                     "type": "string"
                 },
@@ -39,6 +39,7 @@ import (
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	errorsEnum "github.com/ZupIT/horusec/development-kit/pkg/enums/errors"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/repository/response"
 	"github.com/go-chi/chi"
 	"github.com/stretchr/testify/assert"
@@ -235,6 +236,47 @@ func TestPost(t *testing.T) {
 
 		assert.Equal(t, http.StatusCreated, w.Code)
 	})
+	t.Run("Should return 201 when analysis has vulnerability of external tool", func(t *testing.T) {
+		mockRead := &relational.MockRead{}
+		mockWrite := &relational.MockWrite{}
+
+		_ = os.Setenv(config2.EnvRelationalDialect, "sqlite")
+		_ = os.Setenv(config2.EnvRelationalURI, "tmp/tmp-"+uuid.New().String()+".db")
+		conn := adapter.NewRepositoryRead().GetConnection()
+		_ = conn.Table("analysis").AutoMigrate(&horusec.Analysis{})
+		_ = conn.Table("analysis_vulnerabilities").AutoMigrate(&horusec.AnalysisVulnerabilities{})
+		_ = conn.Table("vulnerabilities").AutoMigrate(&horusec.Vulnerability{})
+		resp := &response.Response{}
+
+		mockWrite.On("StartTransaction").Return(mockWrite)
+		mockWrite.On("Create").Return(resp)
+		mockWrite.On("CommitTransaction").Return(resp)
+		mockWrite.On("GetConnection").Return(conn)
+
+		mockRead.On("Find").Return(resp)
+		mockRead.On("SetFilter").Return(conn)
+
+		analysis := test.CreateAnalysisMock()
+		analysis.AnalysisVulnerabilities[0].Vulnerability.SecurityTool = tools.External
+		analysis.AnalysisVulnerabilities[0].Vulnerability.Details = "InternalLinter\nhardcoded password"
+		analysisData := apiEntities.AnalysisData{
+			Analysis:       analysis,
+			RepositoryName: "",
+		}
+
+		handler := NewHandler(mockRead, mockWrite, mockBroker, config)
+		r, _ := http.NewRequest(http.MethodPost, "api/analysis", bytes.NewReader(analysisData.ToBytes()))
+		ctx := r.Context()
+		ctx = context.WithValue(ctx, middlewares.RepositoryIDCtxKey, uuid.New())
+		ctx = context.WithValue(ctx, middlewares.CompanyIDCtxKey, uuid.New())
+		r = r.WithContext(ctx)
+		r.Header.Set("X-Horusec-Authorization", uuid.New().String())
+		w := httptest.NewRecorder()
+
+		handler.Post(w, r)
+
+		assert.Equal(t, http.StatusCreated, w.Code)
+	})
 	t.Run("Should return 400 when body is nil", func(t *testing.T) {
 		mockRead := &relational.MockRead{}
 		mockWrite := &relational.MockWrite{}
@@ -15,12 +15,16 @@
 package main
 
 import (
+	"errors"
+
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/generate"
+	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/rules"
 	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/start"
 	"github.com/ZupIT/horusec/horusec-cli/cmd/horusec/version"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/spf13/cobra"
 	"os"
 )
@@ -44,6 +48,7 @@ horusec start -p="/home/user/projects/my-project"
 func init() {
 	startCmd := start.NewStartCommand(configs)
 	generateCmd := generate.NewGenerateCommand()
+	rulesCmd := rules.NewRulesCommand(configs)
 
 	_ = rootCmd.PersistentFlags().String("log-level", configs.GetLogLevel(), "Set verbose level of the CLI. Log Level enable is: \"panic\",\"fatal\",\"error\",\"warn\",\"info\",\"debug\",\"trace\"")
 	_ = rootCmd.PersistentFlags().String("config-file-path", configs.GetConfigFilePath(), "Path of the file horusec-config.json to setup content of horusec")
@@ -51,16 +56,23 @@ func init() {
 	rootCmd.AddCommand(version.NewVersionCommand().CreateCobraCmd())
 	rootCmd.AddCommand(startCmd.CreateStartCommand())
 	rootCmd.AddCommand(generateCmd.CreateCobraCmd())
+	rootCmd.AddCommand(rulesCmd.CreateCobraCmd())
 
 	cobra.OnInitialize(func() {
 		startCmd.SetGlobalCmd(rootCmd)
 		generateCmd.SetGlobalCmd(rootCmd)
+		rulesCmd.SetGlobalCmd(rootCmd)
 		engine.SetLogLevel(configs.GetLogLevel())
 	})
 }
 
 func main() {
 	if err := rootCmd.Execute(); err != nil {
+		var violation *policy.ViolationError
+		if errors.As(err, &violation) {
+			os.Exit(violation.ExitCode)
+		}
+
 		os.Exit(1)
 	} else {
 		os.Exit(0)
@@ -0,0 +1,188 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ZupIT/horusec/horusec-cli/internal/enums/outputtype"
+	rulesService "github.com/ZupIT/horusec/horusec-cli/internal/services/rules"
+	"github.com/spf13/cobra"
+)
+
+var ErrInvalidOutputFormat = errors.New("{HORUSEC_CLI} invalid output format, the options are: text, json")
+
+// nolint:funlen method is necessary more 15 lines
+func (r *Rules) createListCmd() *cobra.Command {
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the built-in rules of horusec",
+		Long: "List the built-in rules of the horusec engines with their ID, language, engine, severity, " +
+			"confidence and name. Use the json output format or the show command to see their descriptions",
+		Example: `
+horusec rules list
+horusec rules list --language=Go --severity=HIGH
+horusec rules list --search="sql injection" -o=json
+`,
+		SilenceUsage: true,
+		RunE:         r.runList,
+	}
+
+	_ = listCmd.Flags().String("language", "", "Show only the rules of the language, like Go, Java or HCL")
+	_ = listCmd.Flags().String("engine", "", "Show only the rules of the engine, like HorusecGo or HorusecKubernetes")
+	_ = listCmd.Flags().String("severity", "", "Show only the rules of the severity, like HIGH or LOW")
+	_ = listCmd.Flags().String("confidence", "", "Show only the rules of the confidence, like HIGH or LOW")
+	_ = listCmd.Flags().String("search", "", "Show only the rules with the text in their ID, name or description")
+	_ = listCmd.Flags().StringP("output-format", "o", outputtype.Text.ToString(),
+		"The format for the output to be shown. Options are: text, json")
+	return listCmd
+}
+
+func (r *Rules) createShowCmd() *cobra.Command {
+	showCmd := &cobra.Command{
+		Use:          "show [rule ID]",
+		Short:        "Show the detail of a built-in rule of horusec",
+		Long:         "Show all information of a built-in rule of horusec, including its regular expressions",
+		Example:      "horusec rules show 6d44fe57-24d2-44a0-ba0e-a1e688030c5d",
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		RunE:         r.runShow,
+	}
+
+	_ = showCmd.Flags().StringP("output-format", "o", outputtype.Text.ToString(),
+		"The format for the output to be shown. Options are: text, json")
+	return showCmd
+}
+
+func (r *Rules) runList(cmd *cobra.Command, _ []string) error {
+	outputFormat, err := r.getOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	rules := rulesService.FilterRules(rulesService.GetBuiltInRules(), r.getFilter(cmd))
+	if outputFormat == outputtype.JSON {
+		return r.printJSON(cmd.OutOrStdout(), r.toDetails(rules))
+	}
+
+	r.printTable(cmd.OutOrStdout(), rules)
+	return nil
+}
+
+func (r *Rules) runShow(cmd *cobra.Command, args []string) error {
+	outputFormat, err := r.getOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	rules := rulesService.GetRulesByID(rulesService.GetBuiltInRules(), args[0])
+	if len(rules) == 0 {
+		return fmt.Errorf("%w: %s", rulesService.ErrRuleNotFound, args[0])
+	}
+
+	return r.printDetails(cmd.OutOrStdout(), outputFormat, rules)
+}
+
+func (r *Rules) getOutputFormat(cmd *cobra.Command) (outputtype.OutputType, error) {
+	outputFormat, _ := cmd.Flags().GetString("output-format")
+	switch outputtype.OutputType(strings.ToLower(outputFormat)) {
+	case outputtype.Text:
+		return outputtype.Text, nil
+	case outputtype.JSON:
+		return outputtype.JSON, nil
+	}
+
+	return "", ErrInvalidOutputFormat
+}
+
+func (r *Rules) getFilter(cmd *cobra.Command) *rulesService.Filter {
+	filter := &rulesService.Filter{}
+	filter.Language, _ = cmd.Flags().GetString("language")
+	filter.Engine, _ = cmd.Flags().GetString("engine")
+	filter.Severity, _ = cmd.Flags().GetString("severity")
+	filter.Confidence, _ = cmd.Flags().GetString("confidence")
+	filter.Search, _ = cmd.Flags().GetString("search")
+	return filter
+}
+
+func (r *Rules) toDetails(rules []rulesService.Rule) []*rulesService.Detail {
+	details := make([]*rulesService.Detail, 0, len(rules))
+	for index := range rules {
+		details = append(details, rules[index].ToDetail())
+	}
+
+	return details
+}
+
+func (r *Rules) printJSON(out io.Writer, details []*rulesService.Detail) error {
+	content, err := json.MarshalIndent(details, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(out, string(content))
+	return err
+}
+
+func (r *Rules) printTable(out io.Writer, rules []rulesService.Rule) {
+	writer := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(writer, "ID\tLANGUAGE\tENGINE\tSEVERITY\tCONFIDENCE\tNAME")
+	for index := range rules {
+		_, _ = fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", rules[index].ID, rules[index].Language,
+			rules[index].Tool, rules[index].Severity, rules[index].Confidence, rules[index].Name)
+	}
+
+	_ = writer.Flush()
+	_, _ = fmt.Fprintf(out, "\nTotal of rules: %d\n", len(rules))
+}
+
+func (r *Rules) printDetails(out io.Writer, outputFormat outputtype.OutputType, rules []rulesService.Rule) error {
+	if outputFormat == outputtype.JSON {
+		return r.printJSON(out, r.toDetails(rules))
+	}
+
+	for index := range rules {
+		r.printDetail(out, rules[index].ToDetail())
+	}
+
+	return nil
+}
+
+func (r *Rules) printDetail(out io.Writer, detail *rulesService.Detail) {
+	_, _ = fmt.Fprintf(out, "ID: %s\nName: %s\nDescription: %s\nSeverity: %s\nConfidence: %s\n",
+		detail.ID, detail.Name, detail.Description, detail.Severity, detail.Confidence)
+	_, _ = fmt.Fprintf(out, "Language: %s\nEngine: %s\nType: %s\n", detail.Language, detail.Engine, detail.Type)
+	r.printList(out, "Expressions", detail.Expressions)
+	r.printList(out, "Targets", detail.Targets)
+	r.printList(out, "CWE", detail.CWEs)
+	r.printList(out, "OWASP Top 10", detail.OWASPTop10)
+	_, _ = fmt.Fprintln(out)
+}
+
+func (r *Rules) printList(out io.Writer, title string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "%s:\n", title)
+	for _, value := range values {
+		_, _ = fmt.Fprintf(out, "  %s\n", value)
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	rulesService "github.com/ZupIT/horusec/horusec-cli/internal/services/rules"
+	"github.com/stretchr/testify/assert"
+)
+
+func executeRulesCmd(args ...string) (string, error) {
+	cobraCmd := NewRulesCommand(config.NewConfig()).CreateCobraCmd()
+	stdoutMock := bytes.NewBufferString("")
+	cobraCmd.SetOut(stdoutMock)
+	cobraCmd.SetErr(stdoutMock)
+	cobraCmd.SetArgs(args)
+
+	err := cobraCmd.Execute()
+	outputBytes, _ := ioutil.ReadAll(stdoutMock)
+	return string(outputBytes), err
+}
+
+func TestRules_List(t *testing.T) {
+	t.Run("should list built-in rules filtered by language and severity", func(t *testing.T) {
+		output, err := executeRulesCmd("list", "--language=HCL", "--severity=HIGH")
+
+		assert.NoError(t, err)
+		assert.Contains(t, output, "ID")
+		assert.Contains(t, output, "3f81b118-ecd0-43b7-a40a-a6fac4ada944")
+		assert.NotContains(t, output, "HorusecGo")
+	})
+
+	t.Run("should list rules as json with descriptions", func(t *testing.T) {
+		output, err := executeRulesCmd("list", "--search=InsecureSkipVerify", "-o=json")
+
+		assert.NoError(t, err)
+		var details []rulesService.Detail
+		assert.NoError(t, json.Unmarshal([]byte(output), &details))
+		assert.Len(t, details, 1)
+		assert.Equal(t, "6d44fe57-24d2-44a0-ba0e-a1e688030c5d", details[0].ID)
+		assert.NotEmpty(t, details[0].Description)
+	})
+
+	t.Run("should return error when output format is invalid", func(t *testing.T) {
+		_, err := executeRulesCmd("list", "-o=sarif")
+
+		assert.ErrorIs(t, err, ErrInvalidOutputFormat)
+	})
+}
+
+func TestRules_Show(t *testing.T) {
+	t.Run("should show detail of rule with expressions", func(t *testing.T) {
+		output, err := executeRulesCmd("show", "6d44fe57-24d2-44a0-ba0e-a1e688030c5d")
+
+		assert.NoError(t, err)
+		assert.Contains(t, output, "Name: TLS InsecureSkipVerify set true")
+		assert.Contains(t, output, "Engine: HorusecGo")
+		assert.Contains(t, output, `InsecureSkipVerify\s*:\s*true`)
+	})
+
+	t.Run("should show rule as json", func(t *testing.T) {
+		output, err := executeRulesCmd("show", "3f81b118-ecd0-43b7-a40a-a6fac4ada944", "-o=json")
+
+		assert.NoError(t, err)
+		var details []rulesService.Detail
+		assert.NoError(t, json.Unmarshal([]byte(output), &details))
+		assert.Equal(t, []string{"resource aws_s3_bucket", "resource aws_s3_bucket_acl"}, details[0].Targets)
+	})
+
+	t.Run("should return error when rule not exists", func(t *testing.T) {
+		_, err := executeRulesCmd("show", "not-exists")
+
+		assert.ErrorIs(t, err, rulesService.ErrRuleNotFound)
+	})
+}
@@ -0,0 +1,218 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	customrules "github.com/ZupIT/horusec/horusec-cli/internal/services/custom_rules"
+	rulesService "github.com/ZupIT/horusec/horusec-cli/internal/services/rules"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ErrRulesFailed       = errors.New("{HORUSEC_CLI} some rules did not pass their fixtures")
+	ErrInvalidCustomRule = errors.New("{HORUSEC_CLI} some custom rules could not be loaded")
+)
+
+type IRules interface {
+	SetGlobalCmd(globalCmd *cobra.Command)
+	CreateCobraCmd() *cobra.Command
+}
+
+type Rules struct {
+	globalCmd *cobra.Command
+	configs   config.IConfig
+}
+
+func NewRulesCommand(configs config.IConfig) IRules {
+	return &Rules{
+		configs: configs,
+	}
+}
+
+func (r *Rules) SetGlobalCmd(globalCmd *cobra.Command) {
+	r.globalCmd = globalCmd
+}
+
+// nolint:funlen method is necessary more 15 lines
+func (r *Rules) CreateCobraCmd() *cobra.Command {
+	rulesCmd := &cobra.Command{
+		Use:     "rules",
+		Short:   "Manage the rules of horusec",
+		Long:    "Manage the built-in rules of the horusec engines and the custom rules of user",
+		Example: `
+horusec rules list --language=Go
+horusec rules show 6d44fe57-24d2-44a0-ba0e-a1e688030c5d
+horusec rules test -c="./horusec-rules"
+`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmd.Help()
+		},
+	}
+
+	rulesCmd.AddCommand(r.createTestCmd())
+	rulesCmd.AddCommand(r.createListCmd())
+	rulesCmd.AddCommand(r.createShowCmd())
+	return rulesCmd
+}
+
+// nolint:funlen method is necessary more 15 lines
+func (r *Rules) createTestCmd() *cobra.Command {
+	testCmd := &cobra.Command{
+		Use:   "test [fixtures paths]",
+		Short: "Test rules against annotated fixture files",
+		Long: "Run the rules against fixture files with markers like \"// ruleid: HS-JAVA-1\" before the lines " +
+			"which the rule must find and \"// ok: HS-JAVA-1\" before the lines which it must not find. " +
+			"When no fixtures paths are informed the folder of custom rules is used",
+		Example: `
+horusec rules test -c="./horusec-rules"
+horusec rules test -c="./horusec-rules/java.yaml" ./fixtures/java
+horusec rules test --builtin ./fixtures
+`,
+		SilenceUsage: true,
+		RunE:         r.runTest,
+	}
+
+	_ = testCmd.Flags().StringP("custom-rules-path", "c", r.configs.GetCustomRulesPath(),
+		"Path of a file or folder with the JSON or YAML files of custom rules to test")
+	_ = testCmd.Flags().Bool("builtin", false, "Also test the built-in rules of horusec engines")
+	return testCmd
+}
+
+func (r *Rules) setConfig(cmd *cobra.Command) {
+	r.configs = r.configs.NewConfigsFromCobraAndLoadsCmdGlobalFlags(r.globalCmd).NormalizeConfigs()
+	r.configs = r.configs.NewConfigsFromViper().NormalizeConfigs()
+	r.configs = r.configs.NewConfigsFromEnvironments().NormalizeConfigs()
+	if cmd.Flags().Changed("custom-rules-path") {
+		customRulesPath, _ := cmd.Flags().GetString("custom-rules-path")
+		r.configs.SetCustomRulesPath(customRulesPath)
+	}
+}
+
+func (r *Rules) runTest(cmd *cobra.Command, args []string) error {
+	r.setConfig(cmd)
+	customRulesService := customrules.NewCustomRulesService(r.configs)
+	customRules := rulesService.GetCustomRules(customRulesService.GetCustomRules())
+
+	results, err := r.testFixtures(r.getRulesToTest(cmd, customRules), r.getFixturesPaths(args))
+	if err != nil {
+		return err
+	}
+
+	r.printResults(cmd.OutOrStdout(), results)
+	r.printRulesWithoutFixtures(cmd.OutOrStdout(), customRules, results)
+	return r.getTestResult(results, customRulesService.GetErrors())
+}
+
+func (r *Rules) getRulesToTest(cmd *cobra.Command, customRules []rulesService.Rule) []rulesService.Rule {
+	if builtIn, _ := cmd.Flags().GetBool("builtin"); builtIn {
+		return append(customRules, rulesService.GetBuiltInRules()...)
+	}
+
+	return customRules
+}
+
+// getFixturesPaths returns the paths of args, or the folder of custom rules to keep fixtures next to their rules
+func (r *Rules) getFixturesPaths(args []string) []string {
+	if len(args) > 0 {
+		return args
+	}
+
+	customRulesPath := r.configs.GetCustomRulesPath()
+	if customRulesPath == "" {
+		return []string{"."}
+	}
+
+	if info, err := os.Stat(customRulesPath); err == nil && !info.IsDir() {
+		return []string{filepath.Dir(customRulesPath)}
+	}
+
+	return []string{customRulesPath}
+}
+
+func (r *Rules) testFixtures(rules []rulesService.Rule, paths []string) (results []*rulesService.Result, err error) {
+	tester := rulesService.NewTester(rules)
+	for _, path := range paths {
+		pathResults, err := tester.Test(path)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pathResults...)
+	}
+
+	return results, nil
+}
+
+func (r *Rules) printResults(out io.Writer, results []*rulesService.Result) {
+	passed := 0
+	for _, result := range results {
+		if result.IsPassed() {
+			passed++
+			_, _ = fmt.Fprintf(out, "PASS  %s  %s\n", result.RuleID, result.File)
+			continue
+		}
+
+		r.printFailedResult(out, result)
+	}
+
+	_, _ = fmt.Fprintf(out, "\n%d passed, %d failed\n", passed, len(results)-passed)
+}
+
+func (r *Rules) printFailedResult(out io.Writer, result *rulesService.Result) {
+	_, _ = fmt.Fprintf(out, "FAIL  %s  %s\n", result.RuleID, result.File)
+	if result.Error != nil {
+		_, _ = fmt.Fprintf(out, "      error: %v\n", result.Error)
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "      expected lines: %v\n", result.Expected)
+	_, _ = fmt.Fprintf(out, "      found lines: %v\n", result.Found)
+	_, _ = fmt.Fprintf(out, "      missing lines: %v\n", result.Missing)
+	_, _ = fmt.Fprintf(out, "      unexpected lines: %v\n", result.Unexpected)
+}
+
+func (r *Rules) printRulesWithoutFixtures(out io.Writer, rules []rulesService.Rule, results []*rulesService.Result) {
+	tested := map[string]bool{}
+	for _, result := range results {
+		tested[result.RuleID] = true
+	}
+
+	for index := range rules {
+		if !tested[rules[index].ID] {
+			_, _ = fmt.Fprintf(out, "WARN  %s  no fixture found for custom rule\n", rules[index].ID)
+		}
+	}
+}
+
+func (r *Rules) getTestResult(results []*rulesService.Result, loadErrors []error) error {
+	for _, result := range results {
+		if !result.IsPassed() {
+			return ErrRulesFailed
+		}
+	}
+
+	if len(loadErrors) > 0 {
+		return ErrInvalidCustomRule
+	}
+
+	return nil
+}
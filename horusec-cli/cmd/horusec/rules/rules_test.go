@@ -0,0 +1,144 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+const customRules = `
+- id: 11111111-1111-1111-1111-111111111111
+  name: Command execution
+  description: Command execution with tainted input
+  tool: HorusecJava
+  severity: HIGH
+  confidence: HIGH
+  type: Regular
+  expressions:
+    - '\.exec\('
+`
+
+const invalidCustomRules = `
+- id: 22222222-2222-2222-2222-222222222222
+  name: Invalid regex
+  description: Invalid regex
+  tool: HorusecJava
+  severity: HIGH
+  confidence: HIGH
+  type: Regular
+  expressions:
+    - '(unclosed'
+`
+
+const fixture = `class Main {
+    // ruleid: 11111111-1111-1111-1111-111111111111
+    void run() { Runtime.getRuntime().exec(input); }
+}
+`
+
+func newGlobalCmd(dir string) *cobra.Command {
+	globalCmd := &cobra.Command{}
+	_ = globalCmd.PersistentFlags().String("log-level", "info", "Set verbose level of the CLI")
+	_ = globalCmd.PersistentFlags().String("config-file-path", filepath.Join(dir, "horusec-config.json"),
+		"Path of the file horusec-config.json to setup content of horusec")
+	return globalCmd
+}
+
+func executeTestCmd(t *testing.T, dir string, args ...string) (string, error) {
+	rulesCmd := NewRulesCommand(config.NewConfig())
+	rulesCmd.SetGlobalCmd(newGlobalCmd(dir))
+	cobraCmd := rulesCmd.CreateCobraCmd()
+	stdoutMock := bytes.NewBufferString("")
+	cobraCmd.SetOut(stdoutMock)
+	cobraCmd.SetErr(stdoutMock)
+	cobraCmd.SetArgs(append([]string{"test"}, args...))
+
+	err := cobraCmd.Execute()
+	outputBytes, _ := ioutil.ReadAll(stdoutMock)
+	return string(outputBytes), err
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600))
+	}
+}
+
+func TestRules_CreateCobraCmd(t *testing.T) {
+	t.Run("should create rules command with test subcommand", func(t *testing.T) {
+		cobraCmd := NewRulesCommand(config.NewConfig()).CreateCobraCmd()
+
+		testCmd, _, err := cobraCmd.Find([]string{"test"})
+		assert.NoError(t, err)
+		assert.Equal(t, "test", testCmd.Name())
+		assert.NotNil(t, testCmd.Flags().Lookup("custom-rules-path"))
+		assert.NotNil(t, testCmd.Flags().Lookup("builtin"))
+	})
+
+	t.Run("should pass when custom rules find the marked lines of fixtures in their folder", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{"rules.yaml": customRules, "Main.java": fixture})
+
+		output, err := executeTestCmd(t, dir, "-c", filepath.Join(dir, "rules.yaml"))
+
+		assert.NoError(t, err)
+		assert.Contains(t, output, "PASS  11111111-1111-1111-1111-111111111111")
+		assert.Contains(t, output, "1 passed, 0 failed")
+	})
+
+	t.Run("should return error and print lines when rule finds unexpected lines", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{"rules.yaml": customRules,
+			"Main.java": fixture + "class Other { void run() { r.exec(input); } }\n"})
+
+		output, err := executeTestCmd(t, dir, "-c", filepath.Join(dir, "rules.yaml"), dir)
+
+		assert.ErrorIs(t, err, ErrRulesFailed)
+		assert.Contains(t, output, "FAIL  11111111-1111-1111-1111-111111111111")
+		assert.Contains(t, output, "unexpected lines: [5]")
+	})
+
+	t.Run("should return error when custom rule is invalid", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{"rules.yaml": invalidCustomRules})
+
+		_, err := executeTestCmd(t, dir, "-c", dir)
+
+		assert.ErrorIs(t, err, ErrInvalidCustomRule)
+	})
+
+	t.Run("should test built-in rules when enabled", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, map[string]string{"main.go": `package main
+
+func main() {
+	// ruleid: 6d44fe57-24d2-44a0-ba0e-a1e688030c5d
+	_ = &tls.Config{InsecureSkipVerify: true}
+}
+`})
+
+		output, err := executeTestCmd(t, dir, "--builtin", dir)
+
+		assert.NoError(t, err)
+		assert.Contains(t, output, "PASS  6d44fe57-24d2-44a0-ba0e-a1e688030c5d")
+	})
+}
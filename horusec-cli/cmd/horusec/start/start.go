@@ -23,6 +23,7 @@ import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/requirements"
 
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	"github.com/ZupIT/horusec/horusec-cli/internal/usecases/cli"
 
@@ -72,11 +73,13 @@ func (s *Start) CreateStartCommand() *cobra.Command {
 	_ = startCmd.PersistentFlags().
 		Int64P("monitor-retry-count", "m", s.configs.GetMonitorRetryInSeconds(), "The number of retries for the monitor.")
 	_ = startCmd.PersistentFlags().
-		StringP("output-format", "o", s.configs.GetPrintOutputType(), "The format for the output to be shown. Options are: text (stdout), json, sonarqube")
+		Int64("max-concurrent-tools", s.configs.GetMaxConcurrentTools(), "The max number of tools (containers and engines) running at same time. Example --max-concurrent-tools=4")
+	_ = startCmd.PersistentFlags().
+		StringP("output-format", "o", s.configs.GetPrintOutputType(), "The format for the output to be shown. Options are: text (stdout), json, sonarqube, sarif, junit, html")
 	_ = startCmd.PersistentFlags().
 		StringSliceP("ignore-severity", "s", s.configs.GetSeveritiesToIgnore(), "The level of vulnerabilities to ignore in the output. Example: -s=\"LOW, MEDIUM, HIGH\"")
 	_ = startCmd.PersistentFlags().
-		StringP("json-output-file", "O", s.configs.GetJSONOutputFilePath(), "If your pass output-format you can configure the output JSON location. Example: -O=\"/tmp/output.json\"")
+		StringP("json-output-file", "O", s.configs.GetJSONOutputFilePath(), "If your pass output-format you can configure the output JSON location (or XML location for junit and HTML location for html). Example: -O=\"/tmp/output.json\"")
 	_ = startCmd.PersistentFlags().
 		StringSliceP("ignore", "i", s.configs.GetFilesOrPathsToIgnore(), "Paths to ignore in the analysis. Example: -i=\"/home/user/project/assets, /home/user/project/deployments\"")
 	_ = startCmd.PersistentFlags().
@@ -96,7 +99,7 @@ func (s *Start) CreateStartCommand() *cobra.Command {
 	_ = startCmd.PersistentFlags().
 		StringP("filter-path", "f", s.configs.GetFilterPath(), "Filter the path to run the analysis")
 	_ = startCmd.PersistentFlags().
-		Bool("enable-git-history", s.configs.GetEnableGitHistoryAnalysis(), "When this value is \"true\" we will run tool gitleaks and search vulnerability in all git history of the project. Example --enable-git-history=\"true\"")
+		Bool("enable-git-history", s.configs.GetEnableGitHistoryAnalysis(), "When this value is \"true\" we will search leaks in the lines added by every commit of the git history of the project. Example --enable-git-history=\"true\"")
 	_ = startCmd.PersistentFlags().
 		BoolP("insecure-skip-verify", "S", s.configs.GetCertInsecureSkipVerify(), "Insecure skip verify cert authority. PLEASE, try not to use it. Example -S=\"true\"")
 	_ = startCmd.PersistentFlags().
@@ -110,15 +113,27 @@ func (s *Start) CreateStartCommand() *cobra.Command {
 	_ = startCmd.PersistentFlags().
 		StringSliceP("risk-accept", "R", s.configs.GetRiskAcceptHashes(), "Used to ignore a vulnerability by hash and setting it to be of the risk accept type. Example -R=\"hash3, hash4\"")
 	_ = startCmd.PersistentFlags().
-		StringSliceP("tools-ignore", "T", s.configs.GetToolsToIgnore(), "Tools to ignore in the analysis. Available are: GoSec,SecurityCodeScan,Brakeman,Safety,Bandit,NpmAudit,YarnAudit,SpotBugs,HorusecKotlin,HorusecJava,HorusecLeaks,GitLeaks,TfSec,Semgrep,HorusecCsharp,HorusecDart,HorusecKubernetes,Eslint,HorusecNodeJS,Flawfinder,PhpCS,MixAudit,Sobelow,ShellCheck,BundlerAudit. Example: -T=\"GoSec, Brakeman\"")
+		StringSliceP("tools-ignore", "T", s.configs.GetToolsToIgnore(), "Tools to ignore in the analysis. Available are: GoSec,SecurityCodeScan,Brakeman,Safety,Bandit,NpmAudit,YarnAudit,SpotBugs,HorusecKotlin,HorusecJava,HorusecLeaks,GitLeaks,TfSec,Semgrep,HorusecCsharp,HorusecDart,HorusecKubernetes,Eslint,HorusecNodeJS,Flawfinder,PhpCS,MixAudit,Sobelow,ShellCheck,BundlerAudit,HorusecGo,HorusecPython,HorusecGitHistory,HorusecDockerfile,HorusecHCL,HorusecRust,HorusecCustom. Example: -T=\"GoSec, Brakeman\"")
 	_ = startCmd.PersistentFlags().
 		StringP("container-bind-project-path", "P", s.configs.GetContainerBindProjectPath(), "Used to pass project path in host when running horusec cli inside a container.")
 	_ = startCmd.PersistentFlags().
-		StringP("custom-rules-path", "c", s.configs.GetContainerBindProjectPath(), "Used to pass the path to the horusec custom rules file. Example: -c=\"./horusec/horusec-custom-rules.json\".")
+		StringP("custom-rules-path", "c", s.configs.GetCustomRulesPath(), "Used to pass the path to the horusec custom rules file, or to a folder of custom rules files in JSON or YAML. Example: -c=\"./horusec/horusec-custom-rules.json\".")
 	_ = startCmd.PersistentFlags().
-		BoolP("disable-docker", "D", s.configs.GetEnableCommitAuthor(), "Used to run horusec without docker if enabled it will only run the following tools: horusec-csharp, horusec-kotlin, horusec-kubernetes, horusec-leaks, horusec-nodejs, horusec-dart. Example: -D=\"true\"")
+		BoolP("disable-docker", "D", s.configs.GetEnableCommitAuthor(), "Used to run horusec without docker if enabled it will only run the following tools: horusec-csharp, horusec-kotlin, horusec-kubernetes, horusec-leaks, horusec-nodejs, horusec-dart, horusec-dockerfile, horusec-hcl, horusec-rust, horusec-custom. Example: -D=\"true\"")
 	_ = startCmd.PersistentFlags().
 		BoolP("information-severity", "I", s.configs.GetEnableInformationSeverity(), "Used to enable or disable information severity vulnerabilities, information vulnerabilities can contain a lot of false positives. Example: -I=\"true\"")
+	_ = startCmd.PersistentFlags().
+		String("baseline-file", s.configs.GetBaselineFilePath(), "Used to pass the path to a baseline file. Vulnerabilities found in the baseline are marked as pre existing and only new vulnerabilities will count to return error. Example: --baseline-file=\"./horusec-baseline.json\"")
+	_ = startCmd.PersistentFlags().
+		Bool("write-baseline", s.configs.GetWriteBaseline(), "Used to write all vulnerabilities found in this analysis in the file passed on baseline-file flag. Example: --write-baseline=\"true\"")
+	_ = startCmd.PersistentFlags().
+		String("diff-base", s.configs.GetDiffBase(), "Used to analyze only the files changed between a git reference and the working tree, the files not changed are not analyzed by any tool and only the vulnerabilities in lines changed are reported. Example: --diff-base=\"origin/main\"")
+	_ = startCmd.PersistentFlags().
+		String("git-history-range", s.configs.GetGitHistoryRange(), "Used with enable-git-history to analyze only the commits of a git revision range. Example: --git-history-range=\"v1.0.0..HEAD\"")
+	_ = startCmd.PersistentFlags().
+		Int64("git-history-depth", s.configs.GetGitHistoryDepth(), "Used with enable-git-history to limit the number of commits analyzed, 0 analyzes all commits. Example: --git-history-depth=100")
+	_ = startCmd.PersistentFlags().
+		String("rust-advisory-db-path", s.configs.GetRustAdvisoryDBPath(), "Used to pass the path to a local snapshot of the RustSec advisory database used to check the crates of Cargo.lock files, by default is used the database of cargo audit in \"$CARGO_HOME/advisory-db\" and when it not exists the Cargo.lock files are skipped with a warning. Example: --rust-advisory-db-path=\"./advisory-db\"")
 	return startCmd
 }
 
@@ -133,7 +148,7 @@ func (s *Start) runE(cmd *cobra.Command, _ []string) error {
 	s.setConfig(cmd)
 	totalVulns, err := s.startAnalysis(cmd)
 	if err != nil {
-		return err
+		return s.checkIfIsPolicyViolation(cmd, err)
 	}
 
 	if totalVulns > 0 && s.configs.GetReturnErrorIfFoundVulnerability() {
@@ -146,6 +161,17 @@ func (s *Start) runE(cmd *cobra.Command, _ []string) error {
 	return nil
 }
 
+func (s *Start) checkIfIsPolicyViolation(cmd *cobra.Command, err error) error {
+	var violation *policy.ViolationError
+	if errors.As(err, &violation) {
+		cmd.SetUsageFunc(func(command *cobra.Command) error {
+			return nil
+		})
+	}
+
+	return err
+}
+
 func (s *Start) startAnalysis(cmd *cobra.Command) (totalVulns int, err error) {
 	if err := s.askIfRunInDirectorySelected(s.isRunPromptQuestion(cmd)); err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorWhenAskDirToRun, err)
@@ -19,6 +19,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/env"
@@ -26,6 +27,8 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/valueordefault"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/images"
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
@@ -57,6 +60,7 @@ func (c *Config) NewConfigsFromCobraAndLoadsCmdGlobalFlags(cmd *cobra.Command) I
 //nolint
 func (c *Config) NewConfigsFromCobraAndLoadsCmdStartFlags(cmd *cobra.Command) IConfig {
 	c.SetMonitorRetryInSeconds(c.extractFlagValueInt64(cmd, "monitor-retry-count", c.GetMonitorRetryInSeconds()))
+	c.SetMaxConcurrentTools(c.extractFlagValueInt64(cmd, "max-concurrent-tools", c.GetMaxConcurrentTools()))
 	c.SetPrintOutputType(c.extractFlagValueString(cmd, "output-format", c.GetPrintOutputType()))
 	c.SetJSONOutputFilePath(c.extractFlagValueString(cmd, "json-output-file", c.GetJSONOutputFilePath()))
 	c.SetSeveritiesToIgnore(c.extractFlagValueStringSlice(cmd, "ignore-severity", c.GetSeveritiesToIgnore()))
@@ -81,6 +85,12 @@ func (c *Config) NewConfigsFromCobraAndLoadsCmdStartFlags(cmd *cobra.Command) IC
 	c.SetDisableDocker(c.extractFlagValueBool(cmd, "disable-docker", c.GetDisableDocker()))
 	c.SetCustomRulesPath(c.extractFlagValueString(cmd, "custom-rules-path", c.GetCustomRulesPath()))
 	c.SetEnableInformationSeverity(c.extractFlagValueBool(cmd, "information-severity", c.GetEnableInformationSeverity()))
+	c.SetBaselineFilePath(c.extractFlagValueString(cmd, "baseline-file", c.GetBaselineFilePath()))
+	c.SetWriteBaseline(c.extractFlagValueBool(cmd, "write-baseline", c.GetWriteBaseline()))
+	c.SetDiffBase(c.extractFlagValueString(cmd, "diff-base", c.GetDiffBase()))
+	c.SetGitHistoryRange(c.extractFlagValueString(cmd, "git-history-range", c.GetGitHistoryRange()))
+	c.SetGitHistoryDepth(c.extractFlagValueInt64(cmd, "git-history-depth", c.GetGitHistoryDepth()))
+	c.SetRustAdvisoryDBPath(c.extractFlagValueString(cmd, "rust-advisory-db-path", c.GetRustAdvisoryDBPath()))
 	return c
 }
 
@@ -93,6 +103,7 @@ func (c *Config) NewConfigsFromViper() IConfig {
 	c.SetTimeoutInSecondsRequest(viper.GetInt64(c.toLowerCamel(EnvTimeoutInSecondsRequest)))
 	c.SetTimeoutInSecondsAnalysis(viper.GetInt64(c.toLowerCamel(EnvTimeoutInSecondsAnalysis)))
 	c.SetMonitorRetryInSeconds(viper.GetInt64(c.toLowerCamel(EnvMonitorRetryInSeconds)))
+	c.SetMaxConcurrentTools(viper.GetInt64(c.toLowerCamel(EnvMaxConcurrentTools)))
 	c.SetRepositoryAuthorization(viper.GetString(c.toLowerCamel(EnvRepositoryAuthorization)))
 	c.SetPrintOutputType(viper.GetString(c.toLowerCamel(EnvPrintOutputType)))
 	c.SetJSONOutputFilePath(viper.GetString(c.toLowerCamel(EnvJSONOutputFilePath)))
@@ -117,6 +128,14 @@ func (c *Config) NewConfigsFromViper() IConfig {
 	c.SetCustomRulesPath(viper.GetString(c.toLowerCamel(EnvCustomRulesPath)))
 	c.SetEnableInformationSeverity(viper.GetBool(c.toLowerCamel(EnvEnableInformationSeverity)))
 	c.SetCustomImages(viper.Get(c.toLowerCamel(EnvCustomImages)))
+	c.SetBaselineFilePath(viper.GetString(c.toLowerCamel(EnvBaselineFilePath)))
+	c.SetWriteBaseline(viper.GetBool(c.toLowerCamel(EnvWriteBaseline)))
+	c.SetDiffBase(viper.GetString(c.toLowerCamel(EnvDiffBase)))
+	c.SetGitHistoryRange(viper.GetString(c.toLowerCamel(EnvGitHistoryRange)))
+	c.SetGitHistoryDepth(viper.GetInt64(c.toLowerCamel(EnvGitHistoryDepth)))
+	c.SetRustAdvisoryDBPath(viper.GetString(c.toLowerCamel(EnvRustAdvisoryDBPath)))
+	c.SetPolicy(viper.Get(c.toLowerCamel(EnvPolicy)))
+	c.SetExternalTools(viper.Get(c.toLowerCamel(EnvExternalTools)))
 	return c
 }
 
@@ -126,6 +145,7 @@ func (c *Config) NewConfigsFromEnvironments() IConfig {
 	c.SetTimeoutInSecondsRequest(env.GetEnvOrDefaultInt64(EnvTimeoutInSecondsRequest, c.timeoutInSecondsRequest))
 	c.SetTimeoutInSecondsAnalysis(env.GetEnvOrDefaultInt64(EnvTimeoutInSecondsAnalysis, c.timeoutInSecondsAnalysis))
 	c.SetMonitorRetryInSeconds(env.GetEnvOrDefaultInt64(EnvMonitorRetryInSeconds, c.monitorRetryInSeconds))
+	c.SetMaxConcurrentTools(env.GetEnvOrDefaultInt64(EnvMaxConcurrentTools, c.maxConcurrentTools))
 	c.SetRepositoryAuthorization(env.GetEnvOrDefault(EnvRepositoryAuthorization, c.repositoryAuthorization))
 	c.SetPrintOutputType(env.GetEnvOrDefault(EnvPrintOutputType, c.printOutputType))
 	c.SetJSONOutputFilePath(env.GetEnvOrDefault(EnvJSONOutputFilePath, c.jsonOutputFilePath))
@@ -147,6 +167,12 @@ func (c *Config) NewConfigsFromEnvironments() IConfig {
 	c.SetDisableDocker(env.GetEnvOrDefaultBool(EnvDisableDocker, c.disableDocker))
 	c.SetCustomRulesPath(env.GetEnvOrDefault(EnvCustomRulesPath, c.customRulesPath))
 	c.SetEnableInformationSeverity(env.GetEnvOrDefaultBool(EnvEnableInformationSeverity, c.enableInformationSeverity))
+	c.SetBaselineFilePath(env.GetEnvOrDefault(EnvBaselineFilePath, c.baselineFilePath))
+	c.SetWriteBaseline(env.GetEnvOrDefaultBool(EnvWriteBaseline, c.writeBaseline))
+	c.SetDiffBase(env.GetEnvOrDefault(EnvDiffBase, c.diffBase))
+	c.SetGitHistoryRange(env.GetEnvOrDefault(EnvGitHistoryRange, c.gitHistoryRange))
+	c.SetGitHistoryDepth(env.GetEnvOrDefaultInt64(EnvGitHistoryDepth, c.gitHistoryDepth))
+	c.SetRustAdvisoryDBPath(env.GetEnvOrDefault(EnvRustAdvisoryDBPath, c.rustAdvisoryDBPath))
 	return c
 }
 
@@ -210,6 +236,14 @@ func (c *Config) SetMonitorRetryInSeconds(retryInterval int64) {
 	c.monitorRetryInSeconds = retryInterval
 }
 
+func (c *Config) GetMaxConcurrentTools() int64 {
+	return valueordefault.GetInt64ValueOrDefault(c.maxConcurrentTools, int64(runtime.NumCPU()))
+}
+
+func (c *Config) SetMaxConcurrentTools(maxConcurrentTools int64) {
+	c.maxConcurrentTools = maxConcurrentTools
+}
+
 func (c *Config) GetRepositoryAuthorization() string {
 	return valueordefault.GetStringValueOrDefault(c.repositoryAuthorization, uuid.Nil.String())
 }
@@ -474,6 +508,7 @@ func (c *Config) toMap() map[string]interface{} {
 		"timeoutInSecondsRequest":         c.timeoutInSecondsRequest,
 		"timeoutInSecondsAnalysis":        c.timeoutInSecondsAnalysis,
 		"monitorRetryInSeconds":           c.monitorRetryInSeconds,
+		"maxConcurrentTools":              c.maxConcurrentTools,
 		"isTimeout":                       c.isTimeout,
 		"returnErrorIfFoundVulnerability": c.returnErrorIfFoundVulnerability,
 		"enableGitHistoryAnalysis":        c.enableGitHistoryAnalysis,
@@ -491,6 +526,14 @@ func (c *Config) toMap() map[string]interface{} {
 		"customRulesPath":                 c.customRulesPath,
 		"enableInformationSeverity":       c.enableInformationSeverity,
 		"customImages":                    c.customImages,
+		"baselineFilePath":                c.baselineFilePath,
+		"writeBaseline":                   c.writeBaseline,
+		"diffBase":                        c.diffBase,
+		"gitHistoryRange":                 c.gitHistoryRange,
+		"gitHistoryDepth":                 c.gitHistoryDepth,
+		"rustAdvisoryDBPath":              c.rustAdvisoryDBPath,
+		"policy":                          c.policy,
+		"externalTools":                   c.externalTools,
 	}
 }
 
@@ -511,6 +554,7 @@ func (c *Config) ToMapLowerCase() map[string]interface{} {
 		c.toLowerCamel(EnvTimeoutInSecondsRequest):         c.GetTimeoutInSecondsRequest(),
 		c.toLowerCamel(EnvTimeoutInSecondsAnalysis):        c.GetTimeoutInSecondsAnalysis(),
 		c.toLowerCamel(EnvMonitorRetryInSeconds):           c.GetMonitorRetryInSeconds(),
+		c.toLowerCamel(EnvMaxConcurrentTools):              c.GetMaxConcurrentTools(),
 		c.toLowerCamel(EnvRepositoryAuthorization):         c.GetRepositoryAuthorization(),
 		c.toLowerCamel(EnvPrintOutputType):                 c.GetPrintOutputType(),
 		c.toLowerCamel(EnvJSONOutputFilePath):              c.GetJSONOutputFilePath(),
@@ -535,6 +579,14 @@ func (c *Config) ToMapLowerCase() map[string]interface{} {
 		c.toLowerCamel(EnvCustomRulesPath):                 c.GetCustomRulesPath(),
 		c.toLowerCamel(EnvEnableInformationSeverity):       c.GetEnableInformationSeverity(),
 		c.toLowerCamel(EnvCustomImages):                    c.GetCustomImages(),
+		c.toLowerCamel(EnvBaselineFilePath):                c.GetBaselineFilePath(),
+		c.toLowerCamel(EnvWriteBaseline):                   c.GetWriteBaseline(),
+		c.toLowerCamel(EnvDiffBase):                        c.GetDiffBase(),
+		c.toLowerCamel(EnvGitHistoryRange):                 c.GetGitHistoryRange(),
+		c.toLowerCamel(EnvGitHistoryDepth):                 c.GetGitHistoryDepth(),
+		c.toLowerCamel(EnvRustAdvisoryDBPath):              c.GetRustAdvisoryDBPath(),
+		c.toLowerCamel(EnvPolicy):                          c.GetPolicy(),
+		c.toLowerCamel(EnvExternalTools):                   c.GetExternalTools(),
 	}
 }
 
@@ -543,6 +595,14 @@ func (c *Config) NormalizeConfigs() IConfig {
 		absJSONOutputFilePath, _ := filepath.Abs(c.GetJSONOutputFilePath())
 		c.SetJSONOutputFilePath(absJSONOutputFilePath)
 	}
+	if c.GetBaselineFilePath() != "" {
+		absBaselineFilePath, _ := filepath.Abs(c.GetBaselineFilePath())
+		c.SetBaselineFilePath(absBaselineFilePath)
+	}
+	if c.GetRustAdvisoryDBPath() != "" {
+		absRustAdvisoryDBPath, _ := filepath.Abs(c.GetRustAdvisoryDBPath())
+		c.SetRustAdvisoryDBPath(absRustAdvisoryDBPath)
+	}
 	projectPath, _ := filepath.Abs(c.GetProjectPath())
 	c.SetProjectPath(projectPath)
 	configFilePath, _ := filepath.Abs(c.GetConfigFilePath())
@@ -638,3 +698,97 @@ func (c *Config) SetCustomImages(configData interface{}) {
 
 	c.customImages = customImages
 }
+
+func (c *Config) GetBaselineFilePath() string {
+	return c.baselineFilePath
+}
+
+func (c *Config) SetBaselineFilePath(baselineFilePath string) {
+	c.baselineFilePath = baselineFilePath
+}
+
+func (c *Config) GetWriteBaseline() bool {
+	return c.writeBaseline
+}
+
+func (c *Config) SetWriteBaseline(writeBaseline bool) {
+	c.writeBaseline = writeBaseline
+}
+
+func (c *Config) GetDiffBase() string {
+	return c.diffBase
+}
+
+func (c *Config) SetDiffBase(diffBase string) {
+	c.diffBase = strings.TrimSpace(diffBase)
+}
+
+func (c *Config) GetGitHistoryRange() string {
+	return c.gitHistoryRange
+}
+
+func (c *Config) SetGitHistoryRange(gitHistoryRange string) {
+	c.gitHistoryRange = strings.TrimSpace(gitHistoryRange)
+}
+
+func (c *Config) GetGitHistoryDepth() int64 {
+	return c.gitHistoryDepth
+}
+
+func (c *Config) SetGitHistoryDepth(gitHistoryDepth int64) {
+	c.gitHistoryDepth = gitHistoryDepth
+}
+
+func (c *Config) GetRustAdvisoryDBPath() string {
+	return c.rustAdvisoryDBPath
+}
+
+func (c *Config) SetRustAdvisoryDBPath(rustAdvisoryDBPath string) {
+	c.rustAdvisoryDBPath = strings.TrimSpace(rustAdvisoryDBPath)
+}
+
+func (c *Config) GetPolicy() policy.Policy {
+	return c.policy
+}
+
+func (c *Config) SetPolicy(configData interface{}) {
+	configPolicy := policy.Policy{}
+	if configData == nil {
+		c.policy = configPolicy
+		return
+	}
+
+	bytes, err := json.Marshal(configData)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWhileParsingPolicy, err)
+	}
+
+	if err := json.Unmarshal(bytes, &configPolicy); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWhileParsingPolicy, err)
+	}
+
+	c.policy = configPolicy
+}
+
+func (c *Config) GetExternalTools() []externaltools.ExternalTool {
+	return c.externalTools
+}
+
+func (c *Config) SetExternalTools(configData interface{}) {
+	externalTools := []externaltools.ExternalTool{}
+	if configData == nil {
+		c.externalTools = externalTools
+		return
+	}
+
+	bytes, err := json.Marshal(configData)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWhileParsingExternalTools, err)
+	}
+
+	if err := json.Unmarshal(bytes, &externalTools); err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorWhileParsingExternalTools, err)
+	}
+
+	c.externalTools = externalTools
+}
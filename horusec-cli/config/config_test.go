@@ -17,6 +17,8 @@ package config
 import (
 	"os"
 	"path"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
@@ -449,6 +451,72 @@ func TestToLowerCamel(t *testing.T) {
 		assert.Equal(t, "horusecCliHeaders", configs.toLowerCamel(EnvHeaders))
 		assert.Equal(t, "horusecCliWorkDir", configs.toLowerCamel(EnvWorkDir))
 		assert.Equal(t, "horusecCliCustomImages", configs.toLowerCamel(EnvCustomImages))
+		assert.Equal(t, "horusecCliPolicy", configs.toLowerCamel(EnvPolicy))
+		assert.Equal(t, "horusecCliMaxConcurrentTools", configs.toLowerCamel(EnvMaxConcurrentTools))
+		assert.Equal(t, "horusecCliExternalTools", configs.toLowerCamel(EnvExternalTools))
+		assert.Equal(t, "horusecCliGitHistoryRange", configs.toLowerCamel(EnvGitHistoryRange))
+		assert.Equal(t, "horusecCliGitHistoryDepth", configs.toLowerCamel(EnvGitHistoryDepth))
+		assert.Equal(t, "horusecCliRustAdvisoryDbPath", configs.toLowerCamel(EnvRustAdvisoryDBPath))
+	})
+}
+
+func TestGetMaxConcurrentTools(t *testing.T) {
+	t.Run("Should return number of cpus when not set", func(t *testing.T) {
+		config := &Config{}
+		assert.Equal(t, int64(runtime.NumCPU()), config.GetMaxConcurrentTools())
+	})
+
+	t.Run("Should return value configured", func(t *testing.T) {
+		config := &Config{}
+		config.SetMaxConcurrentTools(2)
+		assert.Equal(t, int64(2), config.GetMaxConcurrentTools())
+	})
+}
+
+func TestSetExternalTools(t *testing.T) {
+	t.Run("Should parse external tools from config file data", func(t *testing.T) {
+		config := &Config{}
+		config.SetExternalTools([]interface{}{
+			map[string]interface{}{"name": "linter", "image": "company/linter", "cmd": "linter .",
+				"languages": []string{"Go"}, "outputFormat": "sarif"},
+		})
+
+		assert.Len(t, config.GetExternalTools(), 1)
+		assert.Equal(t, "linter", config.GetExternalTools()[0].Name)
+		assert.Equal(t, "sarif", config.GetExternalTools()[0].OutputFormat)
+	})
+
+	t.Run("Should set empty external tools when config data is nil or invalid", func(t *testing.T) {
+		config := &Config{}
+		config.SetExternalTools(nil)
+		assert.Empty(t, config.GetExternalTools())
+
+		config.SetExternalTools("invalid")
+		assert.Empty(t, config.GetExternalTools())
+	})
+}
+
+func TestSetPolicy(t *testing.T) {
+	t.Run("Should parse policy from config file data", func(t *testing.T) {
+		config := &Config{}
+		config.SetPolicy(map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{"name": "no-critical", "severity": "CRITICAL", "maxCount": 0, "exitCode": 10},
+			},
+		})
+
+		assert.Len(t, config.GetPolicy().Rules, 1)
+		assert.Equal(t, "no-critical", config.GetPolicy().Rules[0].Name)
+		assert.Equal(t, 10, config.GetPolicy().Rules[0].ExitCode)
+	})
+
+	t.Run("Should set empty policy when config data is nil or invalid", func(t *testing.T) {
+		config := &Config{}
+		config.SetPolicy(nil)
+		assert.Empty(t, config.GetPolicy().Rules)
+
+		config.SetPolicy("invalid")
+		assert.Empty(t, config.GetPolicy().Rules)
 	})
 }
 
@@ -474,3 +542,44 @@ func TestConfig_ToBytes(t *testing.T) {
 		assert.NotEmpty(t, config.ToBytes(true))
 	})
 }
+
+func TestGitHistoryRangeAndDepth(t *testing.T) {
+	t.Run("Should return empty range and no depth limit when not set", func(t *testing.T) {
+		config := &Config{}
+		assert.Empty(t, config.GetGitHistoryRange())
+		assert.Equal(t, int64(0), config.GetGitHistoryDepth())
+	})
+
+	t.Run("Should return range and depth configured by environment", func(t *testing.T) {
+		assert.NoError(t, os.Setenv(EnvGitHistoryRange, " v1.0.0..HEAD "))
+		assert.NoError(t, os.Setenv(EnvGitHistoryDepth, "50"))
+		defer func() {
+			_ = os.Unsetenv(EnvGitHistoryRange)
+			_ = os.Unsetenv(EnvGitHistoryDepth)
+		}()
+
+		config := &Config{}
+		config.NewConfigsFromEnvironments()
+		assert.Equal(t, "v1.0.0..HEAD", config.GetGitHistoryRange())
+		assert.Equal(t, int64(50), config.GetGitHistoryDepth())
+	})
+}
+
+func TestRustAdvisoryDBPath(t *testing.T) {
+	t.Run("Should return empty path when not set", func(t *testing.T) {
+		config := &Config{}
+		assert.Empty(t, config.GetRustAdvisoryDBPath())
+	})
+
+	t.Run("Should return absolute path configured by environment", func(t *testing.T) {
+		assert.NoError(t, os.Setenv(EnvRustAdvisoryDBPath, " ./advisory-db "))
+		defer func() {
+			_ = os.Unsetenv(EnvRustAdvisoryDBPath)
+		}()
+
+		config := &Config{}
+		config.NewConfigsFromEnvironments().NormalizeConfigs()
+		absPath, _ := filepath.Abs("./advisory-db")
+		assert.Equal(t, absPath, config.GetRustAdvisoryDBPath())
+	})
+}
@@ -17,6 +17,8 @@ package config
 
 import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/images"
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 )
@@ -40,18 +42,23 @@ const (
 	// By default is 15
 	// Validation: It is mandatory to be greater than 10
 	EnvMonitorRetryInSeconds = "HORUSEC_CLI_MONITOR_RETRY_IN_SECONDS"
+	// This setting will identify how many tools (containers and engines) can run at same time
+	// By default is the number of CPUs available
+	// Validation: It is mandatory to be greater than 0
+	EnvMaxConcurrentTools = "HORUSEC_CLI_MAX_CONCURRENT_TOOLS"
 	// This setting is to identify which repository you are analyzing from.
 	// This repository is created within the horusec webapp
 	// By default is 00000000-0000-0000-0000-000000000000
 	// Validation: If exist It is mandatory to be valid uuid
 	EnvRepositoryAuthorization = "HORUSEC_CLI_REPOSITORY_AUTHORIZATION"
-	// This setting is to know what type of output you want for the analysis (text, json, sonarqube)
+	// This setting is to know what type of output you want for the analysis (text, json, sonarqube, sarif, junit, html)
 	// By default is text
-	// Validation: It is mandatory to be in text, json, sonarqube
+	// Validation: It is mandatory to be in text, json, sonarqube, sarif, junit, html
 	EnvPrintOutputType = "HORUSEC_CLI_PRINT_OUTPUT_TYPE"
 	// This setting is to know in which directory you want the output of the json file
-	// generated by the output types json or sonarqube to be located.
-	// By default if the type is json or sonarqube o path is ./output.json
+	// generated by the output types json, sonarqube or sarif to be located.
+	// By default if the type is json, sonarqube or sarif o path is ./output.json
+	// When the output type is junit the file must be a .xml file and when is html must be a .html file
 	// Validation: It is mandatory to be valid path
 	EnvJSONOutputFilePath = "HORUSEC_CLI_JSON_OUTPUT_FILEPATH"
 	// This setting is to find out what types of severity I don't want you to recognize as a vulnerability.
@@ -119,8 +126,8 @@ const (
 	// By default is empty
 	// Validation: if exists is required valid path
 	EnvFilterPath = "HORUSEC_CLI_FILTER_PATH"
-	// This setting is to know if I want enable run gitleaks tools
-	// and analysis in all git history searching vulnerabilities
+	// This setting is to know if I want enable search leaks in the lines added by
+	// all commits of git history, it runs without docker
 	// By default is false
 	// Validation: It is mandatory to be in "false", "true"
 	EnvEnableGitHistoryAnalysis = "HORUSEC_CLI_ENABLE_GIT_HISTORY_ANALYSIS"
@@ -147,7 +154,8 @@ const (
 	EnvRiskAcceptHashes = "HORUSEC_CLI_RISK_ACCEPT_HASHES"
 	// DEPRECATED on 16 dec 2020
 	EnvToolsToIgnore = "HORUSEC_CLI_TOOLS_TO_IGNORE"
-	// Used to set configurations of tools
+	// Used to set configurations of tools, the tool is canceled when timeoutinseconds is greater than 0 and reached
+	// Example: {"gosec": {"istoignore": false, "timeoutinseconds": 300}}
 	// By default is setup:
 	// {
 	//
@@ -164,8 +172,9 @@ const (
 	// Validation: It is mandatory to be in "false", "true"
 	EnvDisableDocker = "HORUSEC_CLI_DISABLE_DOCKER"
 	// Used to pass the path to the horusec custom rules file. Example: -c="./horusec/horusec-custom-rules.json".
+	// It can also be a folder, then all JSON and YAML files inside of it are loaded as custom rules files
 	// By default is empty
-	// Validation: It is mandatory to be a valida path and contains file name
+	// Validation: It is mandatory to be a valida path
 	EnvCustomRulesPath = "HORUSEC_CLI_CUSTOM_RULES_PATH"
 	// Used to enable or disable information severity vulnerabilities, information vulnerabilities can contain a lot of false positives.
 	// By default is false
@@ -175,6 +184,42 @@ const (
 	// By default is empty
 	// Validation: Value should be a valid language of horusec
 	EnvCustomImages = "HORUSEC_CLI_CUSTOM_IMAGES"
+	// Used to pass the path to a baseline file with the vulnerabilities already known in the project.
+	// Vulnerabilities found in the baseline are marked as pre existing and will not count towards the return of exit (1)
+	// By default is empty
+	// Validation: It is mandatory to be a valid path when write baseline is disabled
+	EnvBaselineFilePath = "HORUSEC_CLI_BASELINE_FILE_PATH"
+	// Used to write the vulnerabilities found in the current analysis in the baseline file
+	// By default is false
+	// Validation: It is mandatory to be in "false", "true" and required baseline file path when is "true"
+	EnvWriteBaseline = "HORUSEC_CLI_WRITE_BASELINE"
+	// Used to pass a git reference to analyze only the files and lines changed between the reference and the working tree
+	// By default is empty and all files of the project will be analyzed
+	// Validation: It is mandatory to be a valid git reference and the project path a git repository
+	EnvDiffBase = "HORUSEC_CLI_DIFF_BASE"
+	// Used to pass a git revision range to limit the commits analyzed when git history analysis is enabled
+	// Example: "v1.0.0..HEAD" to analyze only the commits since the last release
+	// By default is empty and all commits reachable from HEAD will be analyzed
+	// Validation: It is mandatory to be a valid git revision range
+	EnvGitHistoryRange = "HORUSEC_CLI_GIT_HISTORY_RANGE"
+	// Used to limit the number of commits analyzed when git history analysis is enabled
+	// By default is 0 and there is no limit
+	// Validation: It is mandatory to be greater or equal 0
+	EnvGitHistoryDepth = "HORUSEC_CLI_GIT_HISTORY_DEPTH"
+	// Used to pass the path to a local snapshot of the RustSec advisory database (https://github.com/rustsec/advisory-db)
+	// used to check the crates locked in Cargo.lock files without network access
+	// By default is empty and the database cloned by cargo audit in "$CARGO_HOME/advisory-db" will be used
+	// Validation: It is mandatory to be a valid path of a folder
+	EnvRustAdvisoryDBPath = "HORUSEC_CLI_RUST_ADVISORY_DB_PATH"
+	// Used to pass rules to decide if the analysis fails and which exit code is returned when a rule is violated
+	// By default is empty and only return-error is used to decide if the analysis fails
+	// Validation: All rules should have name, valid severity, max count greater or equal 0 and exit code between 0 and 255
+	EnvPolicy = "HORUSEC_CLI_POLICY"
+	// Used to declare tools not supported by horusec that run in container when one of its languages is detected
+	// The output of tool is parsed as SARIF or by JSONPath mapping into horusec vulnerabilities
+	// By default is empty
+	// Validation: All tools should have name, image, cmd, valid languages and output format "sarif" or "jsonpath"
+	EnvExternalTools = "HORUSEC_CLI_EXTERNAL_TOOLS"
 )
 
 type Config struct {
@@ -192,10 +237,16 @@ type Config struct {
 	jsonOutputFilePath              string
 	projectPath                     string
 	customRulesPath                 string
+	baselineFilePath                string
+	diffBase                        string
+	gitHistoryRange                 string
+	rustAdvisoryDBPath              string
 	containerBindProjectPath        string
 	timeoutInSecondsRequest         int64
 	timeoutInSecondsAnalysis        int64
 	monitorRetryInSeconds           int64
+	maxConcurrentTools              int64
+	gitHistoryDepth                 int64
 	isTimeout                       bool
 	returnErrorIfFoundVulnerability bool
 	enableGitHistoryAnalysis        bool
@@ -203,6 +254,7 @@ type Config struct {
 	enableCommitAuthor              bool
 	disableDocker                   bool
 	enableInformationSeverity       bool
+	writeBaseline                   bool
 	severitiesToIgnore              []string
 	filesOrPathsToIgnore            []string
 	falsePositiveHashes             []string
@@ -212,4 +264,6 @@ type Config struct {
 	headers                         map[string]string
 	workDir                         *workdir.WorkDir
 	customImages                    images.Custom
+	policy                          policy.Policy
+	externalTools                   []externaltools.ExternalTool
 }
@@ -1,7 +1,9 @@
 package config
 
 import (
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/images"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/spf13/cobra"
@@ -33,6 +35,8 @@ type IConfig interface {
 
 	GetMonitorRetryInSeconds() int64
 	SetMonitorRetryInSeconds(retryInterval int64)
+	GetMaxConcurrentTools() int64
+	SetMaxConcurrentTools(maxConcurrentTools int64)
 
 	GetRepositoryAuthorization() string
 	SetRepositoryAuthorization(repositoryAuthorization string)
@@ -113,4 +117,27 @@ type IConfig interface {
 
 	GetCustomImages() images.Custom
 	SetCustomImages(configData interface{})
+
+	GetBaselineFilePath() string
+	SetBaselineFilePath(baselineFilePath string)
+
+	GetWriteBaseline() bool
+	SetWriteBaseline(writeBaseline bool)
+
+	GetDiffBase() string
+	SetDiffBase(diffBase string)
+
+	GetGitHistoryRange() string
+	SetGitHistoryRange(gitHistoryRange string)
+
+	GetGitHistoryDepth() int64
+	SetGitHistoryDepth(gitHistoryDepth int64)
+
+	GetRustAdvisoryDBPath() string
+	SetRustAdvisoryDBPath(rustAdvisoryDBPath string)
+
+	GetPolicy() policy.Policy
+	SetPolicy(configData interface{})
+	GetExternalTools() []externaltools.ExternalTool
+	SetExternalTools(configData interface{})
 }
@@ -15,6 +15,7 @@
 package analyser
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -23,42 +24,56 @@ import (
 	"time"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	analysisUseCases "github.com/ZupIT/horusec/development-kit/pkg/usecases/analysis"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	languageDetect "github.com/ZupIT/horusec/horusec-cli/internal/controllers/language_detect"
 	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/printresults"
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
 	"github.com/ZupIT/horusec/horusec-cli/internal/enums/images"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/baseline"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/c/flawfinder"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/csharp/horuseccsharp"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/csharp/scs"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/custom/horuseccustom"
 	horusecDart "github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/dart/horusecdart"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/dockerfile/horusecdockerfile"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/elixir/mixaudit"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/elixir/sobelow"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/generic/external"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/generic/semgrep"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/go/gosec"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/go/horusecgo"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/hcl"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/hcl/horusechcl"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/java/horusecjava"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/horusecnodejs"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/npmaudit"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/javascript/yarnaudit"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/kotlin/horuseckotlin"
-	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/leaks/gitleaks"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/leaks/horusecgithistory"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/leaks/horusecleaks"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/php/phpcs"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/bandit"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/horusecpython"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/python/safety"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/ruby/brakeman"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/ruby/bundler"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/rust/horusecrust"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/shell/shellcheck"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/yaml/horuseckubernetes"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/git"
 	horusecAPI "github.com/ZupIT/horusec/horusec-cli/internal/services/horusapi"
+	"github.com/ZupIT/horusec/horusec-cli/internal/utils/workerpool"
 	"github.com/google/uuid"
 )
 
@@ -67,7 +82,7 @@ type Interface interface {
 }
 
 type Analyser struct {
-	monitor           *horusec.Monitor
+	workerPool        workerpool.Interface
 	dockerSDK         docker.Interface
 	analysis          *horusec.Analysis
 	config            cliConfig.IConfig
@@ -76,6 +91,7 @@ type Analyser struct {
 	printController   printresults.Interface
 	horusecAPIService horusecAPI.IService
 	formatterService  formatters.IService
+	baselineService   baseline.IService
 }
 
 func NewAnalyser(config cliConfig.IConfig) Interface {
@@ -90,7 +106,8 @@ func NewAnalyser(config cliConfig.IConfig) Interface {
 		analysisUseCases:  useCases,
 		printController:   printresults.NewPrintResults(analysis, config),
 		horusecAPIService: horusecAPI.NewHorusecAPIService(config),
-		formatterService:  formatters.NewFormatterService(analysis, dockerAPI, config, nil),
+		formatterService:  formatters.NewFormatterService(analysis, dockerAPI, config),
+		baselineService:   baseline.NewBaselineService(config),
 	}
 }
 
@@ -121,28 +138,70 @@ func (a *Analyser) removeHorusecFolder() {
 }
 
 func (a *Analyser) runAnalysis() (totalVulns int, err error) {
+	if err := a.setDiff(); err != nil {
+		return 0, err
+	}
+
 	langs, err := a.languageDetect.LanguageDetect(a.config.GetProjectPath())
 	if err != nil {
 		return 0, err
 	}
 
-	a.setMonitor(horusec.NewMonitor())
 	a.startDetectVulnerabilities(langs)
 	return a.sendAnalysisAndStartPrintResults()
 }
 
+func (a *Analyser) setDiff() error {
+	if a.config.GetDiffBase() == "" {
+		return nil
+	}
+
+	logger.LogInfoWithLevel(messages.MsgInfoStartDiffAnalysis, a.config.GetDiffBase())
+	changes, err := git.NewGitService(a.config).GetDiff(a.config.GetDiffBase())
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGitDiffExecute, err)
+		return err
+	}
+
+	a.languageDetect.SetDiff(changes)
+	a.formatterService.SetDiff(changes)
+	return nil
+}
+
 func (a *Analyser) sendAnalysisAndStartPrintResults() (int, error) {
 	a.formatAnalysisToPrintAndSendToAPI()
 	a.horusecAPIService.SendAnalysis(a.analysis)
 	analysisSaved := a.horusecAPIService.GetAnalysis(a.analysis.ID)
 	if analysisSaved != nil && analysisSaved.ID != uuid.Nil {
-		a.analysis = analysisSaved
+		a.analysis = a.keepInlineSuppressions(analysisSaved)
 	}
 	a.setFalsePositive()
+	a.setBaseline()
 	a.printController.SetAnalysis(a.analysis)
 	return a.printController.StartPrintResults()
 }
 
+// keepInlineSuppressions set again the suppressions found in source code because the reason is not saved in api
+func (a *Analyser) keepInlineSuppressions(analysisSaved *horusec.Analysis) *horusec.Analysis {
+	reasons := map[string]string{}
+	for index := range a.analysis.AnalysisVulnerabilities {
+		vulnerability := a.analysis.AnalysisVulnerabilities[index].Vulnerability
+		if vulnerability.SuppressionReason != "" {
+			reasons[vulnerability.VulnHash] = vulnerability.SuppressionReason
+		}
+	}
+
+	for index := range analysisSaved.AnalysisVulnerabilities {
+		vulnerability := &analysisSaved.AnalysisVulnerabilities[index].Vulnerability
+		if reason, ok := reasons[vulnerability.VulnHash]; ok {
+			vulnerability.Type = enumHorusec.FalsePositive
+			vulnerability.SuppressionReason = reason
+		}
+	}
+
+	return analysisSaved
+}
+
 func (a *Analyser) formatAnalysisToPrintAndSendToAPI() {
 	a.analysis = a.analysis.
 		SetAnalysisFinishedData().
@@ -150,39 +209,119 @@ func (a *Analyser) formatAnalysisToPrintAndSendToAPI() {
 		SortVulnerabilitiesByCriticality().
 		SetDefaultVulnerabilityType().
 		SortVulnerabilitiesByType()
+	a.analysis = vulnhash.BindStable(a.analysis)
 	if !a.config.GetEnableInformationSeverity() {
 		a.analysis = a.analysis.RemoveInfoVulnerabilities()
 	}
 }
 
-func (a *Analyser) setMonitor(monitor *horusec.Monitor) {
-	a.monitor = monitor
-	a.formatterService.SetMonitor(monitor)
-}
-
 func (a *Analyser) startDetectVulnerabilities(langs []languages.Language) {
+	ctx, cancel := context.WithTimeout(context.Background(),
+		time.Duration(a.config.GetTimeoutInSecondsAnalysis())*time.Second)
+	defer cancel()
+
+	a.workerPool = workerpool.NewWorkerPool(ctx, int(a.config.GetMaxConcurrentTools()))
 	for _, language := range langs {
 		for _, projectSubPath := range a.config.GetWorkDir().GetArrayByLanguage(language) {
 			a.logProjectSubPath(language, projectSubPath)
-			langFunc := a.mapDetectVulnerabilityByLanguage()[language]
-			go langFunc(projectSubPath)
+			a.submitDetectVulnerabilities(language, projectSubPath)
 		}
 	}
 
-	a.runMonitorTimeout(a.config.GetTimeoutInSecondsAnalysis())
+	a.submitDetectVulnerabilitiesByExternalTools(langs)
+	a.submitDetectVulnerabilitiesByCustomRules()
+	a.waitAnalysisFinish(ctx)
 }
 
-func (a *Analyser) runMonitorTimeout(monitor int64) {
-	if monitor <= 0 {
-		a.dockerSDK.DeleteContainersFromAPI()
-		a.config.SetIsTimeout(true)
+// submitDetectVulnerabilities pull the images inside of the pool, and the tools are submitted after it
+func (a *Analyser) submitDetectVulnerabilities(language languages.Language, projectSubPath string) {
+	langFunc := a.mapDetectVulnerabilityByLanguage()[language]
+	a.workerPool.Submit(0, func(_ context.Context) {
+		langFunc(projectSubPath)
+	}, nil)
+}
+
+func (a *Analyser) submitDetectVulnerabilitiesByExternalTools(langs []languages.Language) {
+	for _, externalTool := range a.config.GetExternalTools() {
+		language, isDetected := externalTool.GetLanguage(langs)
+		if !isDetected {
+			continue
+		}
+
+		for _, projectSubPath := range a.config.GetWorkDir().GetArrayByLanguage(language) {
+			a.submitDetectVulnerabilityExternalTool(externalTool, language, projectSubPath)
+		}
+	}
+}
+
+func (a *Analyser) submitDetectVulnerabilityExternalTool(externalTool externaltools.ExternalTool,
+	language languages.Language, projectSubPath string) {
+	a.workerPool.Submit(0, func(_ context.Context) {
+		a.detectVulnerabilityExternalTool(externalTool, language, projectSubPath)
+	}, nil)
+}
+
+// submitDetectVulnerabilitiesByCustomRules runs once in the whole project the custom rules without a native engine,
+// because their files are selected by globs and not by the languages detected
+func (a *Analyser) submitDetectVulnerabilitiesByCustomRules() {
+	if len(a.formatterService.GetCustomRulesByTool(tools.HorusecCustom)) == 0 {
+		return
+	}
+
+	a.runFormatter(tools.HorusecCustom, horuseccustom.NewFormatter, "")
+}
+
+func (a *Analyser) runFormatter(tool tools.Tool, newFormatter func(formatters.IService) formatters.IFormatter,
+	projectSubPath string) {
+	timeout := time.Duration(a.config.GetToolsConfig()[tool].TimeoutInSeconds) * time.Second
+	a.workerPool.Submit(timeout, func(ctx context.Context) {
+		newFormatter(a.formatterService.WithContext(ctx)).StartAnalysis(projectSubPath)
+	}, func(err error) {
+		a.formatterService.SetAnalysisError(
+			fmt.Errorf("%s%s: %w", messages.MsgErrorToolTimeout, tool.ToString(), err), tool, projectSubPath)
+	})
+}
+
+func (a *Analyser) waitAnalysisFinish(ctx context.Context) {
+	finished := make(chan error, 1)
+	go func() {
+		finished <- a.workerPool.Wait()
+	}()
+
+	ticker := time.NewTicker(a.getMonitorRetryInterval())
+	defer ticker.Stop()
+
+	a.logTimeoutIn(ctx)
+	for {
+		select {
+		case err := <-finished:
+			a.setTimeoutIfAnalysisCanceled(err)
+			return
+		case <-ticker.C:
+			a.logTimeoutIn(ctx)
+		}
 	}
+}
 
-	if !a.monitor.IsFinished() && !a.config.GetIsTimeout() {
+func (a *Analyser) getMonitorRetryInterval() time.Duration {
+	if a.config.GetMonitorRetryInSeconds() <= 0 {
+		return time.Second
+	}
+
+	return time.Duration(a.config.GetMonitorRetryInSeconds()) * time.Second
+}
+
+func (a *Analyser) logTimeoutIn(ctx context.Context) {
+	if deadline, ok := ctx.Deadline(); ok {
 		logger.LogInfoWithLevel(
-			fmt.Sprintf(messages.MsgInfoMonitorTimeoutIn + strconv.Itoa(int(monitor)) + "s"))
-		time.Sleep(time.Duration(a.config.GetMonitorRetryInSeconds()) * time.Second)
-		a.runMonitorTimeout(monitor - a.config.GetMonitorRetryInSeconds())
+			messages.MsgInfoMonitorTimeoutIn + strconv.Itoa(int(time.Until(deadline).Seconds())) + "s")
+	}
+}
+
+func (a *Analyser) setTimeoutIfAnalysisCanceled(err error) {
+	if err != nil {
+		a.dockerSDK.DeleteContainersFromAPI()
+		a.config.SetIsTimeout(true)
 	}
 }
 
@@ -205,175 +344,170 @@ func (a *Analyser) mapDetectVulnerabilityByLanguage() map[languages.Language]fun
 		languages.Dart:       a.detectVulnerabilityDart,
 		languages.Elixir:     a.detectVulnerabilityElixir,
 		languages.Shell:      a.detectVulnerabilityShell,
+		languages.Dockerfile: a.detectVulnerabilityDockerfile,
+		languages.Rust:       a.detectVulnerabilityRust,
 	}
 }
 
 func (a *Analyser) detectVulnerabilityCsharp(projectSubPath string) {
-	a.monitor.AddProcess(2)
-	go horuseccsharp.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.HorusecCsharp, horuseccsharp.NewFormatter, projectSubPath)
 
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.CSharp)); err != nil {
-		a.setErrorAndRemoveProcess(err, 1)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go scs.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.SecurityCodeScan, scs.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityLeaks(projectSubPath string) {
-	a.monitor.AddProcess(2)
-	go horusecleaks.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
-	a.executeGitLeaks(projectSubPath)
+	a.runFormatter(tools.HorusecLeaks, horusecleaks.NewFormatter, projectSubPath)
+	a.executeGitHistory(projectSubPath)
 }
 
-func (a *Analyser) executeGitLeaks(projectSubPath string) {
+func (a *Analyser) executeGitHistory(projectSubPath string) {
 	if a.config.GetEnableGitHistoryAnalysis() {
 		logger.LogWarnWithLevel(messages.MsgWarnGitHistoryEnable)
-
-		if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Leaks)); err != nil {
-			a.setErrorAndRemoveProcess(err, 1)
-			return
-		}
-
-		go gitleaks.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
-	} else {
-		a.monitor.RemoveProcess(1)
+		a.runFormatter(tools.HorusecGitHistory, horusecgithistory.NewFormatter, projectSubPath)
 	}
 }
 
 func (a *Analyser) detectVulnerabilityGo(projectSubPath string) {
-	a.monitor.AddProcess(1)
+	a.runFormatter(tools.HorusecGo, horusecgo.NewFormatter, projectSubPath)
 
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Go)); err != nil {
-		a.setErrorAndRemoveProcess(err, 1)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go gosec.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.GoSec, gosec.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityJava(projectSubPath string) {
-	a.monitor.AddProcess(1)
-	go horusecjava.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.HorusecJava, horusecjava.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityKotlin(projectSubPath string) {
-	a.monitor.AddProcess(1)
-	go horuseckotlin.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.HorusecKotlin, horuseckotlin.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityJavascript(projectSubPath string) {
-	a.monitor.AddProcess(3)
-	go horusecnodejs.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.HorusecNodejs, horusecnodejs.NewFormatter, projectSubPath)
 
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Javascript)); err != nil {
-		a.setErrorAndRemoveProcess(err, 2)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go yarnaudit.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
-	go npmaudit.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.YarnAudit, yarnaudit.NewFormatter, projectSubPath)
+	a.runFormatter(tools.NpmAudit, npmaudit.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityPython(projectSubPath string) {
-	a.monitor.AddProcess(2)
+	a.runFormatter(tools.HorusecPython, horusecpython.NewFormatter, projectSubPath)
 
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Python)); err != nil {
-		a.setErrorAndRemoveProcess(err, 2)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go bandit.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
-	go safety.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.Bandit, bandit.NewFormatter, projectSubPath)
+	a.runFormatter(tools.Safety, safety.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityRuby(projectSubPath string) {
-	a.monitor.AddProcess(2)
-
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Ruby)); err != nil {
-		a.setErrorAndRemoveProcess(err, 2)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go brakeman.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
-	go bundler.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.Brakeman, brakeman.NewFormatter, projectSubPath)
+	a.runFormatter(tools.BundlerAudit, bundler.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityHCL(projectSubPath string) {
-	a.monitor.AddProcess(1)
+	a.runFormatter(tools.HorusecHCL, horusechcl.NewFormatter, projectSubPath)
 
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.HCL)); err != nil {
-		a.setErrorAndRemoveProcess(err, 1)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go hcl.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.TfSec, hcl.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityYaml(projectSubPath string) {
-	a.monitor.AddProcess(1)
-	go horuseckubernetes.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.HorusecKubernetes, horuseckubernetes.NewFormatter, projectSubPath)
 }
 
-func (a *Analyser) detectVulnerabilityC(projectSubPath string) {
-	a.monitor.AddProcess(1)
+func (a *Analyser) detectVulnerabilityDockerfile(projectSubPath string) {
+	a.runFormatter(tools.HorusecDockerfile, horusecdockerfile.NewFormatter, projectSubPath)
+}
 
+func (a *Analyser) detectVulnerabilityRust(projectSubPath string) {
+	a.runFormatter(tools.HorusecRust, horusecrust.NewFormatter, projectSubPath)
+}
+
+func (a *Analyser) detectVulnerabilityC(projectSubPath string) {
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.C)); err != nil {
-		a.setErrorAndRemoveProcess(err, 1)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go flawfinder.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.Flawfinder, flawfinder.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityPHP(projectSubPath string) {
-	a.monitor.AddProcess(1)
-
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.PHP)); err != nil {
-		a.setErrorAndRemoveProcess(err, 1)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go phpcs.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.PhpCS, phpcs.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityGeneric(projectSubPath string) {
-	a.monitor.AddProcess(1)
-
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Generic)); err != nil {
-		a.setErrorAndRemoveProcess(err, 1)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go semgrep.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.Semgrep, semgrep.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityDart(projectSubPath string) {
-	a.monitor.AddProcess(1)
-	go horusecDart.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.HorusecDart, horusecDart.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityElixir(projectSubPath string) {
-	a.monitor.AddProcess(2)
-
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Elixir)); err != nil {
-		a.setErrorAndRemoveProcess(err, 2)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go mixaudit.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
-	go sobelow.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.MixAudit, mixaudit.NewFormatter, projectSubPath)
+	a.runFormatter(tools.Sobelow, sobelow.NewFormatter, projectSubPath)
 }
 
 func (a *Analyser) detectVulnerabilityShell(projectSubPath string) {
-	a.monitor.AddProcess(1)
-
 	if err := a.dockerSDK.PullImage(a.getCustomOrDefaultImage(languages.Shell)); err != nil {
-		a.setErrorAndRemoveProcess(err, 1)
+		a.setAnalysisError(err)
 		return
 	}
 
-	go shellcheck.NewFormatter(a.formatterService).StartAnalysis(projectSubPath)
+	a.runFormatter(tools.ShellCheck, shellcheck.NewFormatter, projectSubPath)
+}
+
+func (a *Analyser) detectVulnerabilityExternalTool(externalTool externaltools.ExternalTool,
+	language languages.Language, projectSubPath string) {
+	if err := a.dockerSDK.PullImage(externalTool.Image); err != nil {
+		a.setAnalysisError(err)
+		return
+	}
+
+	a.runFormatter(externalTool.GetTool(), func(service formatters.IService) formatters.IFormatter {
+		return external.NewFormatter(service, externalTool, language)
+	}, projectSubPath)
 }
 
 func (a *Analyser) logProjectSubPath(language languages.Language, subPath string) {
@@ -387,7 +521,7 @@ func (a *Analyser) checkIfNoExistHashAndLog(list []string) {
 	for _, hash := range list {
 		existing := false
 		for keyAv := range a.analysis.AnalysisVulnerabilities {
-			if hash == a.analysis.AnalysisVulnerabilities[keyAv].Vulnerability.VulnHash {
+			if a.analysis.AnalysisVulnerabilities[keyAv].Vulnerability.HasHash(hash) {
 				existing = true
 				break
 			}
@@ -407,9 +541,24 @@ func (a *Analyser) setFalsePositive() {
 	a.checkIfNoExistHashAndLog(a.config.GetRiskAcceptHashes())
 }
 
-func (a *Analyser) setErrorAndRemoveProcess(err error, processNumber int) {
+func (a *Analyser) setBaseline() {
+	if a.config.GetWriteBaseline() {
+		if err := a.baselineService.WriteBaseline(a.analysis); err != nil {
+			logger.LogErrorWithLevel(messages.MsgErrorWriteBaselineFile, err)
+		}
+	}
+
+	baselineHashes, err := a.baselineService.GetBaselineHashes()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadBaselineFile, err)
+		return
+	}
+
+	a.analysis = a.analysis.SetPreExistingInVulnerabilities(baselineHashes)
+}
+
+func (a *Analyser) setAnalysisError(err error) {
 	a.analysis.SetAnalysisError(err)
-	a.monitor.RemoveProcess(processNumber)
 }
 
 func (a *Analyser) getCustomOrDefaultImage(language languages.Language) string {
@@ -16,8 +16,11 @@ package analyser
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/test"
@@ -26,14 +29,20 @@ import (
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	analysisUseCases "github.com/ZupIT/horusec/development-kit/pkg/usecases/analysis"
 	"github.com/ZupIT/horusec/horusec-cli/config"
 	languageDetect "github.com/ZupIT/horusec/horusec-cli/internal/controllers/language_detect"
 	"github.com/ZupIT/horusec/horusec-cli/internal/controllers/printresults"
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/baseline"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	dockerClient "github.com/ZupIT/horusec/horusec-cli/internal/services/docker/client"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
 	horusecAPI "github.com/ZupIT/horusec/horusec-cli/internal/services/horusapi"
+	"github.com/ZupIT/horusec/horusec-cli/internal/utils/workerpool"
 	"github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
 	"github.com/google/uuid"
@@ -99,7 +108,8 @@ func TestAnalyser_AnalysisDirectory(t *testing.T) {
 			analysisUseCases:  analysisUseCases.NewAnalysisUseCases(),
 			printController:   printResultMock,
 			horusecAPIService: horusecAPIMock,
-			formatterService:  formatters.NewFormatterService(&horusec.Analysis{}, dockerSDK, configs, &horusec.Monitor{}),
+			formatterService:  formatters.NewFormatterService(&horusec.Analysis{}, dockerSDK, configs),
+			baselineService:   baseline.NewBaselineService(configs),
 		}
 
 		controller.analysis = controller.analysisUseCases.NewAnalysisRunning()
@@ -157,7 +167,8 @@ func TestAnalyser_AnalysisDirectory(t *testing.T) {
 			analysisUseCases:  analysisUseCases.NewAnalysisUseCases(),
 			printController:   printResultMock,
 			horusecAPIService: horusecAPIMock,
-			formatterService:  formatters.NewFormatterService(&horusec.Analysis{}, dockerSDK, configs, &horusec.Monitor{}),
+			formatterService:  formatters.NewFormatterService(&horusec.Analysis{}, dockerSDK, configs),
+			baselineService:   baseline.NewBaselineService(configs),
 		}
 
 		controller.analysis = controller.analysisUseCases.NewAnalysisRunning()
@@ -200,7 +211,8 @@ func TestAnalyser_AnalysisDirectory(t *testing.T) {
 			analysisUseCases:  analysisUseCases.NewAnalysisUseCases(),
 			printController:   printResultMock,
 			horusecAPIService: horusecAPIMock,
-			formatterService:  formatters.NewFormatterService(&horusec.Analysis{}, dockerSDK, configs, &horusec.Monitor{}),
+			formatterService:  formatters.NewFormatterService(&horusec.Analysis{}, dockerSDK, configs),
+			baselineService:   baseline.NewBaselineService(configs),
 		}
 
 		controller.analysis = controller.analysisUseCases.NewAnalysisRunning()
@@ -209,3 +221,112 @@ func TestAnalyser_AnalysisDirectory(t *testing.T) {
 		assert.Equal(t, 0, totalVulns)
 	})
 }
+
+type formatterBlockedMock struct {
+	finish chan struct{}
+}
+
+func (f *formatterBlockedMock) StartAnalysis(_ string) {
+	<-f.finish
+}
+
+func TestAnalyser_runFormatter(t *testing.T) {
+	t.Run("Should set analysis error when tool reach the timeout of tools config", func(t *testing.T) {
+		configs := config.NewConfig()
+		configs.SetToolsConfig(toolsconfig.MapToolConfig{tools.GoSec: {TimeoutInSeconds: 1}})
+
+		analysis := &horusec.Analysis{}
+		formatter := &formatterBlockedMock{finish: make(chan struct{})}
+		defer close(formatter.finish)
+
+		controller := &Analyser{
+			config:           configs,
+			analysis:         analysis,
+			formatterService: formatters.NewFormatterService(analysis, &docker.Mock{}, configs),
+			workerPool:       workerpool.NewWorkerPool(context.Background(), 1),
+		}
+
+		controller.runFormatter(tools.GoSec, func(_ formatters.IService) formatters.IFormatter {
+			return formatter
+		}, "")
+
+		assert.NoError(t, controller.workerPool.Wait())
+		assert.Contains(t, analysis.Errors, messages.MsgErrorToolTimeout+tools.GoSec.ToString())
+	})
+}
+
+func TestAnalyser_submitDetectVulnerabilitiesByExternalTools(t *testing.T) {
+	t.Run("Should run external tool only when one of its languages was detected", func(t *testing.T) {
+		configs := config.NewConfig()
+		configs.SetExternalTools([]externaltools.ExternalTool{
+			{Name: "InternalLinter", Image: "company/linter", CMD: "linter", OutputFormat: externaltools.OutputFormatSarif,
+				Languages: []languages.Language{languages.Go}},
+			{Name: "InternalChecker", Image: "company/checker", CMD: "checker", OutputFormat: externaltools.OutputFormatSarif,
+				Languages: []languages.Language{languages.Python}},
+		})
+
+		dockerMock := &docker.Mock{}
+		dockerMock.On("PullImage").Return(nil)
+		dockerMock.On("CreateLanguageAnalysisContainer").Return(`{"runs": [{"results": [{"ruleId": "L001",
+			"level": "error", "message": {"text": "test"}, "locations": [{"physicalLocation":
+			{"artifactLocation": {"uri": "main.go"}, "region": {"startLine": 1}}}]}]}]}`, nil)
+
+		analysis := &horusec.Analysis{}
+		controller := &Analyser{
+			config:           configs,
+			analysis:         analysis,
+			dockerSDK:        dockerMock,
+			formatterService: formatters.NewFormatterService(analysis, dockerMock, configs),
+			workerPool:       workerpool.NewWorkerPool(context.Background(), 2),
+		}
+
+		controller.submitDetectVulnerabilitiesByExternalTools([]languages.Language{languages.Go})
+
+		assert.NoError(t, controller.workerPool.Wait())
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Equal(t, "InternalLinter", analysis.AnalysisVulnerabilities[0].Vulnerability.SecurityTool.ToString())
+	})
+}
+
+func TestAnalyser_submitDetectVulnerabilitiesByCustomRules(t *testing.T) {
+	t.Run("Should run custom rules without native engine in all files of project", func(t *testing.T) {
+		rulesPath := filepath.Join(t.TempDir(), "rules.yaml")
+		assert.NoError(t, ioutil.WriteFile(rulesPath, []byte(`
+- id: 3f3b6c1a-6d1f-4b8e-9a55-2a8f1f1a0c01
+  name: Public bucket
+  description: Public bucket
+  severity: HIGH
+  confidence: MEDIUM
+  type: Regular
+  language: HCL
+  files: ["*.tf"]
+  expressions: ['acl\s*=\s*"public-read"']
+`), os.ModePerm))
+
+		analysis := &horusec.Analysis{ID: uuid.New()}
+		configs := config.NewConfig()
+		configs.SetProjectPath(t.TempDir())
+		configs.SetCustomRulesPath(rulesPath)
+		projectPath := filepath.Join(configs.GetProjectPath(), ".horusec", analysis.ID.String(), "infra")
+		assert.NoError(t, os.MkdirAll(projectPath, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "main.tf"),
+			[]byte("resource \"aws_s3_bucket\" \"b\" {\n  acl = \"public-read\"\n}\n"), os.ModePerm))
+
+		controller := &Analyser{
+			config:           configs,
+			analysis:         analysis,
+			formatterService: formatters.NewFormatterService(analysis, &docker.Mock{}, configs),
+			workerPool:       workerpool.NewWorkerPool(context.Background(), 1),
+		}
+
+		controller.submitDetectVulnerabilitiesByCustomRules()
+
+		assert.NoError(t, controller.workerPool.Wait())
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.HorusecCustom, vulnerability.SecurityTool)
+		assert.Equal(t, languages.HCL, vulnerability.Language)
+		assert.Equal(t, filepath.Join("infra", "main.tf"), vulnerability.File)
+		assert.Equal(t, "2", vulnerability.Line)
+	})
+}
@@ -21,13 +21,17 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/dockerfile"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rust/cargo"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	copyUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/copy"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	"github.com/ZupIT/horusec/horusec-cli/internal/enums/toignore"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	horusecIgnore "github.com/ZupIT/horusec/horusec-cli/internal/services/horusec_ignore"
 	"github.com/bmatcuk/doublestar/v2"
 	"github.com/go-enry/go-enry/v2"
 	"github.com/google/uuid"
@@ -35,22 +39,27 @@ import (
 
 type Interface interface {
 	LanguageDetect(directory string) ([]languages.Language, error)
+	SetDiff(changes *diff.Diff)
 }
 
 type LanguageDetect struct {
-	configs    config.IConfig
-	analysisID uuid.UUID
+	configs              config.IConfig
+	analysisID           uuid.UUID
+	changes              *diff.Diff
+	horusecIgnoreService horusecIgnore.IService
 }
 
 func NewLanguageDetect(configs config.IConfig, analysisID uuid.UUID) Interface {
 	return &LanguageDetect{
-		analysisID: analysisID,
-		configs:    configs,
+		analysisID:           analysisID,
+		configs:              configs,
+		horusecIgnoreService: horusecIgnore.NewHorusecIgnoreService(configs),
 	}
 }
 
 func (ld *LanguageDetect) LanguageDetect(directory string) ([]languages.Language, error) {
 	langs := []string{languages.Leaks.ToString(), languages.Generic.ToString()}
+	ld.configs.SetProjectPath(directory)
 	languagesFound, err := ld.getLanguages(directory)
 	if err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorDetectLanguage, err)
@@ -59,11 +68,15 @@ func (ld *LanguageDetect) LanguageDetect(directory string) ([]languages.Language
 
 	langs = ld.appendLanguagesFound(langs, languagesFound)
 
-	ld.configs.SetProjectPath(directory)
 	err = ld.copyProjectToHorusecFolder(directory)
 	return ld.filterSupportedLanguages(langs), err
 }
 
+// SetDiff restrict the language detection and the copy of project to only files changed against diff base
+func (ld *LanguageDetect) SetDiff(changes *diff.Diff) {
+	ld.changes = changes
+}
+
 func (ld *LanguageDetect) getLanguages(directory string) (languagesFound []string, err error) {
 	filesToSkip, languagesFound, err := ld.walkInPathAndReturnTotalToSkip(directory)
 	if filesToSkip > 0 {
@@ -96,8 +109,8 @@ func (ld *LanguageDetect) execWalkToGetLanguagesAndReturnIfSkip(
 	if skip {
 		logger.LogDebugWithLevel(messages.MsgDebugFolderOrFileIgnored, path)
 	}
-	if !info.IsDir() && !skip {
-		newLanguages := enry.GetLanguages(path, nil)
+	if !info.IsDir() && !skip && !ld.isFileNotChanged(path) {
+		newLanguages := ld.getLanguagesOfFile(path)
 		logger.LogTraceWithLevel(messages.MsgTraceLanguageFound,
 			map[string][]string{path: newLanguages})
 		languagesFound = append(languagesFound, newLanguages...)
@@ -105,6 +118,18 @@ func (ld *LanguageDetect) execWalkToGetLanguagesAndReturnIfSkip(
 	return languagesFound, skip
 }
 
+// getLanguagesOfFile adds the languages identified only by the name of file, like Dockerfile and Cargo.toml
+func (ld *LanguageDetect) getLanguagesOfFile(path string) []string {
+	newLanguages := enry.GetLanguages(path, nil)
+	if dockerfile.IsDockerfile(path) || dockerfile.IsDockerComposeFile(path) {
+		newLanguages = append(newLanguages, languages.Dockerfile.ToString())
+	}
+	if cargo.IsCargoFile(path) {
+		newLanguages = append(newLanguages, languages.Rust.ToString())
+	}
+	return newLanguages
+}
+
 func (ld *LanguageDetect) uniqueLanguages(languagesFound []string) (output []string) {
 	for _, language := range languagesFound {
 		if len(output) == 0 {
@@ -133,10 +158,29 @@ func (ld *LanguageDetect) checkIfLanguageExistAndConcat(output []string, languag
 func (ld *LanguageDetect) filesAndFoldersToIgnore(path string) bool {
 	isToSkip := ld.checkDefaultPathsToIgnore(path) ||
 		ld.checkAdditionalPathsToIgnore(path) ||
-		ld.checkFileExtensionInvalid(path)
+		ld.checkFileExtensionInvalid(path) ||
+		ld.horusecIgnoreService.IsToIgnore(path)
 	return isToSkip
 }
 
+func (ld *LanguageDetect) isFileNotChanged(path string) bool {
+	if ld.changes == nil {
+		return false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	relativePath, err := filepath.Rel(ld.configs.GetProjectPath(), path)
+	if err != nil {
+		return false
+	}
+
+	return !ld.changes.IsFileChanged(relativePath)
+}
+
 func (ld *LanguageDetect) checkDefaultPathsToIgnore(path string) bool {
 	for _, value := range toignore.GetDefaultFoldersToIgnore() {
 		if strings.Contains(path, file.ReplacePathSeparator(value)) {
@@ -168,7 +212,9 @@ func (ld *LanguageDetect) checkFileExtensionInvalid(path string) bool {
 
 func (ld *LanguageDetect) copyProjectToHorusecFolder(directory string) error {
 	folderDstName := file.ReplacePathSeparator(fmt.Sprintf("%s/.horusec/%s", directory, ld.analysisID.String()))
-	err := copyUtil.Copy(directory, folderDstName, ld.filesAndFoldersToIgnore)
+	err := copyUtil.Copy(directory, folderDstName, func(path string) bool {
+		return ld.filesAndFoldersToIgnore(path) || ld.isFileNotChanged(path)
+	})
 	if err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorCopyProjectToHorusecAnalysis, err)
 	} else {
@@ -17,6 +17,7 @@ package languagedetect
 import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	mock2 "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -28,3 +29,7 @@ func (m *Mock) LanguageDetect(directory string) ([]languages.Language, error) {
 	args := m.MethodCalled("LanguageDetect")
 	return args.Get(0).([]languages.Language), mock2.ReturnNilOrError(args, 1)
 }
+
+func (m *Mock) SetDiff(_ *diff.Diff) {
+	_ = m.MethodCalled("SetDiff")
+}
@@ -15,12 +15,15 @@
 package languagedetect
 
 import (
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	analysisUseCases "github.com/ZupIT/horusec/development-kit/pkg/usecases/analysis"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	"github.com/google/uuid"
 	CopyLib "github.com/otiai10/copy"
 	"github.com/stretchr/testify/assert"
@@ -159,6 +162,29 @@ func TestNewLanguageDetect(t *testing.T) {
 		assert.Len(t, langs, 4)
 	})
 
+	t.Run("Should run language detect and return DOCKERFILE and GITLEAKS", func(t *testing.T) {
+		configs := &config.Config{}
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		controller := NewLanguageDetect(configs, analysis.ID)
+
+		langs, _ := controller.LanguageDetect("../../../../examples/dockerfile/example1")
+
+		assert.Contains(t, langs, languages.Leaks)
+		assert.Contains(t, langs, languages.Generic)
+		assert.Contains(t, langs, languages.Dockerfile)
+	})
+
+	t.Run("Should run language detect and return RUST and GITLEAKS", func(t *testing.T) {
+		configs := &config.Config{}
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		controller := NewLanguageDetect(configs, analysis.ID)
+
+		langs, _ := controller.LanguageDetect("../../../../examples/rust/example1")
+
+		assert.Contains(t, langs, languages.Leaks)
+		assert.Contains(t, langs, languages.Rust)
+	})
+
 	t.Run("Should run language detect and return KOTLIN and GITLEAKS", func(t *testing.T) {
 		configs := &config.Config{}
 		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
@@ -225,4 +251,38 @@ func TestNewLanguageDetect(t *testing.T) {
 		assert.Contains(t, langs, languages.Yaml)
 		assert.Len(t, langs, 4)
 	})
+
+	t.Run("Should run language detect only in files changed against diff base", func(t *testing.T) {
+		configs := &config.Config{}
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		controller := NewLanguageDetect(configs, analysis.ID)
+		controller.SetDiff(diff.NewDiff())
+
+		langs, _ := controller.LanguageDetect("../../../../examples/go/example1")
+		assert.NotContains(t, langs, languages.Go)
+
+		changes := diff.NewDiff()
+		changes.AddLines("api/server.go", 1, 10)
+		controller.SetDiff(changes)
+
+		langs, _ = controller.LanguageDetect("../../../../examples/go/example1")
+		assert.Contains(t, langs, languages.Go)
+	})
+
+	t.Run("Should ignore files setup in .horusecignore", func(t *testing.T) {
+		projectPath := "./examples/horusecignore"
+		assert.NoError(t, os.MkdirAll(projectPath, os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, ".horusecignore"), []byte("*.go\n"), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "main.go"), []byte("package main"), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "main.py"), []byte("print(1)"), os.ModePerm))
+		configs := &config.Config{}
+		analysis := analysisUseCases.NewAnalysisUseCases().NewAnalysisRunning()
+		controller := NewLanguageDetect(configs, analysis.ID)
+
+		langs, err := controller.LanguageDetect(projectPath)
+
+		assert.NoError(t, err)
+		assert.Contains(t, langs, languages.Python)
+		assert.NotContains(t, langs, languages.Go)
+	})
 }
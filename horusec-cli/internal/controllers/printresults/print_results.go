@@ -16,6 +16,7 @@ package printresults
 
 import (
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"os"
@@ -27,8 +28,13 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/ZupIT/horusec/horusec-cli/internal/enums/outputtype"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/html"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/junit"
+	policyService "github.com/ZupIT/horusec/horusec-cli/internal/services/policy"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/sarif"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/sonarqube"
 )
 
@@ -73,7 +79,34 @@ func (pr *PrintResults) StartPrintResults() (totalVulns int, err error) {
 		logger.LogWarnWithLevel(messages.MsgErrorTimeoutOccurs)
 	}
 
-	return pr.totalVulns, nil
+	return pr.totalVulns, pr.evaluatePolicy()
+}
+
+func (pr *PrintResults) evaluatePolicy() error {
+	configPolicy := pr.configs.GetPolicy()
+	if configPolicy.IsEmpty() {
+		return nil
+	}
+
+	result := policyService.NewPolicyService(pr.configs).Evaluate(pr.analysis)
+	pr.printPolicyEvaluation(result)
+	return result.GetViolationError()
+}
+
+func (pr *PrintResults) printPolicyEvaluation(result *policy.Result) {
+	fmt.Println("POLICY EVALUATION:")
+	fmt.Println("")
+	for _, ruleResult := range result.Rules {
+		status := "PASSED"
+		if ruleResult.Violated {
+			status = "FAILED"
+		}
+
+		fmt.Println(fmt.Sprintf("[%s] %s: %v vulnerabilities found, maximum allowed is %v, exit code is %v", status,
+			ruleResult.Rule.Name, ruleResult.Total, ruleResult.Rule.MaxCount, ruleResult.Rule.GetExitCode()))
+	}
+
+	pr.logSeparator(true)
 }
 
 func (pr *PrintResults) factoryPrintByType() error {
@@ -82,6 +115,12 @@ func (pr *PrintResults) factoryPrintByType() error {
 		return pr.runPrintResultsJSON()
 	case pr.configs.GetPrintOutputType() == string(outputtype.SonarQube):
 		return pr.runPrintResultsSonarQube()
+	case pr.configs.GetPrintOutputType() == string(outputtype.Sarif):
+		return pr.runPrintResultsSarif()
+	case pr.configs.GetPrintOutputType() == string(outputtype.JUnit):
+		return pr.runPrintResultsJUnit()
+	case pr.configs.GetPrintOutputType() == string(outputtype.HTML):
+		return pr.runPrintResultsHTML()
 	default:
 		return pr.runPrintResultsText()
 	}
@@ -117,6 +156,18 @@ func (pr *PrintResults) runPrintResultsSonarQube() error {
 	return pr.saveSonarQubeFormatResults()
 }
 
+func (pr *PrintResults) runPrintResultsSarif() error {
+	return pr.saveSarifFormatResults()
+}
+
+func (pr *PrintResults) runPrintResultsJUnit() error {
+	return pr.saveJUnitFormatResults()
+}
+
+func (pr *PrintResults) runPrintResultsHTML() error {
+	return pr.saveHTMLFormatResults()
+}
+
 func (pr *PrintResults) checkIfExistVulnerabilityOrNoSec() {
 	for key := range pr.analysis.AnalysisVulnerabilities {
 		vuln := pr.analysis.AnalysisVulnerabilities[key].Vulnerability
@@ -128,7 +179,7 @@ func (pr *PrintResults) checkIfExistVulnerabilityOrNoSec() {
 }
 
 func (pr *PrintResults) validateVulnerabilityToCheckTotalErrors(vuln *horusecEntities.Vulnerability) {
-	if vuln.Severity.ToString() != "" && !pr.isTypeVulnToSkip(vuln) {
+	if vuln.Severity.ToString() != "" && !pr.isTypeVulnToSkip(vuln) && !vuln.PreExisting {
 		if !pr.isIgnoredVulnerability(vuln.Severity.ToString()) {
 			logger.LogDebugWithLevel(messages.MsgDebugVulnHashToFix + vuln.VulnHash)
 			if logger.CurrentLevel >= logger.DebugLevel {
@@ -168,6 +219,38 @@ func (pr *PrintResults) saveSonarQubeFormatResults() error {
 	return pr.parseFilePathToAbsAndCreateOutputJSON(bytesToWrite)
 }
 
+func (pr *PrintResults) saveSarifFormatResults() error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateSarifFile)
+	report := sarif.NewSarif(pr.analysis).ConvertVulnerabilityToSarif()
+	bytesToWrite, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
+		return err
+	}
+	return pr.parseFilePathToAbsAndCreateOutputJSON(bytesToWrite)
+}
+
+func (pr *PrintResults) saveJUnitFormatResults() error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateJUnitFile)
+	report := junit.NewJUnit(pr.analysis).ConvertVulnerabilityToJUnit()
+	bytesToWrite, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
+		return err
+	}
+	return pr.parseFilePathToAbsAndCreateOutputJSON(append([]byte(xml.Header), bytesToWrite...))
+}
+
+func (pr *PrintResults) saveHTMLFormatResults() error {
+	logger.LogInfoWithLevel(messages.MsgInfoStartGenerateHTMLFile)
+	bytesToWrite, err := html.NewHTML(pr.analysis, pr.configs.GetEnableCommitAuthor()).ConvertVulnerabilityToHTML()
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
+		return err
+	}
+	return pr.parseFilePathToAbsAndCreateOutputJSON(bytesToWrite)
+}
+
 func (pr *PrintResults) returnDefaultErrOutputJSON(err error) error {
 	logger.LogErrorWithLevel(messages.MsgErrorGenerateJSONFile, err)
 	return ErrOutputJSON
@@ -218,6 +301,9 @@ func (pr *PrintResults) printTotalVulnerabilities() {
 			"were found and we classified them into:", totalVulnerabilities))
 		fmt.Println("")
 	}
+	if totalPreExisting := pr.analysis.GetTotalPreExistingVulnerabilities(); totalPreExisting > 0 {
+		fmt.Println(fmt.Sprintf("Total of vulnerabilities already found in baseline is: %v", totalPreExisting))
+	}
 	totalVulnerabilitiesBySeverity := pr.analysis.GetTotalVulnerabilitiesBySeverity()
 	for vulnType, countBySeverity := range totalVulnerabilitiesBySeverity {
 		for severityName, count := range countBySeverity {
@@ -241,6 +327,14 @@ func (pr *PrintResults) printTextOutputVulnerabilityData(vulnerability *horusecE
 	fmt.Println(fmt.Sprintf("Details: %s", vulnerability.Details))
 	fmt.Println(fmt.Sprintf("Type: %s", vulnerability.Type))
 
+	pr.printClassification(vulnerability)
+
+	pr.printRuleInformation(vulnerability)
+
+	pr.printSuppressionReason(vulnerability)
+
+	pr.printBaseline(vulnerability)
+
 	pr.printCommitAuthor(vulnerability)
 
 	fmt.Println(fmt.Sprintf("ReferenceHash: %s", vulnerability.VulnHash))
@@ -250,6 +344,45 @@ func (pr *PrintResults) printTextOutputVulnerabilityData(vulnerability *horusecE
 	pr.logSeparator(true)
 }
 
+func (pr *PrintResults) printClassification(vulnerability *horusecEntities.Vulnerability) {
+	if len(vulnerability.CWEs) > 0 {
+		fmt.Println(fmt.Sprintf("CWE: %s", strings.Join(vulnerability.CWEs, ", ")))
+	}
+	if len(vulnerability.OWASPTop10) > 0 {
+		fmt.Println(fmt.Sprintf("OWASP Top 10: %s", strings.Join(vulnerability.OWASPTop10, ", ")))
+	}
+}
+
+// nolint
+func (pr *PrintResults) printRuleInformation(vulnerability *horusecEntities.Vulnerability) {
+	if vulnerability.RuleID != "" {
+		fmt.Println(fmt.Sprintf("Rule ID: %s", vulnerability.RuleID))
+	}
+	if vulnerability.Title != "" {
+		fmt.Println(fmt.Sprintf("Title: %s", vulnerability.Title))
+	}
+	if vulnerability.Remediation != "" {
+		fmt.Println(fmt.Sprintf("Remediation: %s", vulnerability.Remediation))
+	}
+	if len(vulnerability.References) > 0 {
+		fmt.Println(fmt.Sprintf("References: %s", strings.Join(vulnerability.References, ", ")))
+	}
+}
+
+func (pr *PrintResults) printSuppressionReason(vulnerability *horusecEntities.Vulnerability) {
+	if vulnerability.SuppressionReason != "" {
+		fmt.Println(fmt.Sprintf("SuppressionReason: %s", vulnerability.SuppressionReason))
+	}
+}
+
+// nolint
+func (pr *PrintResults) printBaseline(vulnerability *horusecEntities.Vulnerability) {
+	if pr.configs.GetBaselineFilePath() == "" {
+		return
+	}
+	fmt.Println(fmt.Sprintf("PreExisting: %t", vulnerability.PreExisting))
+}
+
 // nolint
 func (pr *PrintResults) printCommitAuthor(vulnerability *horusecEntities.Vulnerability) {
 	if !pr.configs.GetEnableCommitAuthor() {
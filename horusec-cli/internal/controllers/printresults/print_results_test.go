@@ -17,12 +17,14 @@ package printresults
 import (
 	"errors"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"os"
 	"testing"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/test"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -83,6 +85,52 @@ func TestPrintResults_StartPrintResults(t *testing.T) {
 		assert.Equal(t, 0, totalVulns)
 	})
 
+	t.Run("Should not return errors with type SARIF", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+
+		configs := &config.Config{}
+		configs.SetPrintOutputType("sarif")
+		configs.SetJSONOutputFilePath("/tmp/horusec-sarif.json")
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 11, totalVulns)
+		assert.FileExists(t, "/tmp/horusec-sarif.json")
+		assert.NoError(t, os.RemoveAll("/tmp/horusec-sarif.json"))
+	})
+
+	t.Run("Should not return errors with type JUNIT", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+
+		configs := &config.Config{}
+		configs.SetPrintOutputType("junit")
+		configs.SetJSONOutputFilePath("/tmp/horusec-junit.xml")
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 11, totalVulns)
+		assert.FileExists(t, "/tmp/horusec-junit.xml")
+		assert.NoError(t, os.RemoveAll("/tmp/horusec-junit.xml"))
+	})
+
+	t.Run("Should not return errors with type HTML", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+
+		configs := &config.Config{}
+		configs.SetPrintOutputType("html")
+		configs.SetEnableCommitAuthor(true)
+		configs.SetJSONOutputFilePath("/tmp/horusec-report.html")
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 11, totalVulns)
+		assert.FileExists(t, "/tmp/horusec-report.html")
+		assert.NoError(t, os.RemoveAll("/tmp/horusec-report.html"))
+	})
+
 	t.Run("Should return errors with type JSON", func(t *testing.T) {
 		analysis := test.CreateAnalysisMock()
 
@@ -178,6 +226,23 @@ func TestPrintResults_StartPrintResults(t *testing.T) {
 		assert.Equal(t, 12, totalVulns)
 	})
 
+	t.Run("Should return 12 vulnerabilities with classification and remediation", func(t *testing.T) {
+		configs := &config.Config{}
+
+		analysis := test.CreateAnalysisMock()
+
+		vulnerability := test.GetGoVulnerabilityWithSeverity(severity.Medium)
+		vulnerability.SetClassification("CWE-89")
+		vulnerability.SetRule("G201", "SQL query construction using format string")
+		vulnerability.SetRemediation("", "https://securego.io/docs/rules/g201-g202.html")
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities, horusec.AnalysisVulnerabilities{Vulnerability: vulnerability})
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 12, totalVulns)
+	})
+
 	t.Run("Should not return errors when configured to ignore vulnerabilities with severity LOW and MEDIUM", func(t *testing.T) {
 		analysis := test.CreateAnalysisMock()
 
@@ -205,4 +270,56 @@ func TestPrintResults_StartPrintResults(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, 1, totalVulns)
 	})
+
+	t.Run("Should not count vulnerabilities already found in baseline", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+
+		preExistingVulnerability := test.GetGoVulnerabilityWithSeverity(severity.High)
+		preExistingVulnerability.PreExisting = true
+		analysis.AnalysisVulnerabilities = []horusec.AnalysisVulnerabilities{
+			{
+				Vulnerability: preExistingVulnerability,
+			},
+			{
+				Vulnerability: test.GetGoVulnerabilityWithSeverity(severity.High),
+			},
+		}
+
+		configs := &config.Config{}
+		configs.SetBaselineFilePath("./horusec-baseline.json")
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+		assert.NoError(t, err)
+		assert.Equal(t, 1, totalVulns)
+	})
+
+	t.Run("Should return policy violation error when policy rule is violated", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+
+		configs := &config.Config{}
+		configs.SetPolicy(policy.Policy{Rules: []policy.Rule{
+			{Name: "max-low", Severity: severity.Low, MaxCount: 100},
+			{Name: "no-high", Severity: severity.High, ExitCode: 10},
+		}})
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		var violation *policy.ViolationError
+		assert.True(t, errors.As(err, &violation))
+		assert.Equal(t, 10, violation.ExitCode)
+		assert.Equal(t, []string{"no-high"}, violation.Rules)
+		assert.Equal(t, 11, totalVulns)
+	})
+
+	t.Run("Should not return error when policy rules are not violated", func(t *testing.T) {
+		analysis := test.CreateAnalysisMock()
+
+		configs := &config.Config{}
+		configs.SetPolicy(policy.Policy{Rules: []policy.Rule{{Name: "max-all", MaxCount: 100}}})
+
+		totalVulns, err := NewPrintResults(analysis, configs).StartPrintResults()
+
+		assert.NoError(t, err)
+		assert.Equal(t, 11, totalVulns)
+	})
 }
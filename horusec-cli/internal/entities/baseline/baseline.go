@@ -12,18 +12,20 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package entities
+package baseline
 
-type Issue struct {
-	Line          string `json:"line"`
-	Offender      string `json:"offender"`
-	Commit        string `json:"commit"`
-	Repo          string `json:"repo"`
-	Rule          string `json:"rule"`
-	CommitMessage string `json:"commitMessage"`
-	Author        string `json:"author"`
-	Email         string `json:"email"`
-	File          string `json:"file"`
-	Date          string `json:"date"`
-	Tags          string `json:"tags"`
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const Version = "1"
+
+type Baseline struct {
+	Version        string    `json:"version"`
+	AnalysisID     uuid.UUID `json:"analysisID"`
+	RepositoryName string    `json:"repositoryName"`
+	CreatedAt      time.Time `json:"createdAt"`
+	VulnHashes     []string  `json:"vulnHashes"`
 }
@@ -7,24 +7,45 @@ import (
 
 	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	customRulesEnums "github.com/ZupIT/horusec/horusec-cli/internal/enums/custom_rules"
+	"github.com/bmatcuk/doublestar/v2"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/go-ozzo/ozzo-validation/v4/is"
 	"github.com/google/uuid"
 )
 
 type CustomRule struct {
-	ID          uuid.UUID                 `json:"id"`
-	Name        string                    `json:"name"`
-	Description string                    `json:"description"`
-	Severity    severity.Severity         `json:"severity"`
-	Confidence  confidence.Confidence     `json:"confidence"`
-	Type        customRulesEnums.MathType `json:"type"`
-	Expressions []string                  `json:"expressions"`
-	Tool        tools.Tool                `json:"tool"`
+	ID          uuid.UUID                 `json:"id" yaml:"id"`
+	Name        string                    `json:"name" yaml:"name"`
+	Description string                    `json:"description" yaml:"description"`
+	Severity    severity.Severity         `json:"severity" yaml:"severity"`
+	Confidence  confidence.Confidence     `json:"confidence" yaml:"confidence"`
+	Type        customRulesEnums.MathType `json:"type" yaml:"type"`
+	Expressions []string                  `json:"expressions" yaml:"expressions"`
+	Tool        tools.Tool                `json:"tool" yaml:"tool"`
+	Language    languages.Language        `json:"language" yaml:"language"`
+	Files       []string                  `json:"files" yaml:"files"`
+}
+
+// nativeToolsByLanguage are the tools which run custom rules together with their own rules
+func nativeToolsByLanguage() map[languages.Language]tools.Tool {
+	return map[languages.Language]tools.Tool{
+		languages.CSharp:     tools.HorusecCsharp,
+		languages.Java:       tools.HorusecJava,
+		languages.Kotlin:     tools.HorusecKotlin,
+		languages.Yaml:       tools.HorusecKubernetes,
+		languages.Leaks:      tools.HorusecLeaks,
+		languages.Javascript: tools.HorusecNodejs,
+		languages.Go:         tools.HorusecGo,
+		languages.Python:     tools.HorusecPython,
+		languages.Dockerfile: tools.HorusecDockerfile,
+		languages.HCL:        tools.HorusecHCL,
+		languages.Dart:       tools.HorusecDart,
+	}
 }
 
 func (c *CustomRule) Validate() error {
@@ -36,11 +57,79 @@ func (c *CustomRule) Validate() error {
 			confidence.Low, confidence.Medium, confidence.High)),
 		validation.Field(&c.Type, validation.Required, validation.In(customRulesEnums.Regular,
 			customRulesEnums.OrMatch, customRulesEnums.AndMatch)),
-		validation.Field(&c.Tool, validation.Required, validation.In(tools.HorusecCsharp, tools.HorusecJava,
-			tools.HorusecKotlin, tools.HorusecKubernetes, tools.HorusecLeaks, tools.HorusecNodejs)),
+		validation.Field(&c.Tool, validation.When(c.Language == "", validation.Required),
+			validation.In(c.validTools()...)),
+		validation.Field(&c.Language, validation.When(c.Tool == "", validation.Required),
+			validation.In(c.validLanguages()...)),
+		validation.Field(&c.Files, validation.When(c.Tool == "" && c.Language == languages.Generic,
+			validation.Required), validation.Each(validation.By(c.validateGlob))),
+		validation.Field(&c.Expressions, validation.Required, validation.Each(validation.By(c.validateExpression))),
 	)
 }
 
+func (c *CustomRule) validTools() (validTools []interface{}) {
+	for _, tool := range nativeToolsByLanguage() {
+		validTools = append(validTools, tool)
+	}
+
+	return append(validTools, tools.HorusecCustom)
+}
+
+func (c *CustomRule) validLanguages() (validLanguages []interface{}) {
+	for _, language := range languages.Unknown.MapEnableLanguages() {
+		validLanguages = append(validLanguages, language)
+	}
+
+	return validLanguages
+}
+
+// validateGlob matches the glob with itself because the syntax is checked only while matching
+func (c *CustomRule) validateGlob(value interface{}) error {
+	glob, _ := value.(string)
+	_, err := doublestar.Match(glob, glob)
+	return err
+}
+
+func (c *CustomRule) validateExpression(value interface{}) error {
+	expression, _ := value.(string)
+	_, err := regexp.Compile(expression)
+	return err
+}
+
+// GetTool returns the tool which runs the rule. Rules with files and rules of languages without a native
+// engine run in HorusecCustom, because native engines apply their rules to all files of the language
+func (c *CustomRule) GetTool() tools.Tool {
+	if len(c.Files) > 0 {
+		return tools.HorusecCustom
+	}
+
+	if c.Tool != "" {
+		return c.Tool
+	}
+
+	if tool, ok := nativeToolsByLanguage()[c.Language]; ok {
+		return tool
+	}
+
+	return tools.HorusecCustom
+}
+
+// GetLanguage returns the language of vulnerabilities found by the rule, when it is not set the language of the
+// native tool is used
+func (c *CustomRule) GetLanguage() languages.Language {
+	if c.Language != "" {
+		return c.Language
+	}
+
+	for language, tool := range nativeToolsByLanguage() {
+		if tool == c.Tool {
+			return language
+		}
+	}
+
+	return languages.Generic
+}
+
 func (c *CustomRule) GetRuleType() text.MatchType {
 	switch c.Type {
 	case customRulesEnums.Regular:
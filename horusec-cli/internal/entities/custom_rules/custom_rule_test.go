@@ -5,7 +5,9 @@ import (
 
 	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/confidence"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	customRulesEnums "github.com/ZupIT/horusec/horusec-cli/internal/enums/custom_rules"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -27,6 +29,79 @@ func TestValidate(t *testing.T) {
 		assert.NoError(t, customRule.Validate())
 	})
 
+	t.Run("should return no errors when custom rule targets native python engine", func(t *testing.T) {
+		customRule := CustomRule{
+			ID:          uuid.New(),
+			Name:        "test",
+			Description: "test",
+			Severity:    severity.High,
+			Confidence:  confidence.Medium,
+			Type:        customRulesEnums.Regular,
+			Expressions: []string{`requests\.get\(`},
+			Tool:        tools.HorusecPython,
+		}
+
+		assert.NoError(t, customRule.Validate())
+	})
+
+	t.Run("should return no errors when custom rule targets language by files", func(t *testing.T) {
+		customRule := CustomRule{
+			ID:          uuid.New(),
+			Severity:    severity.High,
+			Confidence:  confidence.Medium,
+			Type:        customRulesEnums.Regular,
+			Expressions: []string{`acl = "public-read"`},
+			Language:    languages.HCL,
+			Files:       []string{"*.tf", "modules/**/*.tf"},
+		}
+
+		assert.NoError(t, customRule.Validate())
+	})
+
+	t.Run("should return error when custom rule has no tool and invalid language", func(t *testing.T) {
+		for _, language := range []languages.Language{"", "Cobol", languages.Unknown} {
+			customRule := CustomRule{
+				ID:         uuid.New(),
+				Severity:   severity.High,
+				Confidence: confidence.Medium,
+				Type:       customRulesEnums.Regular,
+				Language:   language,
+			}
+
+			assert.Error(t, customRule.Validate(), language)
+		}
+	})
+
+	t.Run("should return error when custom rule has invalid glob or generic without files", func(t *testing.T) {
+		customRule := CustomRule{
+			ID:         uuid.New(),
+			Severity:   severity.High,
+			Confidence: confidence.Medium,
+			Type:       customRulesEnums.Regular,
+			Language:   languages.Generic,
+		}
+
+		assert.Error(t, customRule.Validate())
+
+		customRule.Files = []string{"src/[a-"}
+		assert.Error(t, customRule.Validate())
+	})
+
+	t.Run("should return error when custom rule has no expressions or invalid expression", func(t *testing.T) {
+		customRule := CustomRule{
+			ID:         uuid.New(),
+			Severity:   severity.High,
+			Confidence: confidence.Medium,
+			Type:       customRulesEnums.Regular,
+			Tool:       tools.HorusecJava,
+		}
+
+		assert.Error(t, customRule.Validate())
+
+		customRule.Expressions = []string{"(unclosed"}
+		assert.Error(t, customRule.Validate())
+	})
+
 	t.Run("should return error when invalid custom", func(t *testing.T) {
 		customRule := CustomRule{}
 		assert.Error(t, customRule.Validate())
@@ -90,3 +165,31 @@ func TestToString(t *testing.T) {
 		assert.NotEmpty(t, customRule.ToString())
 	})
 }
+
+func TestGetToolAndLanguage(t *testing.T) {
+	t.Run("should return tool and language of native engine", func(t *testing.T) {
+		customRule := CustomRule{Tool: tools.HorusecJava}
+		assert.Equal(t, tools.HorusecJava, customRule.GetTool())
+		assert.Equal(t, languages.Java, customRule.GetLanguage())
+
+		customRule = CustomRule{Language: languages.Go}
+		assert.Equal(t, tools.HorusecGo, customRule.GetTool())
+		assert.Equal(t, languages.Go, customRule.GetLanguage())
+	})
+
+	t.Run("should return horusec custom when rule has files or language without native engine", func(t *testing.T) {
+		customRule := CustomRule{Language: languages.Go, Files: []string{"cmd/**/*.go"}}
+		assert.Equal(t, tools.HorusecCustom, customRule.GetTool())
+
+		customRule = CustomRule{Tool: tools.HorusecLeaks, Files: []string{"*.env"}}
+		assert.Equal(t, tools.HorusecCustom, customRule.GetTool())
+		assert.Equal(t, languages.Leaks, customRule.GetLanguage())
+
+		customRule = CustomRule{Language: languages.Ruby}
+		assert.Equal(t, tools.HorusecCustom, customRule.GetTool())
+		assert.Equal(t, languages.Ruby, customRule.GetLanguage())
+
+		customRule = CustomRule{Tool: tools.HorusecCustom}
+		assert.Equal(t, languages.Generic, customRule.GetLanguage())
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type LineRange struct {
+	Start int
+	End   int
+}
+
+type FileChanges struct {
+	AllLines bool
+	Lines    []LineRange
+}
+
+// Diff contains the files changed between a git reference and the working tree, all paths are relative to the
+// project path and are matched exactly, so a file with the same name in another folder is not considered changed
+type Diff struct {
+	Files map[string]*FileChanges
+}
+
+func NewDiff() *Diff {
+	return &Diff{
+		Files: map[string]*FileChanges{},
+	}
+}
+
+func (d *Diff) AddFile(path string) {
+	d.getOrCreateFile(path).AllLines = true
+}
+
+func (d *Diff) AddLines(path string, start, end int) {
+	changes := d.getOrCreateFile(path)
+	changes.Lines = append(changes.Lines, LineRange{Start: start, End: end})
+}
+
+func (d *Diff) IsFileChanged(path string) bool {
+	return d.getFile(path) != nil
+}
+
+// IsLineChanged return true when the line was added or modified in file, when line is empty or is not a number
+// only the file is checked
+func (d *Diff) IsLineChanged(path, line string) bool {
+	changes := d.getFile(path)
+	if changes == nil {
+		return false
+	}
+
+	lineNumber := d.parseLine(line)
+	if changes.AllLines || lineNumber <= 0 {
+		return true
+	}
+
+	return changes.containsLine(lineNumber)
+}
+
+func (d *Diff) getOrCreateFile(path string) *FileChanges {
+	path = d.normalizePath(path)
+	if _, ok := d.Files[path]; !ok {
+		d.Files[path] = &FileChanges{}
+	}
+
+	return d.Files[path]
+}
+
+func (d *Diff) getFile(path string) *FileChanges {
+	return d.Files[d.normalizePath(path)]
+}
+
+func (d *Diff) normalizePath(path string) string {
+	path = filepath.ToSlash(filepath.Clean(strings.TrimSpace(path)))
+	return strings.TrimPrefix(path, "/")
+}
+
+func (d *Diff) parseLine(line string) int {
+	line = strings.Split(strings.TrimSpace(line), "-")[0]
+	lineNumber, _ := strconv.Atoi(line)
+	return lineNumber
+}
+
+func (f *FileChanges) containsLine(line int) bool {
+	for _, lineRange := range f.Lines {
+		if line >= lineRange.Start && line <= lineRange.End {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsFileChanged(t *testing.T) {
+	t.Run("should return true when file was changed", func(t *testing.T) {
+		changes := NewDiff()
+		changes.AddLines("api/server.go", 1, 2)
+
+		assert.True(t, changes.IsFileChanged("./api/server.go"))
+		assert.False(t, changes.IsFileChanged("api/util.go"))
+	})
+
+	t.Run("should match only the exact project relative path", func(t *testing.T) {
+		changes := NewDiff()
+		changes.AddLines("a/config.go", 1, 2)
+
+		assert.True(t, changes.IsFileChanged("a/config.go"))
+		assert.False(t, changes.IsFileChanged("b/config.go"))
+		assert.False(t, changes.IsFileChanged("config.go"))
+	})
+}
+
+func TestIsLineChanged(t *testing.T) {
+	t.Run("should return true only when line is in changed ranges", func(t *testing.T) {
+		changes := NewDiff()
+		changes.AddLines("main.go", 10, 12)
+
+		assert.True(t, changes.IsLineChanged("main.go", "10"))
+		assert.True(t, changes.IsLineChanged("main.go", "12-14"))
+		assert.False(t, changes.IsLineChanged("main.go", "13"))
+		assert.False(t, changes.IsLineChanged("other.go", "10"))
+	})
+
+	t.Run("should return true for any line when file is new or line is unknown", func(t *testing.T) {
+		changes := NewDiff()
+		changes.AddFile("new.go")
+		changes.AddLines("main.go", 10, 12)
+
+		assert.True(t, changes.IsLineChanged("new.go", "999"))
+		assert.True(t, changes.IsLineChanged("main.go", ""))
+	})
+}
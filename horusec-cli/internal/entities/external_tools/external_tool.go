@@ -0,0 +1,119 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externaltools
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	OutputFormatSarif    = "sarif"
+	OutputFormatJSONPath = "jsonpath"
+)
+
+// ExternalTool is a tool declared in config that runs in container when one of languages is detected in project
+type ExternalTool struct {
+	Name         string               `json:"name"`
+	Image        string               `json:"image"`
+	CMD          string               `json:"cmd"`
+	Languages    []languages.Language `json:"languages"`
+	OutputFormat string               `json:"outputFormat"`
+	Mapping      Mapping              `json:"mapping"`
+}
+
+// Mapping has the JSONPath of results in output and the JSONPath of vulnerability fields relative to each result
+type Mapping struct {
+	Results     string                       `json:"results"`
+	Details     string                       `json:"details"`
+	Severity    string                       `json:"severity"`
+	Confidence  string                       `json:"confidence"`
+	File        string                       `json:"file"`
+	Line        string                       `json:"line"`
+	Column      string                       `json:"column"`
+	Code        string                       `json:"code"`
+	RuleID      string                       `json:"ruleID"`
+	Title       string                       `json:"title"`
+	Remediation string                       `json:"remediation"`
+	Reference   string                       `json:"reference"`
+	SeverityMap map[string]severity.Severity `json:"severityMap"`
+}
+
+func (e *ExternalTool) Validate() error {
+	return validation.ValidateStruct(e,
+		validation.Field(&e.Name, validation.Required),
+		validation.Field(&e.Image, validation.Required),
+		validation.Field(&e.CMD, validation.Required),
+		validation.Field(&e.Languages, validation.Required, validation.Each(validation.In(e.languagesValues()...))),
+		validation.Field(&e.OutputFormat, validation.Required,
+			validation.In(OutputFormatSarif, OutputFormatJSONPath)),
+		validation.Field(&e.Mapping, validation.When(e.OutputFormat == OutputFormatJSONPath,
+			validation.By(e.validateMapping))),
+	)
+}
+
+func (e *ExternalTool) validateMapping(_ interface{}) error {
+	return validation.ValidateStruct(&e.Mapping,
+		validation.Field(&e.Mapping.Results, validation.Required),
+		validation.Field(&e.Mapping.Details, validation.Required),
+		validation.Field(&e.Mapping.File, validation.Required),
+		validation.Field(&e.Mapping.SeverityMap, validation.Each(validation.In(severity.Values()...))),
+	)
+}
+
+func (e *ExternalTool) languagesValues() (values []interface{}) {
+	for _, language := range languages.SupportedLanguages() {
+		values = append(values, language)
+	}
+
+	return values
+}
+
+// GetTool returns the name of tool as tool, used to ignore it and in logs. The vulnerabilities use tools.External
+func (e *ExternalTool) GetTool() tools.Tool {
+	return tools.Tool(e.Name)
+}
+
+// GetLanguage return the first language of tool detected in project
+func (e *ExternalTool) GetLanguage(detected []languages.Language) (languages.Language, bool) {
+	for _, language := range e.Languages {
+		for _, detectedLanguage := range detected {
+			if language == detectedLanguage {
+				return language, true
+			}
+		}
+	}
+
+	return languages.Unknown, false
+}
+
+// GetSeverity use the severity map of config first and then the value when it is already a horusec severity
+func (e *ExternalTool) GetSeverity(value string) severity.Severity {
+	for key, mappedSeverity := range e.Mapping.SeverityMap {
+		if strings.EqualFold(key, value) {
+			return mappedSeverity
+		}
+	}
+
+	if toolSeverity := severity.ParseStringToSeverity(strings.ToUpper(value)); toolSeverity != "" {
+		return toolSeverity
+	}
+
+	return severity.Unknown
+}
@@ -0,0 +1,85 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package externaltools
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("should return no error when sarif tool is valid", func(t *testing.T) {
+		externalTool := &ExternalTool{Name: "linter", Image: "company/linter", CMD: "linter .",
+			Languages: []languages.Language{languages.Go}, OutputFormat: OutputFormatSarif}
+
+		assert.NoError(t, externalTool.Validate())
+	})
+
+	t.Run("should return error when required fields are empty or language is invalid", func(t *testing.T) {
+		externalTool := &ExternalTool{Languages: []languages.Language{"Cobol"}, OutputFormat: "xml"}
+
+		err := externalTool.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "name: cannot be blank")
+		assert.Contains(t, err.Error(), "image: cannot be blank")
+		assert.Contains(t, err.Error(), "languages: (0: must be a valid value.)")
+		assert.Contains(t, err.Error(), "outputFormat: must be a valid value")
+	})
+
+	t.Run("should return error when json path tool has no mapping", func(t *testing.T) {
+		externalTool := &ExternalTool{Name: "checker", Image: "company/checker", CMD: "checker .",
+			Languages: []languages.Language{languages.Python}, OutputFormat: OutputFormatJSONPath}
+
+		err := externalTool.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "results: cannot be blank")
+	})
+}
+
+func TestGetLanguage(t *testing.T) {
+	externalTool := &ExternalTool{Languages: []languages.Language{languages.Python, languages.Go}}
+
+	t.Run("should return first language of tool detected", func(t *testing.T) {
+		language, isDetected := externalTool.GetLanguage([]languages.Language{languages.Go, languages.Python})
+
+		assert.True(t, isDetected)
+		assert.Equal(t, languages.Python, language)
+	})
+
+	t.Run("should return false when no language was detected", func(t *testing.T) {
+		_, isDetected := externalTool.GetLanguage([]languages.Language{languages.Java})
+
+		assert.False(t, isDetected)
+	})
+}
+
+func TestGetSeverity(t *testing.T) {
+	externalTool := &ExternalTool{Mapping: Mapping{SeverityMap: map[string]severity.Severity{"Blocker": severity.Critical}}}
+
+	t.Run("should return severity from map ignoring case", func(t *testing.T) {
+		assert.Equal(t, severity.Critical, externalTool.GetSeverity("blocker"))
+	})
+
+	t.Run("should return horusec severity when is not in map", func(t *testing.T) {
+		assert.Equal(t, severity.High, externalTool.GetSeverity("high"))
+	})
+
+	t.Run("should return unknown when is not valid", func(t *testing.T) {
+		assert.Equal(t, severity.Unknown, externalTool.GetSeverity("minor"))
+	})
+}
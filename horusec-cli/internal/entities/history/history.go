@@ -0,0 +1,83 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+)
+
+type Line struct {
+	Number  int
+	Content string
+}
+
+type File struct {
+	Path  string
+	Lines []Line
+}
+
+// Commit contains the lines added by one commit of the git history, all paths are relative to the project path
+type Commit struct {
+	Hash    string
+	Author  string
+	Email   string
+	Date    string
+	Message string
+	Files   []*File
+}
+
+func (c *Commit) AddLine(path string, number int, content string) {
+	file := c.getOrCreateFile(path)
+	file.Lines = append(file.Lines, Line{Number: number, Content: content})
+}
+
+func (c *Commit) ToCommitAuthor() horusec.CommitAuthor {
+	return horusec.CommitAuthor{
+		Author:     c.Author,
+		Email:      c.Email,
+		CommitHash: c.Hash,
+		Message:    c.Message,
+		Date:       c.Date,
+	}
+}
+
+func (c *Commit) getOrCreateFile(path string) *File {
+	if len(c.Files) > 0 && c.Files[len(c.Files)-1].Path == path {
+		return c.Files[len(c.Files)-1]
+	}
+
+	file := &File{Path: path}
+	c.Files = append(c.Files, file)
+	return file
+}
+
+// Content returns the added lines of file in the same line numbers of the file in the commit, lines not added by
+// the commit are empty, so the findings of engine keep the line of the file
+func (f *File) Content() []byte {
+	content := strings.Builder{}
+	currentLine := 1
+	for _, line := range f.Lines {
+		for ; currentLine < line.Number; currentLine++ {
+			content.WriteString("\n")
+		}
+
+		content.WriteString(line.Content + "\n")
+		currentLine++
+	}
+
+	return []byte(content.String())
+}
@@ -0,0 +1,59 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddLine(t *testing.T) {
+	t.Run("should group consecutive lines of same file", func(t *testing.T) {
+		commit := &Commit{}
+		commit.AddLine("config.env", 1, "USER=horusec")
+		commit.AddLine("config.env", 3, "TOKEN=secret")
+		commit.AddLine("main.go", 10, "package main")
+
+		assert.Len(t, commit.Files, 2)
+		assert.Len(t, commit.Files[0].Lines, 2)
+		assert.Equal(t, "main.go", commit.Files[1].Path)
+	})
+}
+
+func TestContent(t *testing.T) {
+	t.Run("should keep added lines in the same line numbers of file", func(t *testing.T) {
+		commit := &Commit{}
+		commit.AddLine("config.env", 2, "USER=horusec")
+		commit.AddLine("config.env", 3, "TOKEN=secret")
+		commit.AddLine("config.env", 5, "DEBUG=false")
+
+		assert.Equal(t, "\nUSER=horusec\nTOKEN=secret\n\nDEBUG=false\n", string(commit.Files[0].Content()))
+	})
+}
+
+func TestToCommitAuthor(t *testing.T) {
+	t.Run("should return commit author with commit data", func(t *testing.T) {
+		commit := &Commit{Hash: "abc123", Author: "horusec", Email: "horusec@zup.com.br", Date: "2021", Message: "add"}
+
+		commitAuthor := commit.ToCommitAuthor()
+
+		assert.Equal(t, "abc123", commitAuthor.CommitHash)
+		assert.Equal(t, "horusec", commitAuthor.Author)
+		assert.Equal(t, "horusec@zup.com.br", commitAuthor.Email)
+		assert.Equal(t, "2021", commitAuthor.Date)
+		assert.Equal(t, "add", commitAuthor.Message)
+	})
+}
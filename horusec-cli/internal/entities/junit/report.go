@@ -12,17 +12,15 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//nolint
-package gitleaks
+package junit
 
-const CMD = `
-		{{WORK_DIR}}
-        touch /tmp/results-ANALYSISID.json
-        gitleaks --config="/rules/rules.toml" --owner-path=. --verbose --pretty --report="/tmp/results-ANALYSISID.json" &> /tmp/errorGitleaks-ANALYSISID
-        if [ $? -eq 2 ]; then
-            echo 'ERROR_RUNNING_GITLEAKS'
-            cat /tmp/errorGitleaks-ANALYSISID
-        else
-            jq -j -M -c . /tmp/results-ANALYSISID.json
-        fi
-  `
+import "encoding/xml"
+
+type Report struct {
+	XMLName    xml.Name    `xml:"testsuites"`
+	Name       string      `xml:"name,attr"`
+	Tests      int         `xml:"tests,attr"`
+	Failures   int         `xml:"failures,attr"`
+	Skipped    int         `xml:"skipped,attr"`
+	TestSuites []TestSuite `xml:"testsuite"`
+}
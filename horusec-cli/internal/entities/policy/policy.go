@@ -0,0 +1,119 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const DefaultExitCode = 1
+
+type Policy struct {
+	Rules []Rule `json:"rules"`
+}
+
+// Rule is violated when the total of vulnerabilities matching all filters filled is greater than MaxCount
+type Rule struct {
+	Name     string            `json:"name"`
+	Severity severity.Severity `json:"severity"`
+	Tool     string            `json:"tool"`
+	Language string            `json:"language"`
+	OnlyNew  bool              `json:"onlyNew"`
+	MaxCount int               `json:"maxCount"`
+	ExitCode int               `json:"exitCode"`
+}
+
+type RuleResult struct {
+	Rule     Rule
+	Total    int
+	Violated bool
+}
+
+type Result struct {
+	Rules []RuleResult
+}
+
+type ViolationError struct {
+	ExitCode int
+	Rules    []string
+}
+
+func (p *Policy) Validate() error {
+	for index := range p.Rules {
+		if err := p.Rules[index].Validate(); err != nil {
+			return fmt.Errorf("rule %d: %w", index, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Policy) IsEmpty() bool {
+	return len(p.Rules) == 0
+}
+
+func (r *Rule) Validate() error {
+	return validation.ValidateStruct(r,
+		validation.Field(&r.Name, validation.Required),
+		validation.Field(&r.Severity, validation.In(severity.Values()...)),
+		validation.Field(&r.MaxCount, validation.Min(0)),
+		validation.Field(&r.ExitCode, validation.Min(0), validation.Max(255)),
+	)
+}
+
+func (r *Rule) GetExitCode() int {
+	if r.ExitCode == 0 {
+		return DefaultExitCode
+	}
+
+	return r.ExitCode
+}
+
+func (r *Result) IsViolated() bool {
+	for index := range r.Rules {
+		if r.Rules[index].Violated {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetViolationError return nil when no rule was violated, else the exit code is from the first rule violated
+func (r *Result) GetViolationError() error {
+	violation := &ViolationError{}
+	for index := range r.Rules {
+		if r.Rules[index].Violated {
+			if len(violation.Rules) == 0 {
+				violation.ExitCode = r.Rules[index].Rule.GetExitCode()
+			}
+			violation.Rules = append(violation.Rules, r.Rules[index].Rule.Name)
+		}
+	}
+
+	if len(violation.Rules) == 0 {
+		return nil
+	}
+
+	return violation
+}
+
+func (v *ViolationError) Error() string {
+	return fmt.Sprintf("analysis finished with policy violated by rules: %s", strings.Join(v.Rules, ", "))
+}
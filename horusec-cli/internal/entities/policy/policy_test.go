@@ -0,0 +1,84 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	t.Run("should return no error when policy is valid", func(t *testing.T) {
+		policy := &Policy{Rules: []Rule{{Name: "no-critical", Severity: severity.Critical, ExitCode: 10}}}
+
+		assert.NoError(t, policy.Validate())
+	})
+
+	t.Run("should return error when rule is invalid", func(t *testing.T) {
+		policy := &Policy{Rules: []Rule{{Name: "", Severity: "INVALID", MaxCount: -1, ExitCode: 256}}}
+
+		err := policy.Validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "rule 0:")
+	})
+}
+
+func TestIsEmpty(t *testing.T) {
+	t.Run("should return true when policy has no rules", func(t *testing.T) {
+		assert.True(t, (&Policy{}).IsEmpty())
+	})
+
+	t.Run("should return false when policy has rules", func(t *testing.T) {
+		assert.False(t, (&Policy{Rules: []Rule{{Name: "test"}}}).IsEmpty())
+	})
+}
+
+func TestGetExitCode(t *testing.T) {
+	t.Run("should return default exit code when not set", func(t *testing.T) {
+		assert.Equal(t, DefaultExitCode, (&Rule{}).GetExitCode())
+	})
+
+	t.Run("should return exit code configured", func(t *testing.T) {
+		assert.Equal(t, 10, (&Rule{ExitCode: 10}).GetExitCode())
+	})
+}
+
+func TestGetViolationError(t *testing.T) {
+	t.Run("should return nil when no rule was violated", func(t *testing.T) {
+		result := &Result{Rules: []RuleResult{{Rule: Rule{Name: "test"}}}}
+
+		assert.False(t, result.IsViolated())
+		assert.NoError(t, result.GetViolationError())
+	})
+
+	t.Run("should return exit code of first rule violated", func(t *testing.T) {
+		result := &Result{Rules: []RuleResult{
+			{Rule: Rule{Name: "first", ExitCode: 2}},
+			{Rule: Rule{Name: "second", ExitCode: 3}, Violated: true},
+			{Rule: Rule{Name: "third", ExitCode: 4}, Violated: true},
+		}}
+
+		err := result.GetViolationError()
+
+		var violation *ViolationError
+		assert.True(t, result.IsViolated())
+		assert.True(t, errors.As(err, &violation))
+		assert.Equal(t, 3, violation.ExitCode)
+		assert.Equal(t, "analysis finished with policy violated by rules: second, third", err.Error())
+	})
+}
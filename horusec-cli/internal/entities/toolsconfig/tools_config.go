@@ -11,7 +11,8 @@ import (
 type MapToolConfig map[tools.Tool]ToolConfig
 
 type ToolConfig struct {
-	IsToIgnore bool `json:"istoignore"`
+	IsToIgnore       bool  `json:"istoignore"`
+	TimeoutInSeconds int64 `json:"timeoutinseconds"`
 }
 
 type ToolsConfigsStruct struct {
@@ -36,6 +37,16 @@ type ToolsConfigsStruct struct {
 	PhpCS             ToolConfig `json:"phpcs"`
 	HorusecDart       ToolConfig `json:"horusecdart"`
 	ShellCheck        ToolConfig `json:"shellcheck"`
+	BundlerAudit      ToolConfig `json:"bundleraudit"`
+	MixAudit          ToolConfig `json:"mixaudit"`
+	Sobelow           ToolConfig `json:"sobelow"`
+	HorusecGo         ToolConfig `json:"horusecgo"`
+	HorusecPython     ToolConfig `json:"horusecpython"`
+	HorusecGitHistory ToolConfig `json:"horusecgithistory"`
+	HorusecDockerfile ToolConfig `json:"horusecdockerfile"`
+	HorusecHCL        ToolConfig `json:"horusechcl"`
+	HorusecRust       ToolConfig `json:"horusecrust"`
+	HorusecCustom     ToolConfig `json:"horuseccustom"`
 }
 
 //nolint:funlen parse struct is necessary > 15 lines
@@ -62,6 +73,16 @@ func (t *ToolsConfigsStruct) ToMap() MapToolConfig {
 		tools.PhpCS:             t.PhpCS,
 		tools.HorusecDart:       t.HorusecDart,
 		tools.ShellCheck:        t.ShellCheck,
+		tools.BundlerAudit:      t.BundlerAudit,
+		tools.MixAudit:          t.MixAudit,
+		tools.Sobelow:           t.Sobelow,
+		tools.HorusecGo:         t.HorusecGo,
+		tools.HorusecPython:     t.HorusecPython,
+		tools.HorusecGitHistory: t.HorusecGitHistory,
+		tools.HorusecDockerfile: t.HorusecDockerfile,
+		tools.HorusecHCL:        t.HorusecHCL,
+		tools.HorusecRust:       t.HorusecRust,
+		tools.HorusecCustom:     t.HorusecCustom,
 	}
 }
 
@@ -20,6 +20,9 @@ const (
 	Text      OutputType = "text"
 	JSON      OutputType = "json"
 	SonarQube OutputType = "sonarqube"
+	Sarif     OutputType = "sarif"
+	JUnit     OutputType = "junit"
+	HTML      OutputType = "html"
 )
 
 func (o OutputType) ToString() string {
@@ -44,4 +44,8 @@ const (
 	MsgDebugShowWorkdir   = "{HORUSEC_CLI} The workdir setup for run in path:"
 	MsgDebugToolIgnored   = "{HORUSEC_CLI} The tool was ignored for run in this analysis: "
 	MsgDebugVulnHashToFix = "{HORUSEC_CLI} Vulnerability Hash expected to be FIXED: "
+	// Fired when vulnerability was ignored because it is not in the lines changed against diff base
+	MsgDebugVulnerabilityOutOfDiff = "{HORUSEC_CLI} The vulnerability was ignored because it is out of diff base in file and line:"
+	// Fired when vulnerability was ignored because the tool was canceled by timeout before add it in analysis
+	MsgDebugVulnerabilityAfterToolCanceled = "{HORUSEC_CLI} The vulnerability was ignored because the tool was canceled:"
 )
@@ -18,6 +18,9 @@ const (
 	// Fired when occurs timeout on wait analysis Finish
 	MsgErrorTimeoutOccurs = "{HORUSEC_CLI} Some analysis was not completed due to the timeout, " +
 		"increase the time with -t flag and try again."
+	// Fired when a tool is canceled because it reached the timeout configured in tools config
+	MsgErrorToolTimeout = "{HORUSEC_CLI} Tool was not completed due to the timeout in tools config, " +
+		"increase the timeoutinseconds of tool and try again: "
 	// USED IN USE CASES: Fired when the project path is invalid
 	MsgErrorProjectPathNotValid = "project path is invalid: "
 	// USED IN USE CASES: Fired when an path of json is not valid in configs
@@ -96,6 +99,22 @@ const (
 	MsgErrorErrorOnReadConfigFile   = "{HORUSEC-CLI} Error on read config file on path: "
 	MsgErrorGemLockNotFound         = "{HORUSEC_CLI} Error It looks like your project doesn't have a gemfile.lock file, " +
 		"it would be a good idea to commit it so horusec can check for vulnerabilities"
-	MsgErrorFailedToPullImage        = "{HORUSEC_CLI} Failed to pull docker image"
-	MsgErrorWhileParsingCustomImages = "{HORUSEC_CLI} Error when parsing custom images config."
+	MsgErrorFailedToPullImage         = "{HORUSEC_CLI} Failed to pull docker image"
+	MsgErrorWhileParsingCustomImages  = "{HORUSEC_CLI} Error when parsing custom images config."
+	MsgErrorWhileParsingPolicy        = "{HORUSEC_CLI} Error when parsing policy config."
+	MsgErrorWhileParsingExternalTools = "{HORUSEC_CLI} Error when parsing external tools config."
+	// Fired when an unexpected error occurs when try write the baseline file
+	MsgErrorWriteBaselineFile = "{HORUSEC_CLI} Error when write baseline file: "
+	// Fired when an unexpected error occurs when try read the baseline file
+	MsgErrorReadBaselineFile = "{HORUSEC_CLI} Error when read baseline file: "
+	// USED IN USE CASES: Fired when the baseline file path is not valid in configs
+	MsgErrorBaselineFilePathNotValid = "Baseline file path is required or is invalid: "
+	// USED IN USE CASES: Fired when the policy rules are not valid in configs
+	MsgErrorPolicyNotValid = "Policy is invalid: "
+	// USED IN USE CASES: Fired when some external tool is not valid in configs
+	MsgErrorExternalToolNotValid = "External tool is invalid: "
+	// Fired when an unexpected error occurs when try get the files changed against the diff base
+	MsgErrorGitDiffExecute = "{HORUSEC_CLI} Error when get the files changed against the diff base: "
+	// Fired when an unexpected error occurs when try read a .horusecignore file
+	MsgErrorReadHorusecIgnoreFile = "{HORUSEC_CLI} Error when read .horusecignore file: "
 )
@@ -29,8 +29,18 @@ const (
 	MsgInfoConfigFilePath = "{HORUSEC_CLI} Using config file: "
 	// Fired when is setup to the output is sonarqube
 	MsgInfoStartGenerateSonarQubeFile = "{HORUSEC_CLI} Generating SonarQube output..."
+	// Fired when is setup to the output is sarif
+	MsgInfoStartGenerateSarifFile = "{HORUSEC_CLI} Generating SARIF output..."
+	// Fired when is setup to the output is junit
+	MsgInfoStartGenerateJUnitFile = "{HORUSEC_CLI} Generating JUnit XML output..."
+	// Fired when is setup to the output is html
+	MsgInfoStartGenerateHTMLFile = "{HORUSEC_CLI} Generating HTML report..."
 	// Fired when is setup to the output is sonarqube
 	MsgInfoStartWriteFile = "{HORUSEC_CLI} Writing output JSON to file in the path: "
+	// Fired when is setup to write the baseline file
+	MsgInfoStartWriteBaselineFile = "{HORUSEC_CLI} Writing baseline file in the path: "
+	// Fired when is setup to analyze only the files changed against a git reference
+	MsgInfoStartDiffAnalysis = "{HORUSEC_CLI} Analyzing only the files and lines changed against the git reference: "
 	// Fired when monitor log timeout
 	MsgInfoMonitorTimeoutIn = "Hold on! Horusec is still analyzing your code. Timeout in: "
 	// Fired in print results service when analysis is finished
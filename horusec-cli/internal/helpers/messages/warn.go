@@ -38,4 +38,6 @@ const (
 	MsgWarnInfoVulnerabilitiesDisabled = "{HORUSEC_CLI} Horusec not show info vulnerabilities in this analysis, " +
 		"to see info vulnerabilities add option \"--information-severity=true\". " +
 		"For more details use (horusec start --help) command."
+	// Fired when there are Cargo.lock files but no RustSec advisory database to check them
+	MsgWarnRustAdvisoryDatabaseNotConfigured = "{HORUSEC_CLI} Skipping the analysis of Cargo.lock files: "
 )
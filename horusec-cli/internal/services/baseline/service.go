@@ -0,0 +1,119 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseline
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	baselineEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/baseline"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+)
+
+type IService interface {
+	WriteBaseline(analysis *horusec.Analysis) error
+	GetBaselineHashes() ([]string, error)
+}
+
+type Service struct {
+	config cliConfig.IConfig
+}
+
+func NewBaselineService(config cliConfig.IConfig) IService {
+	return &Service{
+		config: config,
+	}
+}
+
+func (s *Service) WriteBaseline(analysis *horusec.Analysis) error {
+	bytesToWrite, err := json.MarshalIndent(s.newBaseline(analysis), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	logger.LogInfoWithLevel(messages.MsgInfoStartWriteBaselineFile + s.config.GetBaselineFilePath())
+	return ioutil.WriteFile(s.config.GetBaselineFilePath(), bytesToWrite, 0600)
+}
+
+func (s *Service) GetBaselineHashes() ([]string, error) {
+	if s.config.GetBaselineFilePath() == "" {
+		return []string{}, nil
+	}
+
+	baseline, err := s.openBaselineFile()
+	if err != nil {
+		return []string{}, err
+	}
+
+	return baseline.VulnHashes, nil
+}
+
+func (s *Service) openBaselineFile() (baseline *baselineEntities.Baseline, err error) {
+	file, err := os.Open(s.config.GetBaselineFilePath())
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		logger.LogErrorWithLevel(messages.MsgErrorDeferFileClose, file.Close())
+	}()
+
+	byteValue, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return baseline, json.Unmarshal(byteValue, &baseline)
+}
+
+func (s *Service) newBaseline(analysis *horusec.Analysis) *baselineEntities.Baseline {
+	return &baselineEntities.Baseline{
+		Version:        baselineEntities.Version,
+		AnalysisID:     analysis.ID,
+		RepositoryName: s.config.GetRepositoryName(),
+		CreatedAt:      time.Now(),
+		VulnHashes:     s.getVulnHashes(analysis),
+	}
+}
+
+func (s *Service) getVulnHashes(analysis *horusec.Analysis) []string {
+	hashes := []string{}
+	existing := map[string]bool{}
+	for index := range analysis.AnalysisVulnerabilities {
+		vulnHash := s.getVulnHash(&analysis.AnalysisVulnerabilities[index].Vulnerability)
+		if vulnHash != "" && !existing[vulnHash] {
+			existing[vulnHash] = true
+			hashes = append(hashes, vulnHash)
+		}
+	}
+
+	sort.Strings(hashes)
+	return hashes
+}
+
+// getVulnHash prefers the stable fingerprint so the baseline keeps matching when code moves between lines
+func (s *Service) getVulnHash(vulnerability *horusec.Vulnerability) string {
+	if vulnerability.StableVulnHash != "" {
+		return vulnerability.StableVulnHash
+	}
+
+	return vulnerability.VulnHash
+}
@@ -0,0 +1,71 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package baseline
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewBaselineService(t *testing.T) {
+	t.Run("should success create new service", func(t *testing.T) {
+		assert.NotNil(t, NewBaselineService(&cliConfig.Config{}))
+	})
+}
+
+func TestWriteBaselineAndGetBaselineHashes(t *testing.T) {
+	t.Run("should success write and read baseline file", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetBaselineFilePath("/tmp/horusec-baseline-test.json")
+
+		analysis := &horusec.Analysis{
+			ID: uuid.New(),
+			AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+				{Vulnerability: horusec.Vulnerability{VulnHash: "2"}},
+				{Vulnerability: horusec.Vulnerability{VulnHash: "1"}},
+				{Vulnerability: horusec.Vulnerability{VulnHash: "1"}},
+			},
+		}
+
+		service := NewBaselineService(config)
+		assert.NoError(t, service.WriteBaseline(analysis))
+
+		hashes, err := service.GetBaselineHashes()
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"1", "2"}, hashes)
+		assert.NoError(t, os.RemoveAll("/tmp/horusec-baseline-test.json"))
+	})
+
+	t.Run("should return empty hashes when baseline is not configured", func(t *testing.T) {
+		hashes, err := NewBaselineService(&cliConfig.Config{}).GetBaselineHashes()
+
+		assert.NoError(t, err)
+		assert.Empty(t, hashes)
+	})
+
+	t.Run("should return error when baseline file not exists", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetBaselineFilePath("/tmp/not-exists-horusec-baseline.json")
+
+		_, err := NewBaselineService(config).GetBaselineHashes()
+
+		assert.Error(t, err)
+	})
+}
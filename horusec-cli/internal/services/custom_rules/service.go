@@ -5,22 +5,29 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
 	customRulesEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/custom_rules"
+	"gopkg.in/yaml.v3"
 )
 
 type IService interface {
 	GetCustomRulesByTool(tool tools.Tool) []engine.Rule
+	GetCustomRules() map[tools.Tool][]engine.Rule
+	GetErrors() []error
 }
 
 type Service struct {
 	config            cliConfig.IConfig
 	customRulesByTool map[tools.Tool][]engine.Rule
+	errors            []error
 }
 
 func NewCustomRulesService(config cliConfig.IConfig) IService {
@@ -34,18 +41,29 @@ func NewCustomRulesService(config cliConfig.IConfig) IService {
 	return service
 }
 
+// GetCustomRulesByTool returns text rules for native tools, the rules of HorusecCustom are custom.Rule
 func (s *Service) GetCustomRulesByTool(tool tools.Tool) []engine.Rule {
 	return s.customRulesByTool[tool]
 }
 
+func (s *Service) GetCustomRules() map[tools.Tool][]engine.Rule {
+	return s.customRulesByTool
+}
+
+// GetErrors returns the errors of files and custom rules which could not be loaded, they are also logged
+func (s *Service) GetErrors() []error {
+	return s.errors
+}
+
 func (s *Service) setCustomRules() {
 	if s.config.GetCustomRulesPath() == "" {
 		return
 	}
 
-	customRules, err := s.openCustomRulesJSONFile()
+	customRules, err := s.openCustomRulesFiles()
 	if err != nil {
 		logger.LogError("{HORUSEC_CLI} failed to get custom rules: ", err)
+		s.errors = append(s.errors, err)
 	}
 
 	for index := range customRules {
@@ -57,22 +75,75 @@ func (s *Service) validateAndParseCustomRule(index int, customRules []customRule
 	if err := customRules[index].Validate(); err != nil {
 		errMsg := fmt.Sprintf("{HORUSEC_CLI} invalid custom rule: %s", customRules[index].ToString())
 		logger.LogError(errMsg, err)
+		s.errors = append(s.errors, fmt.Errorf("invalid custom rule %s: %w", customRules[index].ID, err))
 		return
 	}
 
-	s.customRulesByTool[customRules[index].Tool] = append(
-		s.customRulesByTool[customRules[index].Tool], s.parseCustomRuleToTextRule(index, customRules),
-	)
+	tool := customRules[index].GetTool()
+	s.customRulesByTool[tool] = append(s.customRulesByTool[tool], s.parseCustomRuleToEngineRule(index, customRules))
+}
+
+// openCustomRulesFiles reads the custom rules path as a single file, or all JSON and YAML files inside of it
+// when it is a folder. A file with errors in a folder is logged and does not prevent loading the others
+func (s *Service) openCustomRulesFiles() (customRules []customRulesEntities.CustomRule, err error) {
+	info, err := os.Stat(s.config.GetCustomRulesPath())
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return s.openCustomRulesFile(s.config.GetCustomRulesPath())
+	}
+
+	return customRules, filepath.Walk(s.config.GetCustomRulesPath(),
+		func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() || !s.isCustomRulesFile(path) {
+				return err
+			}
+
+			fileCustomRules, err := s.openCustomRulesFile(path)
+			if err != nil {
+				logger.LogError(fmt.Sprintf("{HORUSEC_CLI} failed to get custom rules of file %s: ", path), err)
+				s.errors = append(s.errors, fmt.Errorf("%s: %w", path, err))
+			}
+
+			customRules = append(customRules, fileCustomRules...)
+			return nil
+		})
 }
 
-func (s *Service) openCustomRulesJSONFile() (customRules []customRulesEntities.CustomRule, err error) {
-	file, err := os.Open(s.config.GetCustomRulesPath())
+func (s *Service) openCustomRulesFile(path string) (customRules []customRulesEntities.CustomRule, err error) {
+	content, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	byteValue, _ := ioutil.ReadAll(file)
-	return customRules, json.Unmarshal(byteValue, &customRules)
+	if s.isYAMLFile(path) {
+		return customRules, yaml.Unmarshal(content, &customRules)
+	}
+
+	return customRules, json.Unmarshal(content, &customRules)
+}
+
+func (s *Service) isCustomRulesFile(path string) bool {
+	return s.isYAMLFile(path) || strings.EqualFold(filepath.Ext(path), ".json")
+}
+
+func (s *Service) isYAMLFile(path string) bool {
+	extension := strings.ToLower(filepath.Ext(path))
+	return extension == ".yaml" || extension == ".yml"
+}
+
+func (s *Service) parseCustomRuleToEngineRule(index int, customRules []customRulesEntities.CustomRule) engine.Rule {
+	if customRules[index].GetTool() != tools.HorusecCustom {
+		return s.parseCustomRuleToTextRule(index, customRules)
+	}
+
+	return custom.Rule{
+		TextRule: s.parseCustomRuleToTextRule(index, customRules),
+		Language: customRules[index].GetLanguage(),
+		Files:    customRules[index].Files,
+	}
 }
 
 func (s *Service) parseCustomRuleToTextRule(index int, customRules []customRulesEntities.CustomRule) text.TextRule {
@@ -97,5 +168,11 @@ func (s *Service) mapCustomRulesByTools() {
 		tools.HorusecKotlin:     {},
 		tools.HorusecNodejs:     {},
 		tools.HorusecJava:       {},
+		tools.HorusecGo:         {},
+		tools.HorusecPython:     {},
+		tools.HorusecDockerfile: {},
+		tools.HorusecHCL:        {},
+		tools.HorusecDart:       {},
+		tools.HorusecCustom:     {},
 	}
 }
@@ -1,8 +1,13 @@
 package customrules
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 
 	"github.com/stretchr/testify/assert"
@@ -49,5 +54,46 @@ func TestGetCustomRulesByTool(t *testing.T) {
 		rules := service.GetCustomRulesByTool(tools.HorusecCsharp)
 
 		assert.Len(t, rules, 0)
+		assert.NotEmpty(t, service.GetErrors())
+	})
+}
+
+func TestGetCustomRulesByToolFromYAMLAndFolder(t *testing.T) {
+	t.Run("should get rules of yaml file by language and files", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetCustomRulesPath("./custom_rules_example.yaml")
+
+		service := NewCustomRulesService(config)
+
+		assert.Len(t, service.GetCustomRulesByTool(tools.HorusecGo), 1)
+		rules := service.GetCustomRulesByTool(tools.HorusecCustom)
+		assert.Len(t, rules, 2)
+		assert.Equal(t, languages.HCL, rules[0].(custom.Rule).Language)
+		assert.Equal(t, []string{"*.tf"}, rules[0].(custom.Rule).Files)
+		assert.Equal(t, languages.Ruby, rules[1].(custom.Rule).Language)
+		assert.Empty(t, service.GetCustomRulesByTool(tools.HorusecHCL))
+	})
+
+	t.Run("should get rules of all json and yaml files of folder", func(t *testing.T) {
+		path := t.TempDir()
+		for _, name := range []string{"custom_rules_example.json", "custom_rules_example.yaml"} {
+			content, err := ioutil.ReadFile(name)
+			assert.NoError(t, err)
+			assert.NoError(t, os.MkdirAll(filepath.Join(path, "rules"), os.ModePerm))
+			assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "rules", name), content, os.ModePerm))
+		}
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "invalid.yml"), []byte("id: ["), os.ModePerm))
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "README.md"), []byte("# rules"), os.ModePerm))
+
+		config := &cliConfig.Config{}
+		config.SetCustomRulesPath(path)
+
+		service := NewCustomRulesService(config)
+
+		assert.Len(t, service.GetCustomRulesByTool(tools.HorusecCsharp), 1)
+		assert.Len(t, service.GetCustomRulesByTool(tools.HorusecGo), 1)
+		assert.Len(t, service.GetCustomRulesByTool(tools.HorusecCustom), 2)
+		assert.Len(t, service.GetCustomRules()[tools.HorusecCustom], 2)
+		assert.Len(t, service.GetErrors(), 1)
 	})
 }
@@ -42,7 +42,8 @@ import (
 )
 
 type Interface interface {
-	CreateLanguageAnalysisContainer(data *dockerEntities.AnalysisData) (containerOutPut string, err error)
+	CreateLanguageAnalysisContainer(ctx goContext.Context, data *dockerEntities.AnalysisData) (
+		containerOutPut string, err error)
 	PullImage(imageWithTagAndRegistry string) error
 	DeleteContainersFromAPI()
 }
@@ -65,12 +66,14 @@ func NewDockerAPI(docker dockerService.Interface, config cliConfig.IConfig, anal
 	}
 }
 
-func (d *API) CreateLanguageAnalysisContainer(data *dockerEntities.AnalysisData) (containerOutPut string, err error) {
+// CreateLanguageAnalysisContainer the container is removed when the context is done before it finishes
+func (d *API) CreateLanguageAnalysisContainer(ctx goContext.Context, data *dockerEntities.AnalysisData) (
+	containerOutPut string, err error) {
 	if data.IsInvalid() {
 		return "", enumErrors.ErrImageTagCmdRequired
 	}
 
-	return d.logStatusAndExecuteCRDContainer(data.GetCustomOrDefaultImage(), d.replaceCMDAnalysisID(data.CMD))
+	return d.logStatusAndExecuteCRDContainer(ctx, data.GetCustomOrDefaultImage(), d.replaceCMDAnalysisID(data.CMD))
 }
 
 func (d *API) PullImage(imageWithTagAndRegistry string) error {
@@ -141,8 +144,9 @@ func (d *API) replaceCMDAnalysisID(cmd string) string {
 	return strings.ReplaceAll(cmd, "ANALYSISID", d.analysisID.String())
 }
 
-func (d *API) logStatusAndExecuteCRDContainer(imageNameWithTag, cmd string) (containerOutput string, err error) {
-	containerOutput, err = d.executeCRDContainer(imageNameWithTag, cmd)
+func (d *API) logStatusAndExecuteCRDContainer(ctx goContext.Context, imageNameWithTag, cmd string) (
+	containerOutput string, err error) {
+	containerOutput, err = d.executeCRDContainer(ctx, imageNameWithTag, cmd)
 	if err != nil {
 		d.loggerAPIStatus(messages.MsgDebugDockerAPIFinishedError, imageNameWithTag)
 		return "", err
@@ -152,35 +156,43 @@ func (d *API) logStatusAndExecuteCRDContainer(imageNameWithTag, cmd string) (con
 	return containerOutput, nil
 }
 
-func (d *API) executeCRDContainer(imageNameWithTag, cmd string) (containerOutput string, err error) {
-	containerID, err := d.createContainer(imageNameWithTag, cmd)
+func (d *API) executeCRDContainer(ctx goContext.Context, imageNameWithTag, cmd string) (
+	containerOutput string, err error) {
+	containerID, err := d.createContainer(ctx, imageNameWithTag, cmd)
 	if err != nil {
 		return "", err
 	}
 
-	containerOutput, err = d.readContainer(containerID)
+	containerOutput, err = d.readContainer(ctx, containerID)
 	d.loggerAPIStatus(messages.MsgDebugDockerAPIContainerRead, imageNameWithTag)
 
-	time.Sleep(5 * time.Second)
+	d.waitBeforeRemove(ctx)
 	d.removeContainer(containerID)
 	return containerOutput, err
 }
 
+func (d *API) waitBeforeRemove(ctx goContext.Context) {
+	select {
+	case <-time.After(5 * time.Second):
+	case <-ctx.Done():
+	}
+}
+
 func (d *API) removeContainer(containerID string) {
 	err := d.dockerClient.ContainerRemove(d.ctx,
 		containerID, dockerTypes.ContainerRemoveOptions{Force: true})
 	logger.LogErrorWithLevel(messages.MsgErrorDockerRemoveContainer, err)
 }
 
-func (d *API) createContainer(imageNameWithTag, cmd string) (string, error) {
+func (d *API) createContainer(ctx goContext.Context, imageNameWithTag, cmd string) (string, error) {
 	config, host := d.getConfigAndHostToCreateContainer(imageNameWithTag, cmd)
-	response, err := d.dockerClient.ContainerCreate(d.ctx, config, host, nil, nil, d.getImageID())
+	response, err := d.dockerClient.ContainerCreate(ctx, config, host, nil, nil, d.getImageID())
 	if err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorDockerCreateContainer, err)
 		return "", err
 	}
 
-	if err = d.dockerClient.ContainerStart(d.ctx, response.ID, dockerTypes.ContainerStartOptions{}); err != nil {
+	if err = d.dockerClient.ContainerStart(ctx, response.ID, dockerTypes.ContainerStartOptions{}); err != nil {
 		logger.LogErrorWithLevel(messages.MsgErrorDockerStartContainer, err)
 		return "", err
 	}
@@ -193,17 +205,12 @@ func (d *API) getImageID() string {
 	return fmt.Sprintf("%s-%s", d.analysisID.String(), uuid.New().String())
 }
 
-func (d *API) readContainer(containerID string) (string, error) {
+func (d *API) readContainer(ctx goContext.Context, containerID string) (string, error) {
 	d.loggerAPIStatusWithContainerID(messages.MsgDebugDockerAPIContainerWait, "", containerID)
-	chanContainerStatus, _ := d.dockerClient.ContainerWait(d.ctx, containerID, "")
-	if containerWaitStatus := <-chanContainerStatus; containerWaitStatus.Error != nil {
-		message := fmt.Sprintf("Error on wait container %s: %s | Exited with status %s",
-			containerID, containerWaitStatus.Error.Message,
-			strconv.Itoa(int(containerWaitStatus.StatusCode)),
-		)
-		return "", errors.New(message)
+	if err := d.waitContainer(ctx, containerID); err != nil {
+		return "", err
 	}
-	containerOutput, err := d.dockerClient.ContainerLogs(d.ctx, containerID,
+	containerOutput, err := d.dockerClient.ContainerLogs(ctx, containerID,
 		dockerTypes.ContainerLogsOptions{ShowStdout: true})
 	if err != nil {
 		return "", err
@@ -211,6 +218,28 @@ func (d *API) readContainer(containerID string) (string, error) {
 	return d.getOutputString(containerOutput)
 }
 
+func (d *API) waitContainer(ctx goContext.Context, containerID string) error {
+	chanContainerStatus, chanErr := d.dockerClient.ContainerWait(ctx, containerID, "")
+	for {
+		select {
+		case containerWaitStatus := <-chanContainerStatus:
+			if containerWaitStatus.Error != nil {
+				message := fmt.Sprintf("Error on wait container %s: %s | Exited with status %s",
+					containerID, containerWaitStatus.Error.Message,
+					strconv.Itoa(int(containerWaitStatus.StatusCode)),
+				)
+				return errors.New(message)
+			}
+			return nil
+		case err := <-chanErr:
+			if err != nil {
+				return err
+			}
+			chanErr = nil
+		}
+	}
+}
+
 func (d *API) getOutputString(containerOutPut io.Reader) (string, error) {
 	containerOutPutBytes, err := ioutil.ReadAll(containerOutPut)
 	if err != nil {
@@ -15,6 +15,8 @@
 package docker
 
 import (
+	"context"
+
 	utilsMock "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/stretchr/testify/mock"
@@ -24,7 +26,8 @@ type Mock struct {
 	mock.Mock
 }
 
-func (m *Mock) CreateLanguageAnalysisContainer(_ *dockerEntities.AnalysisData) (containerOutPut string, err error) {
+func (m *Mock) CreateLanguageAnalysisContainer(_ context.Context, _ *dockerEntities.AnalysisData) (
+	containerOutPut string, err error) {
 	args := m.MethodCalled("CreateLanguageAnalysisContainer")
 	return args.Get(0).(string), utilsMock.ReturnNilOrError(args, 1)
 }
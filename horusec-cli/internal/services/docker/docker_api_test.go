@@ -63,7 +63,7 @@ const (
 func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 	t.Run("Should return return error when ImagePath is empty", func(t *testing.T) {
 		api := NewDockerAPI(client.NewDockerClient(), &cliConfig.Config{}, uuid.New())
-		_, err := api.CreateLanguageAnalysisContainer(&dockerEntities.AnalysisData{
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), &dockerEntities.AnalysisData{
 			DefaultImage: "",
 			CMD:          "cmd",
 		})
@@ -73,7 +73,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 
 	t.Run("Should return return error when cmd is empty", func(t *testing.T) {
 		api := NewDockerAPI(client.NewDockerClient(), &cliConfig.Config{}, uuid.New())
-		_, err := api.CreateLanguageAnalysisContainer(&dockerEntities.AnalysisData{
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), &dockerEntities.AnalysisData{
 			DefaultImage: "image",
 			CMD:          "",
 		})
@@ -83,7 +83,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 
 	t.Run("Should return error when pull image aleatory", func(t *testing.T) {
 		api := NewDockerAPI(client.NewDockerClient(), &cliConfig.Config{}, uuid.New())
-		_, err := api.CreateLanguageAnalysisContainer(&dockerEntities.AnalysisData{
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), &dockerEntities.AnalysisData{
 			DefaultImage: "john:doe",
 			CMD:          "command",
 		})
@@ -93,7 +93,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 
 	t.Run("Should create valid canonical image path", func(t *testing.T) {
 		api := NewDockerAPI(client.NewDockerClient(), &cliConfig.Config{}, uuid.New())
-		_, err := api.CreateLanguageAnalysisContainer(&dockerEntities.AnalysisData{
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), &dockerEntities.AnalysisData{
 			DefaultImage: "docker.io/dockercloud/hello-world:latest",
 			CMD:          "cmd",
 		})
@@ -140,7 +140,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 			CMD: Cmd,
 		}
 		ad.SetData("", Image)
-		_, err := api.CreateLanguageAnalysisContainer(ad)
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), ad)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrGeneric, err)
@@ -161,7 +161,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 			CMD: Cmd,
 		}
 		ad.SetData("", Image)
-		_, err := api.CreateLanguageAnalysisContainer(ad)
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), ad)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrGeneric, err)
@@ -189,7 +189,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 			CMD: Cmd,
 		}
 		ad.SetData("", Image)
-		_, err := api.CreateLanguageAnalysisContainer(ad)
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), ad)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), ErrGeneric.Error())
@@ -214,7 +214,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 			CMD: Cmd,
 		}
 		ad.SetData("", Image)
-		_, err := api.CreateLanguageAnalysisContainer(ad)
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), ad)
 
 		assert.Error(t, err)
 		assert.Equal(t, ErrGeneric, err)
@@ -239,7 +239,7 @@ func TestDockerAPI_CreateLanguageAnalysisContainer(t *testing.T) {
 			CMD: Cmd,
 		}
 		ad.SetData("", Image)
-		_, err := api.CreateLanguageAnalysisContainer(ad)
+		_, err := api.CreateLanguageAnalysisContainer(goContext.Background(), ad)
 
 		assert.NoError(t, err)
 	})
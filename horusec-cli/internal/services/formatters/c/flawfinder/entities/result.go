@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
 )
 
 type Result struct {
@@ -31,12 +32,21 @@ type Result struct {
 	Suggestion string `json:"suggestion"`
 	Note       string `json:"note"`
 	Context    string `json:"context"`
+	CWEs       string `json:"cwes"`
+	Name       string `json:"name"`
+	RuleID     string `json:"ruleId" csv:"RuleId"`
+	HelpURI    string `json:"helpUri" csv:"HelpUri"`
 }
 
 func (r *Result) GetDetails() string {
 	return fmt.Sprintf("%s %s %s", r.Warning, r.Suggestion, r.Note)
 }
 
+// GetCWEs returns the cwes informed by flawfinder as "CWE-120, CWE-20" or "CWE-120!/CWE-20"
+func (r *Result) GetCWEs() []string {
+	return classification.GetCWEsFromText(r.CWEs)
+}
+
 func (r *Result) GetSeverity() severity.Severity {
 	level, _ := strconv.Atoi(r.Level)
 	return r.mapSeverityByLevels()[level]
@@ -71,3 +71,11 @@ func TestGetFilename(t *testing.T) {
 	})
 
 }
+
+func TestGetCWEs(t *testing.T) {
+	t.Run("should success get cwes", func(t *testing.T) {
+		result := &Result{CWEs: "CWE-119!/CWE-120, CWE-20"}
+
+		assert.Equal(t, []string{"CWE-119", "CWE-120", "CWE-20"}, result.GetCWEs())
+	})
+}
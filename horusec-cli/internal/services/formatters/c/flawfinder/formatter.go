@@ -46,7 +46,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startFlawfinder(projectSubPath), tools.Flawfinder, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Flawfinder)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startFlawfinder(projectSubPath string) error {
@@ -83,6 +82,7 @@ func (f *Formatter) parseOutput(output string) error {
 	return nil
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilityData(results []flawfinderEntities.Result, index int) *horusec.Vulnerability {
 	vulnerability := f.getDefaultVulnerabilitySeverity()
 	vulnerability.Severity = results[index].GetSeverity()
@@ -91,6 +91,9 @@ func (f *Formatter) setVulnerabilityData(results []flawfinderEntities.Result, in
 	vulnerability.Column = results[index].Column
 	vulnerability.Code = f.GetCodeWithMaxCharacters(results[index].Context, 0)
 	vulnerability.File = results[index].GetFilename()
+	vulnerability.SetClassification(results[index].GetCWEs()...)
+	vulnerability.SetRule(results[index].RuleID, results[index].Name)
+	vulnerability.SetRemediation(results[index].Suggestion, results[index].HelpURI)
 	vulnerability = hash.Bind(vulnerability)
 	return f.SetCommitAuthor(vulnerability)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -62,7 +63,7 @@ func TestStartCFlawfinder(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -71,6 +72,33 @@ func TestStartCFlawfinder(t *testing.T) {
 		assert.Len(t, analysis.AnalysisVulnerabilities, 11)
 	})
 
+	t.Run("should set rule and remediation of output", func(t *testing.T) {
+		dockerAPIControllerMock := &docker.Mock{}
+		analysis := &horusec.Analysis{}
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+
+		output := "File,Line,Column,DefaultLevel,Level,Category,Name,Warning,Suggestion,Note,CWEs,Context,Fingerprint," +
+			"ToolVersion,RuleId,HelpUri\n" +
+			"./test.c,16,9,4,4,buffer,strcpy,Does not check for buffer overflows when copying to destination," +
+			"\"Consider using snprintf, strcpy_s, or strlcpy (warning: strncpy easily misused)\",,CWE-120," +
+			"\"  strcpy(b, a);\",6a5bb383fb44030b0d9428b17359e94ba3979bc1ce702be450427f85592c649a,2.0.15,FF1001," +
+			"https://cwe.mitre.org/data/definitions/120.html\n"
+
+		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
+
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "FF1001", vulnerability.RuleID)
+		assert.Equal(t, "strcpy", vulnerability.Title)
+		assert.Equal(t, "Consider using snprintf, strcpy_s, or strlcpy (warning: strncpy easily misused)",
+			vulnerability.Remediation)
+		assert.Equal(t, pq.StringArray{"https://cwe.mitre.org/data/definitions/120.html"}, vulnerability.References)
+	})
+
 	t.Run("should return error when invalid output", func(t *testing.T) {
 		dockerAPIControllerMock := &docker.Mock{}
 		analysis := &horusec.Analysis{}
@@ -81,7 +109,7 @@ func TestStartCFlawfinder(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		assert.NotPanics(t, func() {
@@ -97,7 +125,7 @@ func TestStartCFlawfinder(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("", errors.New("test"))
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		assert.NotPanics(t, func() {
@@ -112,7 +140,7 @@ func TestStartCFlawfinder(t *testing.T) {
 		config.SetWorkDir(&workdir.WorkDir{})
 		config.SetToolsToIgnore([]string{"flawfinder"})
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
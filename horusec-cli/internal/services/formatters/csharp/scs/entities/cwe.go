@@ -0,0 +1,64 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters/csharp/scs/severities"
+)
+
+// nolint:funlen mapping table of rules is necessary more 15 lines
+func cwesByErrorID() map[string][]string {
+	return map[string][]string{
+		severities.CommandInjection:                                        {"78"},
+		severities.SQLInjectionLINQ:                                        {"89"},
+		severities.XPathInjection:                                          {"643"},
+		severities.CertificateValidationDisabled:                           {"295"},
+		severities.WeakRandomNumberGenerator:                               {"338"},
+		severities.WeakHashingFunction:                                     {"328"},
+		severities.XMLExternalEntityInjectionXXE:                           {"611"},
+		severities.CookieWithoutSSLFlag:                                    {"614"},
+		severities.CookieWithoutHTTPOnlyFlag:                               {"1004"},
+		severities.WeakCipherAlgorithm:                                     {"327"},
+		severities.WeakCBCMode:                                             {"327"},
+		severities.WeakECBMode:                                             {"327"},
+		severities.WeakCipherMode:                                          {"327"},
+		severities.SQLInjectionWebControls:                                 {"89"},
+		severities.HardcodedPassword:                                       {"259"},
+		severities.CrossSiteRequestForgeryCSRF:                             {"352"},
+		severities.RequestValidationDisabledAttribute:                      {"20"},
+		severities.PathTraversal:                                           {"22"},
+		severities.OutputCacheConflict:                                     {"524"},
+		severities.SQLInjectionOLEDB:                                       {"89"},
+		severities.RequestValidationDisabledConfigurationFile:              {"20"},
+		severities.EventValidationDisabled:                                 {"20"},
+		severities.ViewStateNotEncrypted:                                   {"311"},
+		severities.ViewStateMACDisabled:                                    {"642"},
+		severities.SQLInjectionODBC:                                        {"89"},
+		severities.SQLInjectionMsSQLDataProvider:                           {"89"},
+		severities.OpenRedirect:                                            {"601"},
+		severities.InsecureDeserialization:                                 {"502"},
+		severities.CrossSiteScriptingXSS:                                   {"79"},
+		severities.RequestValidationIsEnabledOnlyForPagesConfigurationFile: {"20"},
+		severities.LDAPInjection:                                           {"90"},
+		severities.PasswordRequiredLengthTooSmall:                          {"521"},
+		severities.PasswordComplexity:                                      {"521"},
+		severities.PasswordRequiredLengthNotSet:                            {"521"},
+		severities.SQLInjectionEntityFramework:                             {"89"},
+		severities.SQLInjectionEnterpriseLibraryData:                       {"89"},
+		severities.SQLInjectionNHibernate:                                  {"89"},
+		severities.CQLInjectionCassandra:                                   {"89"},
+		severities.SQLInjectionNPGSQL:                                      {"89"},
+	}
+}
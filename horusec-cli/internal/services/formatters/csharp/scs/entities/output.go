@@ -84,6 +84,19 @@ func (s *ScsResult) GetSeverity() severity.Severity {
 	return s.getVulnerabilityMap()[s.ErrorID]
 }
 
+func (s *ScsResult) GetCWEs() []string {
+	return cwesByErrorID()[s.ErrorID]
+}
+
+// GetReference returns the documentation of the rule in the security code scan site, where the remediation is described
+func (s *ScsResult) GetReference() string {
+	if s.ErrorID == "" {
+		return ""
+	}
+
+	return "https://security-code-scan.github.io/#" + s.ErrorID
+}
+
 func (s *ScsResult) getVulnerabilityMap() map[string]severity.Severity {
 	values := map[string]severity.Severity{}
 
@@ -201,3 +201,27 @@ func TestGetFilename(t *testing.T) {
 		assert.Empty(t, output.GetFilename())
 	})
 }
+
+func TestGetCWEs(t *testing.T) {
+	t.Run("should return cwes mapped by error id", func(t *testing.T) {
+		output := ScsResult{ErrorID: "SCS0002"}
+		assert.Equal(t, []string{"89"}, output.GetCWEs())
+	})
+
+	t.Run("should return empty when error id is not mapped", func(t *testing.T) {
+		output := ScsResult{ErrorID: "SCS9999"}
+		assert.Empty(t, output.GetCWEs())
+	})
+}
+
+func TestGetReference(t *testing.T) {
+	t.Run("should return documentation of the error id", func(t *testing.T) {
+		output := ScsResult{ErrorID: "SCS0002"}
+		assert.Equal(t, "https://security-code-scan.github.io/#SCS0002", output.GetReference())
+	})
+
+	t.Run("should return empty when error id is empty", func(t *testing.T) {
+		output := ScsResult{}
+		assert.Empty(t, output.GetReference())
+	})
+}
@@ -50,7 +50,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startSecurityCodeScan(projectSubPath), tools.SecurityCodeScan, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.SecurityCodeScan)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startSecurityCodeScan(projectSubPath string) error {
@@ -103,6 +102,7 @@ func (f *Formatter) removeDuplicatedOutputs(scsResults []entities.ScsResult) []e
 	return scsResults[0 : len(scsResults)/2]
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilitySeverityData(scsResult entities.ScsResult) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilitySeverity()
 	data.Severity = scsResult.GetSeverity()
@@ -110,6 +110,9 @@ func (f *Formatter) setVulnerabilitySeverityData(scsResult entities.ScsResult) *
 	data.Line = scsResult.GetLine()
 	data.Column = scsResult.GetColumn()
 	data.File = f.GetFilepathFromFilename(scsResult.GetFilename())
+	data.SetClassification(scsResult.GetCWEs()...)
+	data.SetRule(scsResult.ErrorID, "")
+	data.SetRemediation("", scsResult.GetReference())
 	data = hash.Bind(data)
 	return f.SetCommitAuthor(data)
 }
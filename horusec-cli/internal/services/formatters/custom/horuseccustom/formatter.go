@@ -0,0 +1,74 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horuseccustom
+
+import (
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+type Formatter struct {
+	formatters.IService
+	custom.Interface
+}
+
+func NewFormatter(service formatters.IService) formatters.IFormatter {
+	return &Formatter{
+		service,
+		custom.NewEngine(),
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	if f.ToolIsToIgnore(tools.HorusecCustom) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored + tools.HorusecCustom.ToString())
+		return
+	}
+
+	f.SetAnalysisError(f.execEngineAndParseResults(projectSubPath), tools.HorusecCustom, projectSubPath)
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecCustom)
+}
+
+// execEngineAndParseResults runs the custom rules without a native engine, the findings are parsed by language
+// because each rule can target a different language
+func (f *Formatter) execEngineAndParseResults(projectSubPath string) error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.HorusecCustom)
+
+	findingsByLanguage, err := f.Run(f.GetProjectPathWithWorkdir(projectSubPath), f.getCustomRules())
+	if err != nil {
+		return err
+	}
+
+	for language, findings := range findingsByLanguage {
+		if err := f.ParseFindingsToVulnerabilities(findings, tools.HorusecCustom, language); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *Formatter) getCustomRules() (rules []custom.Rule) {
+	for _, rule := range f.GetCustomRulesByTool(tools.HorusecCustom) {
+		if customRule, ok := rule.(custom.Rule); ok {
+			rules = append(rules, customRule)
+		}
+	}
+
+	return rules
+}
@@ -0,0 +1,110 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horuseccustom
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+func getRules() []engine.Rule {
+	return []engine.Rule{
+		custom.Rule{
+			TextRule: text.TextRule{
+				Metadata:    engine.Metadata{ID: "TF-1", Name: "Public bucket"},
+				Type:        text.Regular,
+				Expressions: []*regexp.Regexp{regexp.MustCompile(`public-read`)},
+			},
+			Language: languages.HCL,
+			Files:    []string{"*.tf"},
+		},
+		text.TextRule{Metadata: engine.Metadata{ID: "ignored"}},
+	}
+}
+
+func createProject(t *testing.T) string {
+	path := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(path, "infra"), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(path, "infra", "main.tf"),
+		[]byte("resource \"aws_s3_bucket\" \"b\" {\n  acl = \"public-read\"\n}\n"), os.ModePerm))
+
+	return path
+}
+
+func TestStartAnalysis(t *testing.T) {
+	t.Run("should success execute analysis without errors", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("GetProjectPathWithWorkdir").Return(createProject(t))
+		service.On("ParseFindingsToVulnerabilities").Return(nil)
+		service.On("GetCustomRulesByTool").Return(getRules())
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+
+		assert.Empty(t, len(analysis.Errors))
+		service.AssertNumberOfCalls(t, "ParseFindingsToVulnerabilities", 1)
+	})
+
+	t.Run("should find vulnerabilities only with custom rules of files", func(t *testing.T) {
+		service := &formatters.Mock{}
+		service.On("GetCustomRulesByTool").Return(getRules())
+
+		formatter := NewFormatter(service).(*Formatter)
+		rules := formatter.getCustomRules()
+		findings, err := formatter.Run(createProject(t), rules)
+
+		assert.NoError(t, err)
+		assert.Len(t, rules, 1)
+		assert.Len(t, findings[languages.HCL], 1)
+		assert.Equal(t, "TF-1", findings[languages.HCL][0].ID)
+	})
+
+	t.Run("should return error when project path not exists", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("GetProjectPathWithWorkdir").Return("!!!")
+		service.On("GetCustomRulesByTool").Return(getRules())
+
+		assert.Error(t, NewFormatter(service).(*Formatter).execEngineAndParseResults(""))
+	})
+
+	t.Run("should ignore this tool", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("ToolIsToIgnore").Return(true)
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+}
@@ -45,7 +45,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.execEngineAndParseResults(projectSubPath), tools.HorusecDart, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecDart)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) execEngineAndParseResults(projectSubPath string) error {
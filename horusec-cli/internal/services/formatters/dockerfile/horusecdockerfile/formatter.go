@@ -0,0 +1,79 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/dockerfile"
+	engineenums "github.com/ZupIT/horusec/development-kit/pkg/enums/engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+type Formatter struct {
+	formatters.IService
+	dockerfile.Interface
+}
+
+func NewFormatter(service formatters.IService) formatters.IFormatter {
+	return &Formatter{
+		service,
+		dockerfile.NewRules(),
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	if f.ToolIsToIgnore(tools.HorusecDockerfile) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored + tools.HorusecDockerfile.ToString())
+		return
+	}
+
+	f.SetAnalysisError(f.execEngineAndParseResults(projectSubPath), tools.HorusecDockerfile, projectSubPath)
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecDockerfile)
+}
+
+func (f *Formatter) execEngineAndParseResults(projectSubPath string) error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.HorusecDockerfile)
+
+	findings, err := f.execEngineAnalysis(projectSubPath)
+	if err != nil {
+		return err
+	}
+
+	return f.ParseFindingsToVulnerabilities(findings, tools.HorusecDockerfile, languages.Dockerfile)
+}
+
+// execEngineAnalysis runs dockerfile and compose rules only in its own files, because rules of missing
+// instructions like USER and HEALTHCHECK would always be found in compose files
+func (f *Formatter) execEngineAnalysis(projectSubPath string) ([]engine.Finding, error) {
+	projectPath := f.GetProjectPathWithWorkdir(projectSubPath)
+	dockerfileUnits, err := f.GetDockerfileTextUnits(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	composeUnits, err := f.GetDockerComposeTextUnits(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	dockerfileRules := append(f.GetDockerfileRules(), f.GetCustomRulesByTool(tools.HorusecDockerfile)...)
+	findings := engine.RunMaxUnitsByAnalysis(dockerfileUnits, dockerfileRules, engineenums.DefaultMaxUnitsPerAnalysis)
+	return append(findings, engine.RunMaxUnitsByAnalysis(composeUnits, f.GetDockerComposeRules(),
+		engineenums.DefaultMaxUnitsPerAnalysis)...), nil
+}
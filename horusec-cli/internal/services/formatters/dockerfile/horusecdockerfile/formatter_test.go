@@ -0,0 +1,89 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecdockerfile
+
+import (
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartAnalysis(t *testing.T) {
+	t.Run("should success execute analysis without errors", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("GetProjectPathWithWorkdir").Return(".")
+		service.On("ParseFindingsToVulnerabilities").Return(nil)
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{})
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+
+		assert.Empty(t, len(analysis.Errors))
+	})
+
+	t.Run("should find vulnerabilities of dockerfile and compose files of example", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("GetProjectPathWithWorkdir").Return("../../../../../../examples/dockerfile/example1")
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{})
+
+		findings, err := NewFormatter(service).(*Formatter).execEngineAnalysis("")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, findings)
+
+		files := map[string]bool{}
+		for index := range findings {
+			files[filepath.Base(findings[index].SourceLocation.Filename)] = true
+		}
+		assert.True(t, files["Dockerfile"])
+		assert.True(t, files["docker-compose.yml"])
+	})
+
+	t.Run("should return error when getting text unit", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("GetProjectPathWithWorkdir").Return("!!!")
+		service.On("ParseFindingsToVulnerabilities").Return(nil)
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{})
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+
+	t.Run("should ignore this tool", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("ToolIsToIgnore").Return(true)
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+}
@@ -14,8 +14,37 @@
 
 package entities
 
+import (
+	"fmt"
+	"strings"
+)
+
+const cvePrefix = "CVE-"
+
 type Advisory struct {
-	Description string `json:"description"`
-	Package     string `json:"package"`
-	Title       string `json:"title"`
+	Description     string   `json:"description"`
+	Package         string   `json:"package"`
+	Title           string   `json:"title"`
+	ID              string   `json:"id"`
+	CVE             string   `json:"cve"`
+	URL             string   `json:"url"`
+	PatchedVersions []string `json:"patched_versions"`
+}
+
+// GetRuleID returns the cve of the advisory, mix audit informs it without prefix, or the id of advisory when
+// there is no cve
+func (a *Advisory) GetRuleID() string {
+	if a.CVE == "" {
+		return a.ID
+	}
+
+	return cvePrefix + strings.TrimPrefix(a.CVE, cvePrefix)
+}
+
+func (a *Advisory) GetRemediation() string {
+	if len(a.PatchedVersions) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("Update %s to a patched version: %s.", a.Package, strings.Join(a.PatchedVersions, ", "))
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	hash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
@@ -49,7 +50,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startMixAudit(projectSubPath), tools.MixAudit, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.MixAudit)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startMixAudit(projectSubPath string) error {
@@ -86,12 +86,16 @@ func (f *Formatter) parseOutput(output string) error {
 	return nil
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilityData(vulnerabilities []entities.Vulnerability, index int) *horusec.Vulnerability {
 	vulnerability := f.getDefaultVulnerabilitySeverity()
 	vulnerability.Severity = severity.High
 	vulnerability.Details = vulnerabilities[index].GetDetails()
 	vulnerability.Code = f.GetCodeWithMaxCharacters(vulnerabilities[index].Advisory.Package, 0)
 	vulnerability.File = f.RemoveSrcFolderFromPath(vulnerabilities[index].Dependency.Lockfile)
+	vulnerability.SetClassification(classification.CWEVulnerableThirdPartyComponent)
+	vulnerability.SetRule(vulnerabilities[index].Advisory.GetRuleID(), vulnerabilities[index].Advisory.Title)
+	vulnerability.SetRemediation(vulnerabilities[index].Advisory.GetRemediation(), vulnerabilities[index].Advisory.URL)
 	vulnerability = hash.Bind(vulnerability)
 	return f.SetCommitAuthor(vulnerability)
 }
@@ -41,13 +41,18 @@ func TestStartCFlawfinder(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
 
 		assert.NotEmpty(t, analysis)
 		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "CVE-2019-15160", vulnerability.RuleID)
+		assert.Equal(t, "Inline DTD allows XML bomb attack", vulnerability.Title)
+		assert.NotEmpty(t, vulnerability.Remediation)
+		assert.Contains(t, vulnerability.References, "https://github.com/kbrw/sweet_xml/issues/71")
 	})
 
 	t.Run("should return error when invalid output", func(t *testing.T) {
@@ -60,7 +65,7 @@ func TestStartCFlawfinder(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		assert.NotPanics(t, func() {
@@ -76,7 +81,7 @@ func TestStartCFlawfinder(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("", errors.New("test"))
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		assert.NotPanics(t, func() {
@@ -91,7 +96,7 @@ func TestStartCFlawfinder(t *testing.T) {
 		config.SetWorkDir(&workdir.WorkDir{})
 		config.SetToolsToIgnore([]string{"MixAudit"})
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
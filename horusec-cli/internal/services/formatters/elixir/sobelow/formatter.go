@@ -54,7 +54,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startSobelow(projectSubPath), tools.Sobelow, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Sobelow)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startSobelow(projectSubPath string) error {
@@ -119,6 +118,7 @@ func (f *Formatter) setVulnerabilityData(output *entities.Output) *horusec.Vulne
 	vulnerability.Details = output.Title
 	vulnerability.File = output.File
 	vulnerability.Line = output.Line
+	vulnerability.SetRule("", output.Title)
 	vulnerability = hash.Bind(vulnerability)
 	return f.SetCommitAuthor(vulnerability)
 }
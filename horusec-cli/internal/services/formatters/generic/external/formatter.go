@@ -0,0 +1,272 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/jsonpath"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	hash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/sarif"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+type Formatter struct {
+	formatters.IService
+	externalTool externaltools.ExternalTool
+	language     languages.Language
+}
+
+func NewFormatter(service formatters.IService, externalTool externaltools.ExternalTool,
+	language languages.Language) formatters.IFormatter {
+	return &Formatter{
+		service,
+		externalTool,
+		language,
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	tool := f.externalTool.GetTool()
+	if f.ToolIsToIgnore(tool) || f.IsDockerDisabled() {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored + tool.ToString())
+		return
+	}
+
+	f.SetAnalysisError(f.startExternalTool(projectSubPath), tool, projectSubPath)
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tool)
+}
+
+func (f *Formatter) startExternalTool(projectSubPath string) error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, f.externalTool.GetTool())
+
+	output, err := f.ExecuteContainer(f.getDockerConfig(projectSubPath))
+	if err != nil {
+		return err
+	}
+
+	return f.parseOutput(output)
+}
+
+func (f *Formatter) getDockerConfig(projectSubPath string) *dockerEntities.AnalysisData {
+	analysisData := &dockerEntities.AnalysisData{
+		CMD:      f.AddWorkDirInCmd(f.externalTool.CMD, projectSubPath, f.externalTool.GetTool()),
+		Language: f.language,
+	}
+
+	return analysisData.SetData(f.externalTool.Image, f.externalTool.Image)
+}
+
+func (f *Formatter) parseOutput(output string) error {
+	if strings.TrimSpace(output) == "" {
+		logger.LogDebugWithLevel(messages.MsgDebugOutputEmpty,
+			map[string]interface{}{"tool": f.externalTool.GetTool().ToString()})
+		return nil
+	}
+
+	if f.externalTool.OutputFormat == externaltools.OutputFormatSarif {
+		return f.parseSarifOutput(output)
+	}
+
+	return f.parseJSONPathOutput(output)
+}
+
+func (f *Formatter) parseSarifOutput(output string) error {
+	var report sarif.Report
+	if err := json.Unmarshal([]byte(output), &report); err != nil {
+		logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(f.externalTool.GetTool(), output), err)
+		return err
+	}
+
+	for _, run := range report.Runs {
+		rules := f.getSarifRulesByID(run.Tool.Driver.Rules)
+		for index := range run.Results {
+			f.AddNewVulnerabilityIntoAnalysis(f.setSarifVulnerabilityData(&run.Results[index], rules))
+		}
+	}
+
+	return nil
+}
+
+func (f *Formatter) getSarifRulesByID(rules []sarif.Rule) map[string]sarif.Rule {
+	rulesByID := map[string]sarif.Rule{}
+	for index := range rules {
+		rulesByID[rules[index].ID] = rules[index]
+	}
+
+	return rulesByID
+}
+
+func (f *Formatter) setSarifVulnerabilityData(result *sarif.Result,
+	rules map[string]sarif.Rule) *horusec.Vulnerability {
+	vulnerability := f.getDefaultVulnerabilityData()
+	vulnerability.Details = f.getSarifDetails(result)
+	vulnerability.Severity = f.getSarifSeverity(result.Level)
+	if len(result.Locations) > 0 {
+		location := result.Locations[0].PhysicalLocation
+		vulnerability.File = f.getFilePath(location.ArtifactLocation.URI)
+		f.setSarifRegion(vulnerability, location.Region)
+	}
+
+	f.setSarifRule(vulnerability, result.RuleID, rules[result.RuleID])
+	return f.bindVulnerability(vulnerability)
+}
+
+// setSarifRule uses the metadata of rule declared by tool in the run, the help of rule is its remediation
+func (f *Formatter) setSarifRule(vulnerability *horusec.Vulnerability, ruleID string, rule sarif.Rule) {
+	vulnerability.SetRule(ruleID, rule.ShortDescription.Text)
+	if rule.Help != nil {
+		vulnerability.SetRemediation(rule.Help.Text, rule.HelpURI)
+		return
+	}
+
+	vulnerability.SetRemediation("", rule.HelpURI)
+}
+
+func (f *Formatter) getSarifDetails(result *sarif.Result) string {
+	if result.RuleID == "" {
+		return result.Message.Text
+	}
+
+	return result.RuleID + "\n" + result.Message.Text
+}
+
+func (f *Formatter) setSarifRegion(vulnerability *horusec.Vulnerability, region *sarif.Region) {
+	if region == nil {
+		return
+	}
+
+	vulnerability.Line = strconv.Itoa(region.StartLine)
+	vulnerability.Column = strconv.Itoa(region.StartColumn)
+	if region.Snippet != nil {
+		vulnerability.Code = f.GetCodeWithMaxCharacters(region.Snippet.Text, region.StartColumn)
+	}
+}
+
+// getSarifSeverity use the severity map of config before the default conversion of sarif levels
+func (f *Formatter) getSarifSeverity(level string) severity.Severity {
+	if mappedSeverity := f.externalTool.GetSeverity(level); mappedSeverity != severity.Unknown {
+		return mappedSeverity
+	}
+
+	switch level {
+	case "error":
+		return severity.High
+	case "warning":
+		return severity.Medium
+	case "note":
+		return severity.Low
+	}
+
+	return severity.Unknown
+}
+
+func (f *Formatter) parseJSONPathOutput(output string) error {
+	var data interface{}
+	if err := json.Unmarshal([]byte(output), &data); err != nil {
+		logger.LogErrorWithLevel(f.GetAnalysisIDErrorMessage(f.externalTool.GetTool(), output), err)
+		return err
+	}
+
+	results, err := f.getJSONPathResults(data)
+	if err != nil {
+		return err
+	}
+
+	for _, result := range results {
+		vulnerability, err := f.setJSONPathVulnerabilityData(result)
+		if err != nil {
+			return err
+		}
+
+		f.AddNewVulnerabilityIntoAnalysis(vulnerability)
+	}
+
+	return nil
+}
+
+// getJSONPathResults when results path point to an array instead of its items, the items are returned
+func (f *Formatter) getJSONPathResults(data interface{}) ([]interface{}, error) {
+	results, err := jsonpath.Get(data, f.externalTool.Mapping.Results)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(results) == 1 {
+		if items, ok := results[0].([]interface{}); ok {
+			return items, nil
+		}
+	}
+
+	return results, nil
+}
+
+func (f *Formatter) setJSONPathVulnerabilityData(result interface{}) (vulnerability *horusec.Vulnerability, err error) {
+	values := map[string]string{}
+	mapping := f.externalTool.Mapping
+	for field, path := range map[string]string{"details": mapping.Details, "severity": mapping.Severity,
+		"confidence": mapping.Confidence, "file": mapping.File, "line": mapping.Line, "column": mapping.Column,
+		"code": mapping.Code, "ruleID": mapping.RuleID, "title": mapping.Title, "remediation": mapping.Remediation,
+		"reference": mapping.Reference} {
+		if values[field], err = jsonpath.GetFirstString(result, path); err != nil {
+			return nil, err
+		}
+	}
+
+	return f.bindVulnerability(f.newJSONPathVulnerability(values)), nil
+}
+
+func (f *Formatter) newJSONPathVulnerability(values map[string]string) *horusec.Vulnerability {
+	vulnerability := f.getDefaultVulnerabilityData()
+	vulnerability.Details = values["details"]
+	vulnerability.Severity = f.externalTool.GetSeverity(values["severity"])
+	vulnerability.Confidence = values["confidence"]
+	vulnerability.File = f.getFilePath(values["file"])
+	vulnerability.Line = values["line"]
+	vulnerability.Column = values["column"]
+	column, _ := strconv.Atoi(values["column"])
+	vulnerability.Code = f.GetCodeWithMaxCharacters(values["code"], column)
+	vulnerability.SetRule(values["ruleID"], values["title"])
+	vulnerability.SetRemediation(values["remediation"], values["reference"])
+	return vulnerability
+}
+
+func (f *Formatter) getFilePath(path string) string {
+	return f.RemoveSrcFolderFromPath(strings.TrimPrefix(path, "file://"))
+}
+
+// bindVulnerability adds the name of tool in details, because the security tool of all external tools is the same
+func (f *Formatter) bindVulnerability(vulnerability *horusec.Vulnerability) *horusec.Vulnerability {
+	vulnerability.Details = f.externalTool.Name + "\n" + vulnerability.Details
+	vulnerability = hash.Bind(vulnerability)
+	return f.SetCommitAuthor(vulnerability)
+}
+
+func (f *Formatter) getDefaultVulnerabilityData() *horusec.Vulnerability {
+	vulnerability := &horusec.Vulnerability{}
+	vulnerability.SecurityTool = tools.External
+	vulnerability.Language = f.language
+	return vulnerability
+}
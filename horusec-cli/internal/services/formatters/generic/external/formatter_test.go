@@ -0,0 +1,166 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package external
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSarifTool() externaltools.ExternalTool {
+	return externaltools.ExternalTool{
+		Name:         "InternalLinter",
+		Image:        "company/linter:latest",
+		CMD:          "{{WORK_DIR}} linter --sarif .",
+		Languages:    []languages.Language{languages.Go},
+		OutputFormat: externaltools.OutputFormatSarif,
+	}
+}
+
+func newJSONPathTool() externaltools.ExternalTool {
+	return externaltools.ExternalTool{
+		Name:         "InternalChecker",
+		Image:        "company/checker:latest",
+		CMD:          "{{WORK_DIR}} checker --json .",
+		Languages:    []languages.Language{languages.Python},
+		OutputFormat: externaltools.OutputFormatJSONPath,
+		Mapping: externaltools.Mapping{
+			Results:     "$.issues[*]",
+			Details:     "$.message",
+			Severity:    "$.level",
+			File:        "$.location.path",
+			Line:        "$.location.line",
+			Code:        "$.snippet",
+			RuleID:      "$.rule",
+			Remediation: "$.fix",
+			SeverityMap: map[string]severity.Severity{"blocker": severity.Critical},
+		},
+	}
+}
+
+func startAnalysis(externalTool externaltools.ExternalTool, language languages.Language, output string,
+	err error) *horusec.Analysis {
+	dockerAPIControllerMock := &docker.Mock{}
+	dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, err)
+
+	analysis := &horusec.Analysis{}
+	config := &cliConfig.Config{}
+	config.SetWorkDir(&workdir.WorkDir{})
+
+	service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+	NewFormatter(service, externalTool, language).StartAnalysis("")
+	return analysis
+}
+
+func TestStartAnalysis(t *testing.T) {
+	t.Run("Should parse sarif output into vulnerabilities", func(t *testing.T) {
+		output := `{"version": "2.1.0", "runs": [{"tool": {"driver": {"name": "linter", "rules": [
+			{"id": "L001", "shortDescription": {"text": "Hard-coded password"}, "help": {"text": "Use a vault"},
+			 "helpUri": "https://linter.example.com/rules/L001"}]}}, "results": [
+			{"ruleId": "L001", "level": "error", "message": {"text": "hardcoded password"},
+			 "locations": [{"physicalLocation": {"artifactLocation": {"uri": "file:///src/main.go"},
+			 "region": {"startLine": 10, "startColumn": 2, "snippet": {"text": "password := \"123\""}}}}]},
+			{"ruleId": "L002", "level": "note", "message": {"text": "weak random"},
+			 "locations": [{"physicalLocation": {"artifactLocation": {"uri": "random.go"}}}]}]}]}`
+
+		analysis := startAnalysis(newSarifTool(), languages.Go, output, nil)
+
+		assert.Empty(t, analysis.Errors)
+		assert.Len(t, analysis.AnalysisVulnerabilities, 2)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.External, vulnerability.SecurityTool)
+		assert.Equal(t, languages.Go, vulnerability.Language)
+		assert.Equal(t, severity.High, vulnerability.Severity)
+		assert.Equal(t, "main.go", vulnerability.File)
+		assert.Equal(t, "10", vulnerability.Line)
+		assert.Equal(t, "InternalLinter\nL001\nhardcoded password", vulnerability.Details)
+		assert.NotEmpty(t, vulnerability.VulnHash)
+		assert.Equal(t, "L001", vulnerability.RuleID)
+		assert.Equal(t, "Hard-coded password", vulnerability.Title)
+		assert.Equal(t, "Use a vault", vulnerability.Remediation)
+		assert.Equal(t, pq.StringArray{"https://linter.example.com/rules/L001"}, vulnerability.References)
+		assert.Equal(t, severity.Low, analysis.AnalysisVulnerabilities[1].Vulnerability.Severity)
+		assert.Equal(t, "L002", analysis.AnalysisVulnerabilities[1].Vulnerability.RuleID)
+	})
+
+	t.Run("Should parse json output by json path mapping into vulnerabilities", func(t *testing.T) {
+		output := `{"issues": [
+			{"message": "sql injection", "level": "blocker", "location": {"path": "app.py", "line": 7},
+			 "snippet": "cursor.execute(query)", "rule": "PY-SQL", "fix": "Use query parameters"},
+			{"message": "debug enabled", "level": "medium", "location": {"path": "settings.py", "line": 3}}]}`
+
+		analysis := startAnalysis(newJSONPathTool(), languages.Python, output, nil)
+
+		assert.Empty(t, analysis.Errors)
+		assert.Len(t, analysis.AnalysisVulnerabilities, 2)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.External, vulnerability.SecurityTool)
+		assert.Equal(t, "InternalChecker\nsql injection", vulnerability.Details)
+		assert.Equal(t, severity.Critical, vulnerability.Severity)
+		assert.Equal(t, "app.py", vulnerability.File)
+		assert.Equal(t, "7", vulnerability.Line)
+		assert.Equal(t, "cursor.execute(query)", vulnerability.Code)
+		assert.Equal(t, "PY-SQL", vulnerability.RuleID)
+		assert.Equal(t, "Use query parameters", vulnerability.Remediation)
+		assert.Equal(t, severity.Medium, analysis.AnalysisVulnerabilities[1].Vulnerability.Severity)
+	})
+
+	t.Run("Should use items when results path point to an array", func(t *testing.T) {
+		externalTool := newJSONPathTool()
+		externalTool.Mapping.Results = "$.issues"
+		output := `{"issues": [{"message": "sql injection", "level": "high", "location": {"path": "app.py"}}]}`
+
+		analysis := startAnalysis(externalTool, languages.Python, output, nil)
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		assert.Equal(t, severity.High, analysis.AnalysisVulnerabilities[0].Vulnerability.Severity)
+	})
+
+	t.Run("Should return error when invalid output", func(t *testing.T) {
+		analysis := startAnalysis(newJSONPathTool(), languages.Python, "!!", nil)
+
+		assert.NotEmpty(t, analysis.Errors)
+	})
+
+	t.Run("Should return error when executing container", func(t *testing.T) {
+		analysis := startAnalysis(newSarifTool(), languages.Go, "", errors.New("test"))
+
+		assert.NotEmpty(t, analysis.Errors)
+	})
+
+	t.Run("Should not execute tool when it is ignored", func(t *testing.T) {
+		dockerAPIControllerMock := &docker.Mock{}
+		analysis := &horusec.Analysis{}
+		config := &cliConfig.Config{}
+		config.SetToolsToIgnore([]string{"InternalLinter"})
+
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+		NewFormatter(service, newSarifTool(), languages.Go).StartAnalysis("")
+
+		dockerAPIControllerMock.AssertNotCalled(t, "CreateLanguageAnalysisContainer")
+	})
+}
@@ -15,7 +15,8 @@
 package entities
 
 type Extra struct {
-	Message  string `json:"message"`
-	Severity string `json:"severity"`
-	Code     string `json:"lines"`
+	Message  string   `json:"message"`
+	Severity string   `json:"severity"`
+	Code     string   `json:"lines"`
+	Metadata Metadata `json:"metadata"`
 }
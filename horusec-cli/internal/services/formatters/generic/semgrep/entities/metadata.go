@@ -0,0 +1,38 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+import (
+	"encoding/json"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/remediation"
+)
+
+type Metadata struct {
+	CWE           json.RawMessage `json:"cwe"`
+	References    json.RawMessage `json:"references"`
+	SourceRuleURL string          `json:"source-rule-url"`
+}
+
+// GetCWEs returns the cwes of the rule metadata, it can be informed as text or as list of texts
+func (m *Metadata) GetCWEs() []string {
+	return classification.GetCWEsFromText(string(m.CWE))
+}
+
+// GetReferences returns the references of the rule metadata, it can be informed as text or as list of texts
+func (m *Metadata) GetReferences() []string {
+	return append(remediation.GetURLsFromText(string(m.References)), m.SourceRuleURL)
+}
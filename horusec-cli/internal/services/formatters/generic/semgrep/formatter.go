@@ -51,7 +51,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startSemgrep(projectSubPath), tools.SecurityCodeScan, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Semgrep)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startSemgrep(projectSubPath string) error {
@@ -89,6 +88,7 @@ func (f *Formatter) parseOutput(output string) error {
 	return nil
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilityData(result *entities.Result) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilityData()
 	data.Details = result.Extra.Message
@@ -98,6 +98,9 @@ func (f *Formatter) setVulnerabilityData(result *entities.Result) *horusec.Vulne
 	data.File = result.Path
 	data.Code = f.GetCodeWithMaxCharacters(result.Extra.Code, 0)
 	data.Language = f.getLanguageByFile(result.Path)
+	data.SetClassification(result.Extra.Metadata.GetCWEs()...)
+	data.SetRule(result.CheckID, "")
+	data.SetRemediation("", result.Extra.Metadata.GetReferences()...)
 	data = hash.Bind(data)
 	return f.SetCommitAuthor(data)
 }
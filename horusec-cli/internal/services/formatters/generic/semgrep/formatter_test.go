@@ -23,6 +23,7 @@ import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -43,7 +44,7 @@ func TestParseOutput(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -66,7 +67,7 @@ func TestParseOutput(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -89,13 +90,66 @@ func TestParseOutput(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
 		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
 	})
 
+	t.Run("Should classify vulnerabilities by cwe of rule metadata", func(t *testing.T) {
+		dockerAPIControllerMock := &docker.Mock{}
+		dockerAPIControllerMock.On("SetAnalysisID")
+		analysis := &horusec.Analysis{}
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+
+		output := `{"results":[{"check_id":"python.django.security.injection.sql.sql-injection-using-raw","path":"app.py",` +
+			`"start":{"line":1,"col":1},"end":{"line":1,"col":10},"extra":{"message":"SQL Injection","severity":"ERROR",` +
+			`"lines":"User.objects.raw(query)","metadata":{"cwe":"CWE-89: Improper Neutralization of Special Elements"}}},` +
+			`{"check_id":"javascript.express.security.open-redirect","path":"app.js","start":{"line":1,"col":1},` +
+			`"end":{"line":1,"col":10},"extra":{"message":"Open redirect","severity":"WARNING","lines":"res.redirect(url)",` +
+			`"metadata":{"cwe":["CWE-601: URL Redirection to Untrusted Site"]}}}]}`
+
+		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
+
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 2)
+		assert.Equal(t, pq.StringArray{"CWE-89"}, analysis.AnalysisVulnerabilities[0].Vulnerability.CWEs)
+		assert.Equal(t, pq.StringArray{"CWE-601"}, analysis.AnalysisVulnerabilities[1].Vulnerability.CWEs)
+		assert.Equal(t, pq.StringArray{"A01:2021-Broken Access Control"},
+			analysis.AnalysisVulnerabilities[1].Vulnerability.OWASPTop10)
+	})
+
+	t.Run("Should set rule and references of rule metadata", func(t *testing.T) {
+		dockerAPIControllerMock := &docker.Mock{}
+		dockerAPIControllerMock.On("SetAnalysisID")
+		analysis := &horusec.Analysis{}
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+
+		output := `{"results":[{"check_id":"python.django.security.injection.sql.sql-injection-using-raw","path":"app.py",` +
+			`"start":{"line":1,"col":1},"end":{"line":1,"col":10},"extra":{"message":"SQL Injection","severity":"ERROR",` +
+			`"lines":"User.objects.raw(query)","metadata":{"cwe":"CWE-89","references":` +
+			`["https://docs.djangoproject.com/en/3.0/topics/security/#sql-injection-protection"],` +
+			`"source-rule-url":"https://semgrep.dev/r/python.django.security.injection.sql.sql-injection-using-raw"}}}]}`
+
+		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
+
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "python.django.security.injection.sql.sql-injection-using-raw", vulnerability.RuleID)
+		assert.NotEmpty(t, vulnerability.Remediation)
+		assert.Equal(t, pq.StringArray{"https://docs.djangoproject.com/en/3.0/topics/security/#sql-injection-protection",
+			"https://semgrep.dev/r/python.django.security.injection.sql.sql-injection-using-raw",
+			"https://cwe.mitre.org/data/definitions/89.html"}, vulnerability.References)
+	})
+
 	t.Run("Should return error when invalid output", func(t *testing.T) {
 		dockerAPIControllerMock := &docker.Mock{}
 		dockerAPIControllerMock.On("SetAnalysisID")
@@ -107,7 +161,7 @@ func TestParseOutput(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -123,7 +177,7 @@ func TestParseOutput(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("", errors.New("test"))
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -137,7 +191,7 @@ func TestParseOutput(t *testing.T) {
 		config.SetWorkDir(&workdir.WorkDir{})
 		config.SetToolsToIgnore([]string{"semgrep"})
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
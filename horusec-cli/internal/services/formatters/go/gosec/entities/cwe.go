@@ -0,0 +1,56 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+type CWE struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// nolint:funlen mapping table of rules is necessary more 15 lines
+func cwesByRuleID() map[string][]string {
+	return map[string][]string{
+		"G101": {"798"},
+		"G102": {"200"},
+		"G103": {"242"},
+		"G104": {"703"},
+		"G106": {"322"},
+		"G107": {"88"},
+		"G108": {"200"},
+		"G109": {"190"},
+		"G110": {"409"},
+		"G201": {"89"},
+		"G202": {"89"},
+		"G203": {"79"},
+		"G204": {"78"},
+		"G301": {"276"},
+		"G302": {"276"},
+		"G303": {"377"},
+		"G304": {"22"},
+		"G305": {"22"},
+		"G306": {"276"},
+		"G307": {"703"},
+		"G401": {"326"},
+		"G402": {"295"},
+		"G403": {"310"},
+		"G404": {"338"},
+		"G501": {"327"},
+		"G502": {"327"},
+		"G503": {"327"},
+		"G504": {"327"},
+		"G505": {"327"},
+		"G601": {"118"},
+	}
+}
@@ -24,4 +24,15 @@ type Issue struct {
 	Code       string            `json:"code"`
 	Line       string            `json:"line"`
 	Column     string            `json:"column"`
+	RuleID     string            `json:"rule_id"`
+	CWE        CWE               `json:"cwe"`
+}
+
+// GetCWEs returns the cwe informed by gosec, older versions not inform it so it is mapped by rule id
+func (i *Issue) GetCWEs() []string {
+	if i.CWE.ID != "" {
+		return []string{i.CWE.ID}
+	}
+
+	return cwesByRuleID()[i.RuleID]
 }
@@ -49,7 +49,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startGoSec(projectSubPath), tools.GoSec, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.GoSec)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startGoSec(projectSubPath string) error {
@@ -93,6 +92,7 @@ func (f *Formatter) setGoSecOutPutInHorusecAnalysis(golangOutput entities.Output
 	}
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setupVulnerabilitiesSeveritiesGoSec(issue *entities.Issue) *horusec.Vulnerability {
 	vulnerability := f.getDefaultVulnerabilitySeverity()
 	vulnerability.Severity = issue.Severity
@@ -102,6 +102,9 @@ func (f *Formatter) setupVulnerabilitiesSeveritiesGoSec(issue *entities.Issue) *
 	vulnerability.Column = issue.Column
 	vulnerability.Confidence = issue.Confidence
 	vulnerability.File = f.RemoveSrcFolderFromPath(issue.File)
+	vulnerability.SetClassification(issue.GetCWEs()...)
+	vulnerability.SetRule(issue.RuleID, issue.Details)
+	vulnerability.SetRemediation("", issue.CWE.URL)
 	vulnerability = hash.Bind(vulnerability)
 	return f.SetCommitAuthor(vulnerability)
 }
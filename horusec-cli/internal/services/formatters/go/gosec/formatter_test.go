@@ -23,6 +23,7 @@ import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -35,7 +36,7 @@ func TestGoLang_StartAnalysis(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config)
 
 		golangAnalyser := NewFormatter(service)
 
@@ -67,13 +68,21 @@ func TestGoLang_StartAnalysis(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(outputAnalysis, nil)
 
-		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config)
 
 		golangAnalyser := NewFormatter(service)
 
 		assert.NotPanics(t, func() {
 			golangAnalyser.StartAnalysis("")
 		})
+		assert.NotEmpty(t, service.GetAnalysis().AnalysisVulnerabilities)
+		vulnerability := service.GetAnalysis().AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, pq.StringArray{"CWE-327"}, vulnerability.CWEs)
+		assert.Equal(t, pq.StringArray{"A02:2021-Cryptographic Failures"}, vulnerability.OWASPTop10)
+		assert.Equal(t, "G501", vulnerability.RuleID)
+		assert.Equal(t, "Blacklisted import crypto/md5: weak cryptographic primitive", vulnerability.Title)
+		assert.NotEmpty(t, vulnerability.Remediation)
+		assert.Equal(t, pq.StringArray{"https://cwe.mitre.org/data/definitions/327.html"}, vulnerability.References)
 	})
 
 	t.Run("Should run analysis and return error and up docker_api and save on cache with error", func(t *testing.T) {
@@ -84,7 +93,7 @@ func TestGoLang_StartAnalysis(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config)
 
 		golangAnalyser := NewFormatter(service)
 
@@ -103,7 +112,7 @@ func TestGoLang_StartAnalysis(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(outputAnalysis, nil)
 
-		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(&horusec.Analysis{}, dockerAPIControllerMock, config)
 
 		golangAnalyser := NewFormatter(service)
 
@@ -119,7 +128,7 @@ func TestGoLang_StartAnalysis(t *testing.T) {
 		config.SetWorkDir(&workdir.WorkDir{})
 		config.SetToolsToIgnore([]string{"GoSec"})
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -0,0 +1,32 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+type weakness struct {
+	cwe      string
+	keywords []string
+}
+
+// weaknessesByKeywords are ordered by priority, so the more specific weaknesses must be before the generic ones
+func weaknessesByKeywords() []weakness {
+	return []weakness{
+		{cwe: "798", keywords: []string{"secret", "password", "credential", "access key", "sensitive"}},
+		{cwe: "311", keywords: []string{"encrypt"}},
+		{cwe: "319", keywords: []string{"https", "tls", "ssl", "plain text", "plaintext", "http "}},
+		{cwe: "778", keywords: []string{"logging", "audit", "monitor"}},
+		{cwe: "732", keywords: []string{"wildcard", "all actions", "all resources", "overly permissive"}},
+		{cwe: "284", keywords: []string{"public", "0.0.0.0/0", "ingress", "egress", "anonymous"}},
+	}
+}
@@ -17,22 +17,31 @@ package entities
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 )
 
 type Result struct {
-	RuleID      string   `json:"rule_id"`
-	Link        string   `json:"link"`
-	Location    Location `json:"location"`
-	Description string   `json:"description"`
-	Severity    string   `json:"severity"`
+	RuleID          string   `json:"rule_id"`
+	Link            string   `json:"link"`
+	Links           []string `json:"links"`
+	Location        Location `json:"location"`
+	Description     string   `json:"description"`
+	RuleDescription string   `json:"rule_description"`
+	Resolution      string   `json:"resolution"`
+	Severity        string   `json:"severity"`
 }
 
 func (r *Result) GetDetails() string {
 	return r.RuleID + " -> [" + r.Description + "]"
 }
 
+// GetReferences returns the link of older versions of tfsec and the links of newer versions
+func (r *Result) GetReferences() []string {
+	return append([]string{r.Link}, r.Links...)
+}
+
 func (r *Result) GetStartLine() string {
 	return strconv.Itoa(r.Location.StartLine)
 }
@@ -49,6 +58,20 @@ func (r *Result) GetSeverity() severity.Severity {
 	return r.mapSeverityValues()[r.Severity]
 }
 
+// GetCWEs returns the cwe of the first weakness found in the description, because tfsec not inform the cwe
+func (r *Result) GetCWEs() []string {
+	description := strings.ToLower(r.Description)
+	for _, weakness := range weaknessesByKeywords() {
+		for _, keyword := range weakness.keywords {
+			if strings.Contains(description, keyword) {
+				return []string{weakness.cwe}
+			}
+		}
+	}
+
+	return []string{}
+}
+
 func (r *Result) mapSeverityValues() map[string]severity.Severity {
 	return map[string]severity.Severity{
 		"ERROR":   severity.High,
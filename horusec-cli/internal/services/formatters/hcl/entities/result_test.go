@@ -58,3 +58,30 @@ func TestGetFilename(t *testing.T) {
 		assert.NotEmpty(t, resultMock().GetFilename())
 	})
 }
+
+func TestGetCWEs(t *testing.T) {
+	t.Run("should return cwe by keywords of description", func(t *testing.T) {
+		result := resultMock()
+
+		result.Description = "Resource 'aws_s3_bucket.my-bucket' defines an unencrypted S3 bucket."
+		assert.Equal(t, []string{"311"}, result.GetCWEs())
+
+		result.Description = "Resource 'aws_security_group_rule.my-rule' defines a fully open ingress security group."
+		assert.Equal(t, []string{"284"}, result.GetCWEs())
+	})
+
+	t.Run("should return empty when description not contains known weakness", func(t *testing.T) {
+		assert.Empty(t, resultMock().GetCWEs())
+	})
+}
+
+func TestGetReferences(t *testing.T) {
+	t.Run("should return link of older versions and links of newer versions", func(t *testing.T) {
+		result := resultMock()
+		result.Link = "https://github.com/liamg/tfsec/wiki/AWS002"
+		result.Links = []string{"https://tfsec.dev/docs/aws/s3/enable-bucket-logging"}
+
+		assert.Equal(t, []string{"https://github.com/liamg/tfsec/wiki/AWS002",
+			"https://tfsec.dev/docs/aws/s3/enable-bucket-logging"}, result.GetReferences())
+	})
+}
@@ -50,7 +50,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startTfSec(projectSubPath), tools.TfSec, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.TfSec)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startTfSec(projectSubPath string) error {
@@ -91,6 +90,7 @@ func (f *Formatter) parseOutput(output string) error {
 	return nil
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilityData(index int, results []entities.Result) *horusec.Vulnerability {
 	vulnerability := f.getDefaultVulnerabilityData()
 	vulnerability.Severity = results[index].GetSeverity()
@@ -98,6 +98,9 @@ func (f *Formatter) setVulnerabilityData(index int, results []entities.Result) *
 	vulnerability.Line = results[index].GetStartLine()
 	vulnerability.Code = f.GetCodeWithMaxCharacters(results[index].GetCode(), 0)
 	vulnerability.File = f.RemoveSrcFolderFromPath(results[index].GetFilename())
+	vulnerability.SetClassification(results[index].GetCWEs()...)
+	vulnerability.SetRule(results[index].RuleID, results[index].RuleDescription)
+	vulnerability.SetRemediation(results[index].Resolution, results[index].GetReferences()...)
 	vulnerability = hash.Bind(vulnerability)
 	return f.SetCommitAuthor(vulnerability)
 }
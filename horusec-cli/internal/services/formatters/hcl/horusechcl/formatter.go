@@ -0,0 +1,78 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusechcl
+
+import (
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl"
+	engineenums "github.com/ZupIT/horusec/development-kit/pkg/enums/engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+type Formatter struct {
+	formatters.IService
+	hcl.Interface
+}
+
+func NewFormatter(service formatters.IService) formatters.IFormatter {
+	return &Formatter{
+		service,
+		hcl.NewRules(),
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	if f.ToolIsToIgnore(tools.HorusecHCL) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored + tools.HorusecHCL.ToString())
+		return
+	}
+
+	f.SetAnalysisError(f.execEngineAndParseResults(projectSubPath), tools.HorusecHCL, projectSubPath)
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecHCL)
+}
+
+func (f *Formatter) execEngineAndParseResults(projectSubPath string) error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.HorusecHCL)
+
+	findings, err := f.execEngineAnalysis(projectSubPath)
+	if err != nil {
+		return err
+	}
+
+	return f.ParseFindingsToVulnerabilities(findings, tools.HorusecHCL, languages.HCL)
+}
+
+// execEngineAnalysis runs the native rules in the parsed files and the custom rules, which are text rules,
+// in the content of the same files
+func (f *Formatter) execEngineAnalysis(projectSubPath string) ([]engine.Finding, error) {
+	projectPath := f.GetProjectPathWithWorkdir(projectSubPath)
+	structureUnits, err := f.GetStructureUnits(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	textUnits, err := f.GetTextUnitByRulesExt(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	findings := engine.RunMaxUnitsByAnalysis(structureUnits, f.GetAllRules(), engineenums.DefaultMaxUnitsPerAnalysis)
+	return append(findings, engine.RunMaxUnitsByAnalysis(textUnits, f.GetCustomRulesByTool(tools.HorusecHCL),
+		engineenums.DefaultMaxUnitsPerAnalysis)...), nil
+}
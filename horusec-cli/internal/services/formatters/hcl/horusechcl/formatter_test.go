@@ -0,0 +1,91 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusechcl
+
+import (
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartAnalysis(t *testing.T) {
+	t.Run("should success execute analysis without errors", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("GetProjectPathWithWorkdir").Return(".")
+		service.On("ParseFindingsToVulnerabilities").Return(nil)
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{})
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+
+	t.Run("should find vulnerabilities of native and custom rules in example", func(t *testing.T) {
+		service := &formatters.Mock{}
+		customRule := text.TextRule{
+			Metadata:    engine.Metadata{ID: "custom-hcl", Name: "Custom HCL"},
+			Type:        text.Regular,
+			Expressions: []*regexp.Regexp{regexp.MustCompile(`aws_alb_listener`)},
+		}
+
+		service.On("GetProjectPathWithWorkdir").Return("../../../../../../examples/hcl/example1")
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{customRule})
+
+		findings, err := NewFormatter(service).(*Formatter).execEngineAnalysis("")
+
+		assert.NoError(t, err)
+		ids := map[string]bool{}
+		for index := range findings {
+			ids[findings[index].ID] = true
+			assert.Equal(t, "main.tf", filepath.Base(findings[index].SourceLocation.Filename))
+		}
+		assert.True(t, ids["custom-hcl"])
+		assert.Greater(t, len(ids), 1)
+	})
+
+	t.Run("should return error when getting units", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("GetProjectPathWithWorkdir").Return("!!!")
+		service.On("ParseFindingsToVulnerabilities").Return(nil)
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{})
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+
+	t.Run("should ignore this tool", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("ToolIsToIgnore").Return(true)
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+}
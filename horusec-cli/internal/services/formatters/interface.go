@@ -15,11 +15,15 @@
 package formatters
 
 import (
+	"context"
+
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 )
 
@@ -33,13 +37,15 @@ type IService interface {
 	ExecuteContainer(data *dockerEntities.AnalysisData) (output string, err error)
 	GetAnalysisIDErrorMessage(tool tools.Tool, output string) string
 	GetCommitAuthor(line, filePath string) (commitAuthor horusec.CommitAuthor)
+	WalkGitHistory(walkFn func(commit *history.Commit) error) error
 	AddWorkDirInCmd(cmd string, projectSubPath string, tool tools.Tool) string
 	GetConfigProjectPath() string
 	GetToolsConfig() toolsconfig.MapToolConfig
+	GetRustAdvisoryDBPath() string
 	GetAnalysis() *horusec.Analysis
-	SetToolFinishedAnalysis()
 	SetAnalysisError(err error, tool tools.Tool, projectSubPath string)
-	SetMonitor(monitor *horusec.Monitor)
+	SetDiff(changes *diff.Diff)
+	WithContext(ctx context.Context) IService
 	RemoveSrcFolderFromPath(filepath string) string
 	GetCodeWithMaxCharacters(code string, column int) string
 	ToolIsToIgnore(tool tools.Tool) bool
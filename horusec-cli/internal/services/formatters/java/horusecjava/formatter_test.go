@@ -29,7 +29,6 @@ func TestStartAnalysis(t *testing.T) {
 		service := &formatters.Mock{}
 
 		service.On("LogDebugWithReplace")
-		service.On("SetToolFinishedAnalysis")
 		service.On("SetAnalysisError")
 		service.On("ToolIsToIgnore").Return(false)
 		service.On("GetProjectPathWithWorkdir").Return(".")
@@ -47,7 +46,6 @@ func TestStartAnalysis(t *testing.T) {
 		service := &formatters.Mock{}
 
 		service.On("LogDebugWithReplace")
-		service.On("SetToolFinishedAnalysis")
 		service.On("SetAnalysisError")
 		service.On("ToolIsToIgnore").Return(false)
 		service.On("GetProjectPathWithWorkdir").Return("!!!")
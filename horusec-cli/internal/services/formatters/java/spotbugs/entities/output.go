@@ -40,9 +40,25 @@ type SpotBugsIssue struct {
 	Rank         string       `xml:"rank,attr"`
 	Abbreviation string       `xml:"abbrev,attr"`
 	Category     string       `xml:"category,attr"`
+	CWEID        string       `xml:"cweid,attr"`
+	ShortMessage string       `xml:"ShortMessage"`
 	SourceLine   []SourceLine `xml:"SourceLine"`
 }
 
+const (
+	securityCategory = "SECURITY"
+	findSecBugsURL   = "https://find-sec-bugs.github.io/bugs.htm#"
+)
+
+// GetReference returns the description of the bug pattern of find sec bugs plugin, which explains how to fix it
+func (s *SpotBugsIssue) GetReference() string {
+	if s.Type == "" || s.Category != securityCategory {
+		return ""
+	}
+
+	return findSecBugsURL + s.Type
+}
+
 // Error is the struct that holds errors that happened in analysis
 type Error struct {
 	XMLName        xml.Name `xml:"Errors"`
@@ -61,7 +61,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 		return
 	}
 	err := f.startSpotbugsAnalysis(projectSubPath)
-	f.SetToolFinishedAnalysis()
 	f.SetAnalysisError(err, tools.SpotBugs, projectSubPath)
 }
 
@@ -148,6 +147,7 @@ func (f *Formatter) setOutputInHorusecAnalysis(javaOutput *entities.SpotBugsOutp
 	}
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setupVulnerabilitiesSeverities(
 	javaOutput *entities.SpotBugsOutput, indexSpotBugsIssue, indexSourceLine int) (
 	vulnerabilitySeverity horusec.Vulnerability) {
@@ -159,6 +159,10 @@ func (f *Formatter) setupVulnerabilitiesSeverities(
 	vulnerabilitySeverity.Confidence = f.parseSpotbugsPriorityToConfidence(javaOutput, indexSpotBugsIssue)
 	vulnerabilitySeverity.File = f.getVulnerabilitiesSeveritiesFile(javaOutput, indexSpotBugsIssue, indexSourceLine)
 	vulnerabilitySeverity.SecurityTool = tools.SpotBugs
+	vulnerabilitySeverity.SetClassification(javaOutput.SpotBugsIssue[indexSpotBugsIssue].CWEID)
+	vulnerabilitySeverity.SetRule(javaOutput.SpotBugsIssue[indexSpotBugsIssue].Type,
+		javaOutput.SpotBugsIssue[indexSpotBugsIssue].ShortMessage)
+	vulnerabilitySeverity.SetRemediation("", javaOutput.SpotBugsIssue[indexSpotBugsIssue].GetReference())
 	// TODO: Check on tool to return full path of the file to get commit author
 	return vulnerabilitySeverity
 }
@@ -206,10 +210,7 @@ func (f *Formatter) getVulnerabilitiesSeveritiesCode(
 }
 
 func (f *Formatter) factoryAddVulnerabilityBySeverity(vulnerability *horusec.Vulnerability) {
-	f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
-		horusec.AnalysisVulnerabilities{
-			Vulnerability: *vulnerability,
-		})
+	f.AddNewVulnerabilityIntoAnalysis(vulnerability)
 }
 
 func (f *Formatter) isJavaOutput(fileName string) bool {
@@ -92,11 +92,14 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 			assert.Empty(t, analysis.Errors)
 			assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+			assert.Equal(t, "PREDICTABLE_RANDOM", analysis.AnalysisVulnerabilities[0].Vulnerability.RuleID)
+			assert.Contains(t, analysis.AnalysisVulnerabilities[0].Vulnerability.References,
+				"https://find-sec-bugs.github.io/bugs.htm#PREDICTABLE_RANDOM")
 		})
 	})
 
@@ -111,7 +114,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -128,7 +131,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -148,7 +151,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -165,7 +168,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -188,7 +191,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -211,7 +214,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -234,7 +237,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -293,7 +296,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -351,7 +354,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -409,7 +412,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -467,7 +470,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -600,7 +603,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		analysis := AnalysisMock()
 
 		assert.NotPanics(t, func() {
-			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+			service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 			NewFormatter(service).StartAnalysis("")
 		})
@@ -611,7 +614,7 @@ func TestJava_StartAnalysis(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 		config.SetToolsToIgnore([]string{"GoSec", "SecurityCodeScan", "Brakeman", "Safety", "Bandit", "NpmAudit", "YarnAudit", "SpotBugs", "HorusecKotlin", "HorusecJava", "HorusecLeaks", "GitLeaks", "TfSec", "Semgrep", "HorusecCsharp", "HorusecKubernetes", "Eslint", "HorusecNodeJS", "Flawfinder", "PhpCS", "Eslint", "HorusecNodeJS", "Flawfinder", "PhpCS"})
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
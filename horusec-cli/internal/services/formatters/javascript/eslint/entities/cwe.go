@@ -0,0 +1,34 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+// nolint:funlen mapping table of rules is necessary more 15 lines
+func cwesByRuleID() map[string][]string {
+	return map[string][]string{
+		"security/detect-buffer-noassert":                {"125"},
+		"security/detect-child-process":                  {"78"},
+		"security/detect-disable-mustache-escape":        {"79"},
+		"security/detect-eval-with-expression":           {"95"},
+		"security/detect-new-buffer":                     {"770"},
+		"security/detect-no-csrf-before-method-override": {"352"},
+		"security/detect-non-literal-fs-filename":        {"22"},
+		"security/detect-non-literal-regexp":             {"1333"},
+		"security/detect-non-literal-require":            {"829"},
+		"security/detect-object-injection":               {"915"},
+		"security/detect-possible-timing-attacks":        {"208"},
+		"security/detect-pseudoRandomBytes":              {"338"},
+		"security/detect-unsafe-regex":                   {"1333"},
+	}
+}
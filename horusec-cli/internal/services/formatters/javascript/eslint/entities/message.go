@@ -1,5 +1,9 @@
 package entities
 
+import "strings"
+
+const securityPluginPrefix = "security/"
+
 type Message struct {
 	RuleID    string `json:"ruleId"`
 	Severity  int    `json:"severity"`
@@ -11,3 +15,17 @@ type Message struct {
 	EndLine   int    `json:"endLine"`
 	EndColumn int    `json:"endColumn"`
 }
+
+func (m *Message) GetCWEs() []string {
+	return cwesByRuleID()[m.RuleID]
+}
+
+// GetReference returns the documentation of the rules of the security plugin, which describes how to fix them
+func (m *Message) GetReference() string {
+	if !strings.HasPrefix(m.RuleID, securityPluginPrefix) {
+		return ""
+	}
+
+	return "https://github.com/nodesecurity/eslint-plugin-security#" +
+		strings.ToLower(strings.TrimPrefix(m.RuleID, securityPluginPrefix))
+}
@@ -51,7 +51,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startEsLint(projectSubPath), tools.Eslint, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Eslint)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startEsLint(projectSubPath string) error {
@@ -109,8 +108,9 @@ func (f *Formatter) concatOutputIntoAnalysisVulns(output *[]entities.Output) {
 	}
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) parseOutputToVuln(filePath, source string, message *entities.Message) *horusec.Vulnerability {
-	return &horusec.Vulnerability{
+	vuln := &horusec.Vulnerability{
 		File:         f.RemoveSrcFolderFromPath(filePath),
 		Line:         fmt.Sprintf(`%d`, message.Line),
 		Column:       fmt.Sprintf(`%d`, message.Column),
@@ -120,6 +120,11 @@ func (f *Formatter) parseOutputToVuln(filePath, source string, message *entities
 		Code:         f.getCode(source, message.Line, message.EndLine, message.Column),
 		Severity:     severity.Low,
 	}
+
+	vuln.SetClassification(message.GetCWEs()...)
+	vuln.SetRule(message.RuleID, "")
+	vuln.SetRemediation("", message.GetReference())
+	return vuln
 }
 
 func (f *Formatter) getCode(source string, line, endLine, column int) string {
@@ -36,12 +36,16 @@ func TestStartAnalysis(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(analysis, dockerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
 
 		assert.Equal(t, 1, len(analysis.AnalysisVulnerabilities))
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "security/detect-unsafe-regex", vulnerability.RuleID)
+		assert.Contains(t, vulnerability.References,
+			"https://github.com/nodesecurity/eslint-plugin-security#detect-unsafe-regex")
 	})
 
 	t.Run("should return error parsing output", func(t *testing.T) {
@@ -53,7 +57,7 @@ func TestStartAnalysis(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(analysis, dockerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -70,7 +74,7 @@ func TestStartAnalysis(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(analysis, dockerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -87,7 +91,7 @@ func TestStartAnalysis(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(analysis, dockerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -105,7 +109,7 @@ func TestStartAnalysis(t *testing.T) {
 		config.SetToolsToIgnore([]string{"Eslint"})
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(analysis, dockerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
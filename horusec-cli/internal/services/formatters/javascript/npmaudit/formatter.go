@@ -53,7 +53,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startNpmAudit(projectSubPath), tools.NpmAudit, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.NpmAudit)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startNpmAudit(projectSubPath string) error {
@@ -118,12 +117,16 @@ func (f *Formatter) processOutput(output *entities.Output) {
 	}
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilitySeverityData(issue *entities.Issue) (data *horusec.Vulnerability) {
 	data = f.getDefaultVulnerabilitySeverity()
 	data.Severity = issue.GetSeverity()
 	data.Details = issue.Overview
 	data.Code = issue.ModuleName
 	data.Line = f.getVulnerabilityLineByName(f.getVersionText(issue.GetVersion()), data.Code, data.File)
+	data.SetClassification(issue.GetCWEs()...)
+	data.SetRule(issue.GetRuleID(), issue.Title)
+	data.SetRemediation(issue.Recommendation, issue.GetReferences()...)
 	data = hash.Bind(data)
 	return f.SetCommitAuthor(data)
 }
@@ -15,16 +15,26 @@
 package entities
 
 import (
+	"encoding/json"
+	"strconv"
+
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/remediation"
 )
 
 type Issue struct {
-	Findings           []Finding `json:"findings"`
-	ID                 int       `json:"id"`
-	ModuleName         string    `json:"module_name"`
-	VulnerableVersions string    `json:"vulnerable_versions"`
-	Severity           string    `json:"severity"`
-	Overview           string    `json:"overview"`
+	Findings           []Finding       `json:"findings"`
+	ID                 int             `json:"id"`
+	ModuleName         string          `json:"module_name"`
+	VulnerableVersions string          `json:"vulnerable_versions"`
+	Severity           string          `json:"severity"`
+	Overview           string          `json:"overview"`
+	CWE                json.RawMessage `json:"cwe"`
+	Title              string          `json:"title"`
+	Recommendation     string          `json:"recommendation"`
+	URL                string          `json:"url"`
+	References         string          `json:"references"`
 }
 
 func (i *Issue) GetSeverity() severity.Severity {
@@ -42,6 +52,25 @@ func (i *Issue) mapSeverities() map[string]severity.Severity {
 	}
 }
 
+// GetCWEs returns the cwes of the advisory, that can be informed as text or as list of texts, and the cwe of
+// the use of a vulnerable dependency
+func (i *Issue) GetCWEs() []string {
+	return append(classification.GetCWEsFromText(string(i.CWE)), classification.CWEVulnerableThirdPartyComponent)
+}
+
+func (i *Issue) GetRuleID() string {
+	if i.ID == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(i.ID)
+}
+
+// GetReferences returns the url of the advisory and the urls mentioned in its references, which are a markdown text
+func (i *Issue) GetReferences() []string {
+	return append([]string{i.URL}, remediation.GetURLsFromText(i.References)...)
+}
+
 func (i *Issue) GetVersion() string {
 	if len(i.Findings) > 0 {
 		return i.Findings[0].Version
@@ -81,3 +81,47 @@ func TestGetSeverity(t *testing.T) {
 		assert.Equal(t, severity.Unknown, issue.GetSeverity())
 	})
 }
+
+func TestGetCWEs(t *testing.T) {
+	t.Run("should return cwe of advisory and of vulnerable dependency", func(t *testing.T) {
+		issue := Issue{CWE: []byte(`"CWE-400"`)}
+
+		assert.Equal(t, []string{"CWE-400", "CWE-1395"}, issue.GetCWEs())
+	})
+
+	t.Run("should return cwes when advisory inform a list", func(t *testing.T) {
+		issue := Issue{CWE: []byte(`["CWE-1321", "CWE-915"]`)}
+
+		assert.Equal(t, []string{"CWE-1321", "CWE-915", "CWE-1395"}, issue.GetCWEs())
+	})
+
+	t.Run("should return cwe of vulnerable dependency when advisory not inform cwe", func(t *testing.T) {
+		issue := Issue{}
+
+		assert.Equal(t, []string{"CWE-1395"}, issue.GetCWEs())
+	})
+}
+
+func TestGetRuleID(t *testing.T) {
+	t.Run("should return id of advisory", func(t *testing.T) {
+		issue := Issue{ID: 1523}
+
+		assert.Equal(t, "1523", issue.GetRuleID())
+	})
+
+	t.Run("should return empty when advisory not inform id", func(t *testing.T) {
+		issue := Issue{}
+
+		assert.Empty(t, issue.GetRuleID())
+	})
+}
+
+func TestGetReferences(t *testing.T) {
+	t.Run("should return url of advisory and urls of references", func(t *testing.T) {
+		issue := Issue{URL: "https://npmjs.com/advisories/1523",
+			References: "- [CVE](https://nvd.nist.gov/vuln/detail/CVE-2019-10744)\n- [Snyk](https://snyk.io/vuln/SNYK-JS-LODASH-450202)"}
+
+		assert.Equal(t, []string{"https://npmjs.com/advisories/1523", "https://nvd.nist.gov/vuln/detail/CVE-2019-10744",
+			"https://snyk.io/vuln/SNYK-JS-LODASH-450202"}, issue.GetReferences())
+	})
+}
@@ -53,7 +53,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startYarnAudit(projectSubPath), tools.YarnAudit, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.YarnAudit)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startYarnAudit(projectSubPath string) error {
@@ -131,12 +130,16 @@ func (f *Formatter) processOutput(output *entities.Output) {
 	}
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilitySeverityData(output *entities.Issue) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilitySeverity()
 	data.Severity = output.GetSeverity()
 	data.Details = output.Overview
 	data.Code = output.ModuleName
 	data.Line = f.getVulnerabilityLineByName(data.Code, output.GetVersion(), data.File)
+	data.SetClassification(output.GetCWEs()...)
+	data.SetRule(output.GetRuleID(), output.Title)
+	data.SetRemediation(output.Recommendation, output.GetReferences()...)
 	data = vulnHash.Bind(data)
 	return f.SetCommitAuthor(data)
 }
@@ -0,0 +1,126 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecgithistory
+
+import (
+	"strconv"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/cwe"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	engineenums "github.com/ZupIT/horusec/development-kit/pkg/enums/engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/remediation"
+	vulnhash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+)
+
+type Formatter struct {
+	formatters.IService
+	leaks.Interface
+}
+
+func NewFormatter(service formatters.IService) formatters.IFormatter {
+	return &Formatter{
+		service,
+		leaks.NewRules(),
+	}
+}
+
+func (f *Formatter) StartAnalysis(projectSubPath string) {
+	if f.ToolIsToIgnore(tools.HorusecGitHistory) {
+		logger.LogDebugWithLevel(messages.MsgDebugToolIgnored + tools.HorusecGitHistory.ToString())
+		return
+	}
+
+	f.SetAnalysisError(f.execEngineAndParseResults(), tools.HorusecGitHistory, projectSubPath)
+	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecGitHistory)
+}
+
+func (f *Formatter) execEngineAndParseResults() error {
+	f.LogDebugWithReplace(messages.MsgDebugToolStartAnalysis, tools.HorusecGitHistory)
+
+	allRules := append(f.GetAllRules(), f.GetCustomRulesByTool(tools.HorusecLeaks)...)
+	return f.WalkGitHistory(func(commit *history.Commit) error {
+		findings, err := f.execEngineAnalysis(commit, allRules)
+		if err != nil {
+			return err
+		}
+
+		f.setCommitFindingsIntoAnalysis(commit, findings)
+		return nil
+	})
+}
+
+func (f *Formatter) execEngineAnalysis(commit *history.Commit, allRules []engine.Rule) ([]engine.Finding, error) {
+	textUnit := text.TextUnit{}
+	for _, file := range commit.Files {
+		textFile, err := text.NewTextFile(file.Path, file.Content())
+		if err != nil {
+			return nil, err
+		}
+
+		textUnit.Files = append(textUnit.Files, textFile)
+	}
+
+	findings := engine.RunMaxUnitsByAnalysis([]engine.Unit{textUnit}, allRules,
+		engineenums.DefaultMaxUnitsPerAnalysis)
+	return f.ValidateFindings(findings), nil
+}
+
+func (f *Formatter) setCommitFindingsIntoAnalysis(commit *history.Commit, findings []engine.Finding) {
+	for index := range findings {
+		vulnerability := f.setupVulnerability(&findings[index])
+		vulnerability = f.setCommitAuthor(vulnerability, commit.ToCommitAuthor())
+		f.AddNewVulnerabilityIntoAnalysis(vulnhash.Bind(vulnerability))
+	}
+}
+
+//nolint:funlen parse struct is necessary > 15 lines
+func (f *Formatter) setupVulnerability(finding *engine.Finding) *horusec.Vulnerability {
+	vulnerability := &horusec.Vulnerability{
+		Line:         strconv.Itoa(finding.SourceLocation.Line),
+		Column:       strconv.Itoa(finding.SourceLocation.Column),
+		Confidence:   finding.Confidence,
+		File:         finding.SourceLocation.Filename,
+		Code:         f.GetCodeWithMaxCharacters(finding.CodeSample, finding.SourceLocation.Column),
+		Details:      finding.Name + "\n" + finding.Description,
+		SecurityTool: tools.HorusecGitHistory,
+		Language:     languages.Leaks,
+		Severity:     severity.ParseStringToSeverity(finding.Severity),
+	}
+
+	vulnerability.SetClassification(cwe.GetCWEs(finding.ID, finding.Description)...)
+	vulnerability.SetRule(finding.ID, finding.Name)
+	vulnerability.SetRemediation("", remediation.GetURLsFromText(finding.Description)...)
+	return vulnerability
+}
+
+func (f *Formatter) setCommitAuthor(vulnerability *horusec.Vulnerability,
+	commitAuthor horusec.CommitAuthor) *horusec.Vulnerability {
+	vulnerability.CommitAuthor = commitAuthor.Author
+	vulnerability.CommitEmail = commitAuthor.Email
+	vulnerability.CommitHash = commitAuthor.CommitHash
+	vulnerability.CommitMessage = commitAuthor.Message
+	vulnerability.CommitDate = commitAuthor.Date
+	return vulnerability
+}
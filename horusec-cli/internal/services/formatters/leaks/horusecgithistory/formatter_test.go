@@ -0,0 +1,146 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecgithistory
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+func createRepositoryWithRemovedSecret(t *testing.T) string {
+	projectPath, err := ioutil.TempDir("", "horusec-git-history")
+	assert.NoError(t, err)
+
+	runGit := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=horusec", "-c",
+			"user.email=horusec@zup.com.br"}, args...)...)
+		cmd.Dir = projectPath
+		output, err := cmd.CombinedOutput()
+		assert.NoError(t, err, string(output))
+	}
+
+	configPath := filepath.Join(projectPath, "config.env")
+	runGit("init")
+	assert.NoError(t, ioutil.WriteFile(configPath, []byte("USER=horusec\nAWS_KEY=AKIA2E0A8F3B244C9986\n"), 0600))
+	runGit("add", ".")
+	runGit("commit", "-m", "add aws configuration")
+	assert.NoError(t, ioutil.WriteFile(configPath, []byte("USER=horusec\n"), 0600))
+	runGit("commit", "-am", "remove aws key")
+	return projectPath
+}
+
+func TestStartAnalysis(t *testing.T) {
+	t.Run("should add vulnerabilities of secrets removed from current code with commit data", func(t *testing.T) {
+		projectPath := createRepositoryWithRemovedSecret(t)
+		defer func() {
+			_ = os.RemoveAll(projectPath)
+		}()
+
+		config := &cliConfig.Config{}
+		config.SetProjectPath(projectPath)
+		analysis := &horusec.Analysis{}
+		service := formatters.NewFormatterService(analysis, &docker.Mock{}, config)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Empty(t, analysis.Errors)
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, tools.HorusecGitHistory, vulnerability.SecurityTool)
+		assert.Equal(t, "config.env", vulnerability.File)
+		assert.Equal(t, "2", vulnerability.Line)
+		assert.Equal(t, "horusec", vulnerability.CommitAuthor)
+		assert.Equal(t, "horusec@zup.com.br", vulnerability.CommitEmail)
+		assert.Equal(t, "add aws configuration", vulnerability.CommitMessage)
+		assert.NotEmpty(t, vulnerability.CommitHash)
+		assert.NotEmpty(t, vulnerability.CommitDate)
+		assert.NotEmpty(t, vulnerability.VulnHash)
+	})
+
+	t.Run("should analyze only commits of history range configured", func(t *testing.T) {
+		projectPath := createRepositoryWithRemovedSecret(t)
+		defer func() {
+			_ = os.RemoveAll(projectPath)
+		}()
+
+		config := &cliConfig.Config{}
+		config.SetProjectPath(projectPath)
+		config.SetGitHistoryRange("HEAD~1..HEAD")
+		analysis := &horusec.Analysis{}
+		service := formatters.NewFormatterService(analysis, &docker.Mock{}, config)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Empty(t, analysis.Errors)
+		assert.Empty(t, analysis.AnalysisVulnerabilities)
+	})
+
+	t.Run("should success execute analysis with commits mocked", func(t *testing.T) {
+		commit := &history.Commit{Hash: "abc123", Author: "horusec"}
+		commit.AddLine("config.env", 3, "AWS_KEY=AKIA2E0A8F3B244C9986")
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("WalkGitHistory").Return([]*history.Commit{commit}, nil)
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{})
+		service.On("GetCodeWithMaxCharacters").Return("")
+		service.On("AddNewVulnerabilityIntoAnalysis")
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+		service.AssertNumberOfCalls(t, "AddNewVulnerabilityIntoAnalysis", 1)
+	})
+
+	t.Run("should return error when getting git history", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("WalkGitHistory").Return([]*history.Commit{}, errors.New("test"))
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{})
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+		service.AssertNotCalled(t, "AddNewVulnerabilityIntoAnalysis")
+	})
+
+	t.Run("should ignore this tool", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("ToolIsToIgnore").Return(true)
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+}
@@ -45,7 +45,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.execEngineAndParseResults(projectSubPath), tools.HorusecLeaks, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecLeaks)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) execEngineAndParseResults(projectSubPath string) error {
@@ -66,5 +65,6 @@ func (f *Formatter) execEngineAnalysis(projectSubPath string) ([]engine.Finding,
 	}
 
 	allRules := append(f.GetAllRules(), f.GetCustomRulesByTool(tools.HorusecLeaks)...)
-	return engine.RunMaxUnitsByAnalysis(textUnit, allRules, engineenums.DefaultMaxUnitsPerAnalysis), nil
+	findings := engine.RunMaxUnitsByAnalysis(textUnit, allRules, engineenums.DefaultMaxUnitsPerAnalysis)
+	return f.ValidateFindings(findings), nil
 }
@@ -10,6 +10,7 @@ type Message struct {
 	Line    int    `json:"line"`
 	Column  int    `json:"column"`
 	Type    string `json:"type"`
+	Source  string `json:"source"`
 }
 
 func (m *Message) GetLine() string {
@@ -50,7 +50,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startPhpCs(projectSubPath), tools.PhpCS, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.PhpCS)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startPhpCs(projectSubPath string) error {
@@ -108,6 +107,7 @@ func (f *Formatter) setVulnerabilityData(filepath string, result entities.Messag
 	vulnerability.Line = result.GetLine()
 	vulnerability.Column = result.GetColumn()
 	vulnerability.File = f.RemoveSrcFolderFromPath(filepath)
+	vulnerability.SetRule(result.Source, "")
 	vulnerability = vulnhash.Bind(vulnerability)
 	return f.SetCommitAuthor(vulnerability)
 }
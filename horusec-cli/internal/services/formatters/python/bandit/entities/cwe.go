@@ -0,0 +1,92 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+type CWE struct {
+	ID   int    `json:"id"`
+	Link string `json:"link"`
+}
+
+// nolint:funlen mapping table of tests is necessary more 15 lines
+func cwesByTestID() map[string][]string {
+	return map[string][]string{
+		"B101": {"703"},
+		"B102": {"78"},
+		"B103": {"732"},
+		"B104": {"605"},
+		"B105": {"259"},
+		"B106": {"259"},
+		"B107": {"259"},
+		"B108": {"377"},
+		"B110": {"703"},
+		"B112": {"703"},
+		"B201": {"94"},
+		"B301": {"502"},
+		"B302": {"502"},
+		"B303": {"327"},
+		"B304": {"327"},
+		"B305": {"327"},
+		"B306": {"377"},
+		"B307": {"78"},
+		"B308": {"79"},
+		"B310": {"22"},
+		"B311": {"330"},
+		"B312": {"319"},
+		"B313": {"20"},
+		"B314": {"20"},
+		"B315": {"20"},
+		"B316": {"20"},
+		"B317": {"20"},
+		"B318": {"20"},
+		"B319": {"20"},
+		"B320": {"20"},
+		"B321": {"319"},
+		"B323": {"295"},
+		"B324": {"327"},
+		"B401": {"319"},
+		"B402": {"319"},
+		"B403": {"502"},
+		"B404": {"78"},
+		"B405": {"20"},
+		"B406": {"20"},
+		"B407": {"20"},
+		"B408": {"20"},
+		"B409": {"20"},
+		"B410": {"20"},
+		"B411": {"20"},
+		"B413": {"327"},
+		"B501": {"295"},
+		"B502": {"327"},
+		"B503": {"327"},
+		"B504": {"327"},
+		"B505": {"326"},
+		"B506": {"20"},
+		"B507": {"295"},
+		"B601": {"78"},
+		"B602": {"78"},
+		"B603": {"78"},
+		"B604": {"78"},
+		"B605": {"78"},
+		"B606": {"78"},
+		"B607": {"78"},
+		"B608": {"89"},
+		"B609": {"78"},
+		"B610": {"89"},
+		"B611": {"89"},
+		"B701": {"94"},
+		"B702": {"80"},
+		"B703": {"80"},
+	}
+}
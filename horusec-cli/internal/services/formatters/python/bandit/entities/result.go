@@ -1,6 +1,10 @@
 package entities
 
-import "github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+import (
+	"strconv"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+)
 
 type Result struct {
 	Code            string            `json:"code"`
@@ -13,6 +17,16 @@ type Result struct {
 	MoreInfo        string            `json:"more_info"`
 	TestID          string            `json:"test_id"`
 	TestName        string            `json:"test_name"`
+	IssueCWE        CWE               `json:"issue_cwe"`
+}
+
+// GetCWEs returns the cwe informed by bandit, older versions not inform it so it is mapped by test id
+func (r *Result) GetCWEs() []string {
+	if r.IssueCWE.ID > 0 {
+		return []string{strconv.Itoa(r.IssueCWE.ID)}
+	}
+
+	return cwesByTestID()[r.TestID]
 }
 
 func (r *Result) GetFile() string {
@@ -50,7 +50,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startBandit(projectSubPath), tools.Bandit, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Bandit)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startBandit(projectSubPath string) error {
@@ -110,6 +109,7 @@ func (f *Formatter) setBanditOutPutInHorusecAnalysis(issues []entities.Result) {
 	}
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setupVulnerabilitiesSeveritiesBandit(
 	issues []entities.Result, index int) *horusec.Vulnerability {
 	vulnerabilitySeverity := f.getDefaultVulnerabilitySeverity()
@@ -119,6 +119,9 @@ func (f *Formatter) setupVulnerabilitiesSeveritiesBandit(
 	vulnerabilitySeverity.Line = strconv.Itoa(issues[index].LineNumber)
 	vulnerabilitySeverity.Confidence = issues[index].IssueConfidence
 	vulnerabilitySeverity.File = issues[index].GetFile()
+	vulnerabilitySeverity.SetClassification(issues[index].GetCWEs()...)
+	vulnerabilitySeverity.SetRule(issues[index].TestID, issues[index].TestName)
+	vulnerabilitySeverity.SetRemediation("", issues[index].MoreInfo, issues[index].IssueCWE.Link)
 	vulnerabilitySeverity = hash.Bind(vulnerabilitySeverity)
 	return f.SetCommitAuthor(vulnerabilitySeverity)
 }
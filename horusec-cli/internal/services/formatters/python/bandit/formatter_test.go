@@ -26,6 +26,7 @@ import (
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -45,7 +46,7 @@ func TestNewFormatter(t *testing.T) {
 	config := &cliConfig.Config{}
 	config.SetWorkDir(&workdir.WorkDir{})
 
-	service := formatters.NewFormatterService(nil, nil, config, &horusec.Monitor{})
+	service := formatters.NewFormatterService(nil, nil, config)
 
 	assert.IsType(t, NewFormatter(service), &Formatter{})
 }
@@ -61,7 +62,7 @@ func TestFormatter_StartSafety(t *testing.T) {
 		dockerAPIControllerMock.On("SetAnalysisID")
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("", errors.New("Error"))
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -81,7 +82,7 @@ func TestFormatter_StartSafety(t *testing.T) {
 		dockerAPIControllerMock.On("SetAnalysisID")
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -101,7 +102,7 @@ func TestFormatter_StartSafety(t *testing.T) {
 		dockerAPIControllerMock.On("SetAnalysisID")
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -110,6 +111,52 @@ func TestFormatter_StartSafety(t *testing.T) {
 		})
 	})
 
+	t.Run("Should classify vulnerabilities by cwe of output or test id", func(t *testing.T) {
+		analysis := getAnalysis()
+
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+
+		output := `{"results": [{"code": "7 exec(command)\n","filename": "./main.py","line_number": 7,"issue_severity": "MEDIUM","issue_text": "Use of exec detected.","test_id": "B102","issue_cwe": {"id": 78, "link": "https://cwe.mitre.org/data/definitions/78.html"}},` +
+			`{"code": "8 query = 'SELECT * FROM users WHERE id = ' + id\n","filename": "./main.py","line_number": 8,"issue_severity": "MEDIUM","issue_text": "Possible SQL injection vector through string-based query construction.","test_id": "B608"}]}`
+		dockerAPIControllerMock := &docker.Mock{}
+		dockerAPIControllerMock.On("SetAnalysisID")
+		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
+
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 2)
+		assert.Equal(t, pq.StringArray{"CWE-78"}, analysis.AnalysisVulnerabilities[0].Vulnerability.CWEs)
+		assert.Equal(t, pq.StringArray{"CWE-89"}, analysis.AnalysisVulnerabilities[1].Vulnerability.CWEs)
+		assert.Equal(t, pq.StringArray{"A03:2021-Injection"}, analysis.AnalysisVulnerabilities[1].Vulnerability.OWASPTop10)
+	})
+
+	t.Run("Should set rule information and references of output", func(t *testing.T) {
+		analysis := getAnalysis()
+
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+
+		output := `{"results": [{"code": "7 exec(command)\n","filename": "./main.py","line_number": 7,"issue_severity": "MEDIUM","issue_text": "Use of exec detected.","test_id": "B102","test_name": "exec_used","more_info": "https://bandit.readthedocs.io/en/latest/plugins/b102_exec_used.html","issue_cwe": {"id": 78, "link": "https://cwe.mitre.org/data/definitions/78.html"}}]}`
+		dockerAPIControllerMock := &docker.Mock{}
+		dockerAPIControllerMock.On("SetAnalysisID")
+		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
+
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "B102", vulnerability.RuleID)
+		assert.Equal(t, "exec_used", vulnerability.Title)
+		assert.NotEmpty(t, vulnerability.Remediation)
+		assert.Equal(t, pq.StringArray{"https://bandit.readthedocs.io/en/latest/plugins/b102_exec_used.html",
+			"https://cwe.mitre.org/data/definitions/78.html"}, vulnerability.References)
+	})
+
 	t.Run("Should return analysis bandit without error with issue of informative", func(t *testing.T) {
 		analysis := getAnalysis()
 
@@ -121,7 +168,7 @@ func TestFormatter_StartSafety(t *testing.T) {
 		dockerAPIControllerMock.On("SetAnalysisID")
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -140,7 +187,7 @@ func TestFormatter_StartSafety(t *testing.T) {
 		dockerAPIControllerMock.On("SetAnalysisID")
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("some aleatory text", nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -153,7 +200,7 @@ func TestFormatter_StartSafety(t *testing.T) {
 		dockerAPIControllerMock := &docker.Mock{}
 		config := &cliConfig.Config{}
 		config.SetToolsToIgnore([]string{"GoSec", "SecurityCodeScan", "Brakeman", "Safety", "Bandit", "NpmAudit", "YarnAudit", "SpotBugs", "HorusecKotlin", "HorusecJava", "HorusecLeaks", "GitLeaks", "TfSec", "Semgrep", "HorusecCsharp", "HorusecKubernetes", "Eslint", "HorusecNodeJS", "Flawfinder", "PhpCS", "Eslint", "HorusecNodeJS", "Flawfinder", "PhpCS"})
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
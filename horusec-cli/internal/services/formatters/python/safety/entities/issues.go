@@ -1,5 +1,7 @@
 package entities
 
+import "fmt"
+
 type Issue struct {
 	Dependency       string `json:"dependency"`
 	VulnerableBelow  string `json:"vulnerable_below"`
@@ -7,3 +9,12 @@ type Issue struct {
 	Description      string `json:"description"`
 	ID               string `json:"id"`
 }
+
+func (i *Issue) GetRemediation() string {
+	if i.VulnerableBelow == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("Update %s from version %s to a version outside of the vulnerable range %s.",
+		i.Dependency, i.InstalledVersion, i.VulnerableBelow)
+}
@@ -28,9 +28,11 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
 	fileUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	jsonUtils "github.com/ZupIT/horusec/development-kit/pkg/utils/json"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/remediation"
 	hash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
@@ -56,7 +58,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startSafety(projectSubPath), tools.Safety, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Safety)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startSafety(projectSubPath string) error {
@@ -107,13 +108,11 @@ func (f *Formatter) parseOutputToSafetyOutput(output string) (safetyOutput entit
 func (f *Formatter) setSafetyOutPutInHorusecAnalysis(issues []entities.Issue) {
 	for index := range issues {
 		vulnerability := f.setupVulnerabilitiesSeveritiesSafety(issues, index)
-		f.GetAnalysis().AnalysisVulnerabilities = append(f.GetAnalysis().AnalysisVulnerabilities,
-			horusec.AnalysisVulnerabilities{
-				Vulnerability: *vulnerability,
-			})
+		f.AddNewVulnerabilityIntoAnalysis(vulnerability)
 	}
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setupVulnerabilitiesSeveritiesSafety(
 	issues []entities.Issue, index int) *horusec.Vulnerability {
 	lineContent := fmt.Sprintf("%s=%s", issues[index].Dependency, issues[index].InstalledVersion)
@@ -122,6 +121,10 @@ func (f *Formatter) setupVulnerabilitiesSeveritiesSafety(
 	vulnerabilitySeverity.Details = issues[index].Description
 	vulnerabilitySeverity.Code = f.GetCodeWithMaxCharacters(issues[index].Dependency, 0)
 	vulnerabilitySeverity.Line = f.getVulnerabilityLineByName(lineContent, vulnerabilitySeverity.File)
+	vulnerabilitySeverity.SetClassification(classification.CWEVulnerableThirdPartyComponent)
+	vulnerabilitySeverity.SetRule(issues[index].ID, "")
+	vulnerabilitySeverity.SetRemediation(issues[index].GetRemediation(),
+		remediation.GetURLsFromText(issues[index].Description)...)
 	vulnerabilitySeverity = hash.Bind(vulnerabilitySeverity)
 	return f.setCommitAuthor(vulnerabilitySeverity)
 }
@@ -0,0 +1,53 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entities
+
+// nolint:funlen mapping table of warning types is necessary more 15 lines
+func cwesByWarningType() map[string][]string {
+	return map[string][]string{
+		"Attribute Restriction":      {"915"},
+		"Authentication":             {"287"},
+		"Basic Auth":                 {"287"},
+		"Command Injection":          {"77"},
+		"Cross-Site Request Forgery": {"352"},
+		"Cross-Site Scripting":       {"79"},
+		"Dangerous Eval":             {"913", "95"},
+		"Dangerous Send":             {"77"},
+		"Default Routes":             {"22"},
+		"Denial of Service":          {"400"},
+		"Divide by Zero":             {"369"},
+		"Dynamic Render Path":        {"22"},
+		"File Access":                {"22"},
+		"Format Validation":          {"777"},
+		"Information Disclosure":     {"200"},
+		"Link to Href":               {"79"},
+		"Mail Link":                  {"79"},
+		"Mass Assignment":            {"915"},
+		"Missing Encryption":         {"311"},
+		"Nested Attributes":          {"20"},
+		"Redirect":                   {"601"},
+		"Remote Code Execution":      {"94"},
+		"Response Splitting":         {"113"},
+		"Reverse Tabnabbing":         {"1022"},
+		"Session Manipulation":       {"20"},
+		"Session Setting":            {"565"},
+		"SQL Injection":              {"89"},
+		"SSL Verification Bypass":    {"295"},
+		"Unmaintained Dependency":    {"1104"},
+		"Unsafe Reflection":          {"470"},
+		"Unscoped Find":              {"285"},
+		"Weak Hash":                  {"328"},
+	}
+}
@@ -29,6 +29,8 @@ type Warning struct {
 	Line       int    `json:"line"`
 	Details    string `json:"link"`
 	Confidence string `json:"confidence"`
+	CWEs       []int  `json:"cwe_id"`
+	CheckName  string `json:"check_name"`
 }
 
 const (
@@ -55,3 +57,16 @@ func (o *Warning) GetSeverity() severity.Severity {
 func (o *Warning) GetLine() string {
 	return strconv.Itoa(o.Line)
 }
+
+// GetCWEs returns the cwes informed by brakeman, older versions not inform it so it is mapped by warning type
+func (o *Warning) GetCWEs() (cwes []string) {
+	for _, cwe := range o.CWEs {
+		cwes = append(cwes, strconv.Itoa(cwe))
+	}
+
+	if len(cwes) > 0 {
+		return cwes
+	}
+
+	return cwesByWarningType()[o.Type]
+}
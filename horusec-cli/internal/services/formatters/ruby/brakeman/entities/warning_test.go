@@ -58,3 +58,23 @@ func TestGetLine(t *testing.T) {
 		assert.Equal(t, "123", output.GetLine())
 	})
 }
+
+func TestGetCWEs(t *testing.T) {
+	t.Run("Should return cwes informed by brakeman", func(t *testing.T) {
+		output := Warning{Type: "SQL Injection", CWEs: []int{89, 564}}
+
+		assert.Equal(t, []string{"89", "564"}, output.GetCWEs())
+	})
+
+	t.Run("Should return cwes mapped by warning type", func(t *testing.T) {
+		output := Warning{Type: "SQL Injection"}
+
+		assert.Equal(t, []string{"89"}, output.GetCWEs())
+	})
+
+	t.Run("Should return empty when warning type is not mapped", func(t *testing.T) {
+		output := Warning{Type: "Unknown"}
+
+		assert.Empty(t, output.GetCWEs())
+	})
+}
@@ -50,7 +50,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startBrakeman(projectSubPath), tools.Brakeman, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.Brakeman)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startBrakeman(projectSubPath string) error {
@@ -92,6 +91,7 @@ func (f *Formatter) newContainerOutputFromString(containerOutput string) (output
 	return output, err
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilityData(output *entities.Warning) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilitySeverity()
 	data.Severity = output.GetSeverity()
@@ -100,6 +100,9 @@ func (f *Formatter) setVulnerabilityData(output *entities.Warning) *horusec.Vuln
 	data.Line = output.GetLine()
 	data.File = output.File
 	data.Code = f.GetCodeWithMaxCharacters(output.Code, 0)
+	data.SetClassification(output.GetCWEs()...)
+	data.SetRule(output.CheckName, output.Type)
+	data.SetRemediation("", output.Details)
 	data = hash.Bind(data)
 	return f.SetCommitAuthor(data)
 }
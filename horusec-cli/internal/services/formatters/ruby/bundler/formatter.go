@@ -23,6 +23,7 @@ import (
 
 	"github.com/ZupIT/horusec/horusec-cli/internal/enums/images"
 
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
 	fileUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
@@ -55,7 +56,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startBundlerAudit(projectSubPath), tools.BundlerAudit, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.BundlerAudit)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startBundlerAudit(projectSubPath string) error {
@@ -128,12 +128,16 @@ func (f *Formatter) setOutput(outputSplit string) {
 	f.AddNewVulnerabilityIntoAnalysis(f.setVulnerabilityData(output))
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilityData(output *entities.Output) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilitySeverity()
 	data.Severity = output.GetSeverity()
 	data.Details = output.GetDetails()
 	data.File = f.GetFilepathFromFilename("Gemfile.lock")
 	data.Code = f.GetCodeWithMaxCharacters(output.Name, 0)
+	data.SetClassification(classification.CWEVulnerableThirdPartyComponent)
+	data.SetRule(output.Advisory, output.Title)
+	data.SetRemediation(output.Solution, output.URL)
 	data = hash.Bind(data)
 	data.Line = f.getVulnerabilityLineByName(data.Code, data.File)
 	return f.SetCommitAuthor(data)
@@ -40,7 +40,7 @@ func TestParseOutput(t *testing.T) {
 
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -51,6 +51,34 @@ func TestParseOutput(t *testing.T) {
 		assert.Len(t, analysis.AnalysisVulnerabilities, 2)
 	})
 
+	t.Run("Should set advisory, solution and url of output", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+
+		dockerAPIControllerMock := &docker.Mock{}
+		dockerAPIControllerMock.On("SetAnalysisID")
+
+		output := "Name: actionpack\r\nVersion: 6.0.0\r\nAdvisory: CVE-2020-8164\r\nCriticality: Unknown\r\n" +
+			"URL: https://groups.google.com/forum/#!topic/rubyonrails-security/f6ioe4sdpbY\r\n" +
+			"Title: Possible Strong Parameters Bypass in ActionPack\r\nSolution: upgrade to ~> 5.2.4.3, >= 6.0.3.1\r\n"
+
+		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return(output, nil)
+
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
+
+		NewFormatter(service).StartAnalysis("")
+
+		assert.Len(t, analysis.AnalysisVulnerabilities, 1)
+		vulnerability := analysis.AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "CVE-2020-8164", vulnerability.RuleID)
+		assert.Equal(t, "Possible Strong Parameters Bypass in ActionPack", vulnerability.Title)
+		assert.Equal(t, "upgrade to ~> 5.2.4.3, >= 6.0.3.1", vulnerability.Remediation)
+		assert.Contains(t, vulnerability.References,
+			"https://groups.google.com/forum/#!topic/rubyonrails-security/f6ioe4sdpbY")
+	})
+
 	t.Run("Should return error when parsing invalid output", func(t *testing.T) {
 		analysis := &horusec.Analysis{}
 
@@ -61,7 +89,7 @@ func TestParseOutput(t *testing.T) {
 		dockerAPIControllerMock.On("SetAnalysisID")
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("invalid output", nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -79,7 +107,7 @@ func TestParseOutput(t *testing.T) {
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").
 			Return("No such file or directory Errno::ENOENT", nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -97,7 +125,7 @@ func TestParseOutput(t *testing.T) {
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").
 			Return("No vulnerabilities found", nil)
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -113,7 +141,7 @@ func TestParseOutput(t *testing.T) {
 		config := &cliConfig.Config{}
 		config.SetWorkDir(&workdir.WorkDir{})
 
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 
 		formatter := NewFormatter(service)
 
@@ -125,7 +153,7 @@ func TestParseOutput(t *testing.T) {
 		dockerAPIControllerMock := &docker.Mock{}
 		config := &cliConfig.Config{}
 		config.SetToolsToIgnore([]string{"BundlerAudit"})
-		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config, &horusec.Monitor{})
+		service := formatters.NewFormatterService(analysis, dockerAPIControllerMock, config)
 		formatter := NewFormatter(service)
 
 		formatter.StartAnalysis("")
@@ -0,0 +1,106 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecrust
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/rust"
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	examplePath     = "../../../../../../examples/rust/example1"
+	advisoryDBPath  = "../../../../../../examples/rust/advisory-db"
+	exampleLockFile = "Cargo.lock"
+)
+
+func TestStartAnalysis(t *testing.T) {
+	t.Run("should success execute analysis without errors", func(t *testing.T) {
+		analysis := &horusec.Analysis{}
+		service := &formatters.Mock{}
+
+		service.On("LogDebugWithReplace")
+		service.On("SetAnalysisError")
+		service.On("ToolIsToIgnore").Return(false)
+		service.On("GetProjectPathWithWorkdir").Return(examplePath)
+		service.On("GetRustAdvisoryDBPath").Return(advisoryDBPath)
+		service.On("ParseFindingsToVulnerabilities").Return(nil)
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+
+		assert.Empty(t, len(analysis.Errors))
+	})
+
+	t.Run("should find advisories of crates locked in example", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("GetProjectPathWithWorkdir").Return(examplePath)
+		service.On("GetRustAdvisoryDBPath").Return(advisoryDBPath)
+
+		findings, err := NewFormatter(service).(*Formatter).execEngineAnalysis("")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, findings)
+
+		ids := map[string]bool{}
+		for index := range findings {
+			assert.Equal(t, exampleLockFile, filepath.Base(findings[index].SourceLocation.Filename))
+			ids[findings[index].ID] = true
+		}
+		assert.True(t, ids["RUSTSEC-2020-0071"])
+		assert.True(t, ids["RUSTSEC-2021-0078"])
+	})
+
+	t.Run("should return error when advisory database not exists", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("GetProjectPathWithWorkdir").Return(examplePath)
+		service.On("GetRustAdvisoryDBPath").Return(filepath.Join(t.TempDir(), "advisory-db"))
+
+		_, err := NewFormatter(service).(*Formatter).execEngineAnalysis("")
+
+		assert.ErrorIs(t, err, rust.ErrAdvisoryDatabaseNotFound)
+	})
+
+	t.Run("should skip without error when advisory database is not configured", func(t *testing.T) {
+		service := &formatters.Mock{}
+		_ = os.Setenv("CARGO_HOME", t.TempDir())
+		defer func() { _ = os.Unsetenv("CARGO_HOME") }()
+
+		service.On("LogDebugWithReplace")
+		service.On("GetProjectPathWithWorkdir").Return(examplePath)
+		service.On("GetRustAdvisoryDBPath").Return("")
+
+		assert.NoError(t, NewFormatter(service).(*Formatter).execEngineAndParseResults(""))
+		service.AssertNotCalled(t, "ParseFindingsToVulnerabilities")
+	})
+
+	t.Run("should ignore this tool", func(t *testing.T) {
+		service := &formatters.Mock{}
+
+		service.On("ToolIsToIgnore").Return(true)
+
+		assert.NotPanics(t, func() {
+			NewFormatter(service).StartAnalysis("")
+		})
+	})
+}
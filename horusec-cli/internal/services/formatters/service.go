@@ -15,12 +15,15 @@
 package formatters
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
 	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/cwe"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
@@ -28,39 +31,53 @@ import (
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	fileUtil "github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/remediation"
 	hash "github.com/ZupIT/horusec/development-kit/pkg/utils/vuln_hash"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 	customRules "github.com/ZupIT/horusec/horusec-cli/internal/services/custom_rules"
 	dockerService "github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/git"
+	horusecIgnore "github.com/ZupIT/horusec/horusec-cli/internal/services/horusec_ignore"
 )
 
 type Service struct {
-	analysis           *horusec.Analysis
-	docker             dockerService.Interface
-	gitService         git.IService
-	monitor            *horusec.Monitor
-	config             cliConfig.IConfig
-	customRulesService customRules.IService
+	ctx                  context.Context
+	analysis             *horusec.Analysis
+	docker               dockerService.Interface
+	gitService           git.IService
+	config               cliConfig.IConfig
+	customRulesService   customRules.IService
+	horusecIgnoreService horusecIgnore.IService
+	changes              *diff.Diff
 }
 
-func NewFormatterService(analysis *horusec.Analysis, docker dockerService.Interface, config cliConfig.IConfig,
-	monitor *horusec.Monitor) IService {
+func NewFormatterService(analysis *horusec.Analysis, docker dockerService.Interface,
+	config cliConfig.IConfig) IService {
 	return &Service{
-		analysis:           analysis,
-		docker:             docker,
-		gitService:         git.NewGitService(config),
-		monitor:            monitor,
-		config:             config,
-		customRulesService: customRules.NewCustomRulesService(config),
+		ctx:                  context.Background(),
+		analysis:             analysis,
+		docker:               docker,
+		gitService:           git.NewGitService(config),
+		config:               config,
+		customRulesService:   customRules.NewCustomRulesService(config),
+		horusecIgnoreService: horusecIgnore.NewHorusecIgnoreService(config),
 	}
 }
 
 func (s *Service) ExecuteContainer(data *dockerEntities.AnalysisData) (output string, err error) {
-	return s.docker.CreateLanguageAnalysisContainer(data)
+	return s.docker.CreateLanguageAnalysisContainer(s.ctx, data)
+}
+
+// WithContext return a copy of service where containers and vulnerabilities are discarded when ctx is done
+func (s *Service) WithContext(ctx context.Context) IService {
+	service := *s
+	service.ctx = ctx
+	return &service
 }
 
 func (s *Service) GetAnalysisIDErrorMessage(tool tools.Tool, output string) string {
@@ -74,6 +91,12 @@ func (s *Service) GetCommitAuthor(line, filePath string) (commitAuthor horusec.C
 	return s.gitService.GetCommitAuthor(line, filePath)
 }
 
+// WalkGitHistory calls walkFn with the lines added by each commit of project inside the git history range and depth
+// configured, one commit at a time
+func (s *Service) WalkGitHistory(walkFn func(commit *history.Commit) error) error {
+	return s.gitService.WalkHistory(s.config.GetGitHistoryRange(), s.config.GetGitHistoryDepth(), walkFn)
+}
+
 func (s *Service) GetConfigProjectPath() string {
 	return file.ReplacePathSeparator(
 		fmt.Sprintf(
@@ -89,6 +112,10 @@ func (s *Service) GetToolsConfig() toolsconfig.MapToolConfig {
 	return s.config.GetToolsConfig()
 }
 
+func (s *Service) GetRustAdvisoryDBPath() string {
+	return s.config.GetRustAdvisoryDBPath()
+}
+
 func (s *Service) AddWorkDirInCmd(cmd, projectSubPath string, tool tools.Tool) string {
 	if projectSubPath != "" {
 		logger.LogDebugWithLevel(messages.MsgDebugShowWorkdir, tool.ToString(), projectSubPath)
@@ -111,8 +138,9 @@ func (s *Service) GetAnalysis() *horusec.Analysis {
 	return s.analysis
 }
 
+// SetAnalysisError is ignored when the service context is done, so a tool canceled by timeout not change the analysis
 func (s *Service) SetAnalysisError(err error, tool tools.Tool, projectSubPath string) {
-	if err != nil {
+	if err != nil && s.ctx.Err() == nil {
 		s.analysis.SetAnalysisError(err)
 		msg := s.GetAnalysisIDErrorMessage(tool, "")
 		if projectSubPath != "" {
@@ -124,14 +152,6 @@ func (s *Service) SetAnalysisError(err error, tool tools.Tool, projectSubPath st
 	}
 }
 
-func (s *Service) SetToolFinishedAnalysis() {
-	s.monitor.RemoveProcess(1)
-}
-
-func (s *Service) SetMonitor(monitor *horusec.Monitor) {
-	s.monitor = monitor
-}
-
 func (s *Service) RemoveSrcFolderFromPath(filepath string) string {
 	if filepath == "" || len(filepath) <= 4 || !strings.Contains(filepath[:4], "src") {
 		return filepath
@@ -156,12 +176,10 @@ func (s *Service) ToolIsToIgnore(tool tools.Tool) bool {
 	// TODO method GetToolsToIgnore will deprecated in future
 	for _, toolToIgnore := range s.config.GetToolsToIgnore() {
 		if strings.EqualFold(toolToIgnore, tool.ToString()) {
-			s.SetToolFinishedAnalysis()
 			return true
 		}
 	}
 	if s.config.GetToolsConfig()[tool].IsToIgnore {
-		s.SetToolFinishedAnalysis()
 		return true
 	}
 	return false
@@ -227,15 +245,69 @@ func (s *Service) setVulnerabilityDataByFindings(findings []engine.Finding, inde
 }
 
 func (s *Service) AddNewVulnerabilityIntoAnalysis(vulnerability *horusec.Vulnerability) {
+	if s.ctx.Err() != nil {
+		logger.LogDebugWithLevel(messages.MsgDebugVulnerabilityAfterToolCanceled, vulnerability.SecurityTool)
+		return
+	}
+	if s.horusecIgnoreService.IsToIgnore(vulnerability.File) {
+		logger.LogDebugWithLevel(messages.MsgDebugFolderOrFileIgnored, vulnerability.File)
+		return
+	}
+	if s.isVulnerabilityOutOfDiff(vulnerability) {
+		logger.LogDebugWithLevel(messages.MsgDebugVulnerabilityOutOfDiff, vulnerability.File, vulnerability.Line)
+		return
+	}
+
+	s.setInlineSuppression(vulnerability)
 	s.GetAnalysis().AnalysisVulnerabilities = append(s.GetAnalysis().AnalysisVulnerabilities,
 		horusec.AnalysisVulnerabilities{
 			Vulnerability: *vulnerability,
 		})
 }
 
+// SetDiff restrict the vulnerabilities added in analysis to only lines changed against diff base. The files not
+// changed are not copied to the analysis folder, so the tools analyze only the changed files and here are removed
+// the findings in lines not changed
+func (s *Service) SetDiff(changes *diff.Diff) {
+	s.changes = changes
+}
+
+func (s *Service) isVulnerabilityOutOfDiff(vulnerability *horusec.Vulnerability) bool {
+	if s.changes == nil || vulnerability.File == "" {
+		return false
+	}
+
+	for _, path := range s.getDiffPaths(vulnerability) {
+		if s.changes.IsLineChanged(path, vulnerability.Line) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getDiffPaths returns the file of vulnerability and the file joined with the work dir sub paths of the language,
+// because some tools return the path relative to the sub path analyzed instead of the project path
+func (s *Service) getDiffPaths(vulnerability *horusec.Vulnerability) []string {
+	paths := []string{vulnerability.File}
+	workDir := s.config.GetWorkDir()
+	if workDir == nil {
+		return paths
+	}
+
+	for _, subPath := range workDir.GetArrayByLanguage(vulnerability.Language) {
+		if subPath != "" {
+			paths = append(paths, filepath.Join(subPath, vulnerability.File))
+		}
+	}
+
+	return paths
+}
+
+//nolint:funlen parse struct is necessary > 15 lines
 func (s *Service) setVulnerabilityDataByFindingIndex(findings []engine.Finding, index int, tool tools.Tool,
 	language languages.Language) *horusec.Vulnerability {
-	return &horusec.Vulnerability{
+	vulnerability := &horusec.Vulnerability{
 		Line:         strconv.Itoa(findings[index].SourceLocation.Line),
 		Column:       strconv.Itoa(findings[index].SourceLocation.Column),
 		Confidence:   findings[index].Confidence,
@@ -246,6 +318,11 @@ func (s *Service) setVulnerabilityDataByFindingIndex(findings []engine.Finding,
 		Language:     language,
 		Severity:     severity.ParseStringToSeverity(findings[index].Severity),
 	}
+
+	vulnerability.SetClassification(cwe.GetCWEs(findings[index].ID, findings[index].Description)...)
+	vulnerability.SetRule(findings[index].ID, findings[index].Name)
+	vulnerability.SetRemediation("", remediation.GetURLsFromText(findings[index].Description)...)
+	return vulnerability
 }
 
 func (s *Service) removeHorusecFolder(filepath string) string {
@@ -254,12 +331,7 @@ func (s *Service) removeHorusecFolder(filepath string) string {
 }
 
 func (s *Service) IsDockerDisabled() bool {
-	isDisabled := s.config.GetDisableDocker()
-	if isDisabled {
-		s.SetToolFinishedAnalysis()
-	}
-
-	return isDisabled
+	return s.config.GetDisableDocker()
 }
 
 func (s *Service) GetCustomRulesByTool(tool tools.Tool) []engine.Rule {
@@ -15,12 +15,16 @@
 package formatters
 
 import (
+	"context"
+
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	utilsMock "github.com/ZupIT/horusec/development-kit/pkg/utils/mock"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/toolsconfig"
 	"github.com/stretchr/testify/mock"
 )
@@ -53,6 +57,17 @@ func (m *Mock) GetCommitAuthor(_, _ string) (commitAuthor horusec.CommitAuthor)
 	return args.Get(0).(horusec.CommitAuthor)
 }
 
+func (m *Mock) WalkGitHistory(walkFn func(commit *history.Commit) error) error {
+	args := m.MethodCalled("WalkGitHistory")
+	for _, commit := range args.Get(0).([]*history.Commit) {
+		if err := walkFn(commit); err != nil {
+			return err
+		}
+	}
+
+	return utilsMock.ReturnNilOrError(args, 1)
+}
+
 func (m *Mock) AddWorkDirInCmd(_ string, _ string, _ tools.Tool) string {
 	args := m.MethodCalled("AddWorkDirInCmd")
 	return args.Get(0).(string)
@@ -68,16 +83,17 @@ func (m *Mock) GetAnalysis() *horusec.Analysis {
 	return args.Get(0).(*horusec.Analysis)
 }
 
-func (m *Mock) SetToolFinishedAnalysis() {
-	_ = m.MethodCalled("SetToolFinishedAnalysis")
-}
-
 func (m *Mock) SetAnalysisError(_ error, _ tools.Tool, _ string) {
 	_ = m.MethodCalled("SetAnalysisError")
 }
 
-func (m *Mock) SetMonitor(_ *horusec.Monitor) {
-	_ = m.MethodCalled("SetMonitor")
+func (m *Mock) SetDiff(_ *diff.Diff) {
+	_ = m.MethodCalled("SetDiff")
+}
+
+func (m *Mock) WithContext(_ context.Context) IService {
+	_ = m.MethodCalled("WithContext")
+	return m
 }
 
 func (m *Mock) RemoveSrcFolderFromPath(_ string) string {
@@ -124,6 +140,11 @@ func (m *Mock) GetToolsConfig() toolsconfig.MapToolConfig {
 	return args.Get(0).(toolsconfig.MapToolConfig)
 }
 
+func (m *Mock) GetRustAdvisoryDBPath() string {
+	args := m.MethodCalled("GetRustAdvisoryDBPath")
+	return args.Get(0).(string)
+}
+
 func (m *Mock) IsDockerDisabled() bool {
 	args := m.MethodCalled("IsDockerDisabled")
 	return args.Get(0).(bool)
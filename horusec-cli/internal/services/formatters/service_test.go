@@ -15,17 +15,26 @@
 package formatters
 
 import (
+	"context"
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
 
+	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	dockerEntities "github.com/ZupIT/horusec/horusec-cli/internal/entities/docker"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,9 +50,7 @@ func TestMock_AddWorkDirInCmd(t *testing.T) {
 		mock.On("AddWorkDirInCmd").Return("")
 		mock.On("GetConfigProjectPath").Return("")
 		mock.On("GetAnalysis").Return(&horusec.Analysis{})
-		mock.On("SetToolFinishedAnalysis").Return()
 		mock.On("LogAnalysisError").Return()
-		mock.On("SetMonitor").Return()
 		mock.On("RemoveSrcFolderFromPath").Return("")
 		mock.On("GetCodeWithMaxCharacters").Return("")
 		mock.LogDebugWithReplace("", "")
@@ -55,7 +62,6 @@ func TestMock_AddWorkDirInCmd(t *testing.T) {
 		_ = mock.GetConfigProjectPath()
 		_ = mock.GetAnalysis()
 		mock.SetAnalysisError(errors.New(""), "", "")
-		mock.SetMonitor(&horusec.Monitor{})
 		_ = mock.RemoveSrcFolderFromPath("")
 		_ = mock.GetCodeWithMaxCharacters("", 0)
 	})
@@ -69,7 +75,7 @@ func TestExecuteContainer(t *testing.T) {
 		dockerAPIControllerMock.On("SetAnalysisID")
 		dockerAPIControllerMock.On("CreateLanguageAnalysisContainer").Return("test", nil)
 
-		monitorController := NewFormatterService(analysis, dockerAPIControllerMock, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(analysis, dockerAPIControllerMock, &config.Config{})
 		result, err := monitorController.ExecuteContainer(&dockerEntities.AnalysisData{})
 
 		assert.NoError(t, err)
@@ -79,7 +85,7 @@ func TestExecuteContainer(t *testing.T) {
 
 func TestGetAnalysisIDErrorMessage(t *testing.T) {
 	t.Run("should success get error message with replaces", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 
 		result := monitorController.GetAnalysisIDErrorMessage(tools.Bandit, "test")
 
@@ -91,7 +97,7 @@ func TestGetAnalysisIDErrorMessage(t *testing.T) {
 
 func TestGetCommitAuthor(t *testing.T) {
 	t.Run("should get commit author", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 
 		result := monitorController.GetCommitAuthor("", "")
 
@@ -99,12 +105,40 @@ func TestGetCommitAuthor(t *testing.T) {
 	})
 }
 
+func TestWalkGitHistory(t *testing.T) {
+	t.Run("should walk commits of git history limited by depth configured", func(t *testing.T) {
+		cliConfig := &config.Config{}
+		cliConfig.SetProjectPath("../../../../")
+		cliConfig.SetGitHistoryDepth(1)
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig)
+
+		var commits []*history.Commit
+		err := service.WalkGitHistory(func(commit *history.Commit) error {
+			commits = append(commits, commit)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, commits, 1)
+	})
+}
+
+func TestGetRustAdvisoryDBPath(t *testing.T) {
+	t.Run("should return rust advisory database path of config", func(t *testing.T) {
+		cliConfig := &config.Config{}
+		cliConfig.SetRustAdvisoryDBPath("/advisory-db")
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig)
+
+		assert.Equal(t, "/advisory-db", service.GetRustAdvisoryDBPath())
+	})
+}
+
 func TestGetConfigProjectPath(t *testing.T) {
 	t.Run("should success get project path", func(t *testing.T) {
 		cliConfig := &config.Config{}
 		cliConfig.SetProjectPath("test")
 
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig)
 
 		result := monitorController.GetConfigProjectPath()
 
@@ -120,7 +154,7 @@ func TestAddWorkDirInCmd(t *testing.T) {
 			CSharp: []string{"test"},
 		})
 
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig)
 
 		result := monitorController.AddWorkDirInCmd("test", "C#", tools.SecurityCodeScan)
 
@@ -133,7 +167,7 @@ func TestAddWorkDirInCmd(t *testing.T) {
 			CSharp: []string{"test"},
 		})
 
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, cliConfig)
 
 		result := monitorController.AddWorkDirInCmd("test", "C#", tools.SecurityCodeScan)
 
@@ -143,7 +177,7 @@ func TestAddWorkDirInCmd(t *testing.T) {
 
 func TestLogDebugWithReplace(t *testing.T) {
 	t.Run("should log debug and not panics", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 
 		assert.NotPanics(t, func() {
 			monitorController.LogDebugWithReplace("test", tools.NpmAudit)
@@ -154,7 +188,7 @@ func TestLogDebugWithReplace(t *testing.T) {
 func TestGetAnalysisID(t *testing.T) {
 	t.Run("should success get analysis id", func(t *testing.T) {
 		id := uuid.New()
-		monitorController := NewFormatterService(&horusec.Analysis{ID: id}, &docker.Mock{}, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{ID: id}, &docker.Mock{}, &config.Config{})
 		assert.Equal(t, id.String(), monitorController.GetAnalysisID())
 	})
 }
@@ -162,21 +196,21 @@ func TestGetAnalysisID(t *testing.T) {
 func TestGetAnalysis(t *testing.T) {
 	t.Run("should success get analysis", func(t *testing.T) {
 		id := uuid.New()
-		monitorController := NewFormatterService(&horusec.Analysis{ID: id}, &docker.Mock{}, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{ID: id}, &docker.Mock{}, &config.Config{})
 		assert.NotEmpty(t, monitorController.GetAnalysis())
 	})
 }
 
 func TestLogAnalysisError(t *testing.T) {
 	t.Run("should not panic when logging error", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 
 		assert.NotPanics(t, func() {
 			monitorController.SetAnalysisError(errors.New("test"), tools.GoSec, "")
 		})
 	})
 	t.Run("should not panic when logging error and exists projectSubPath", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 
 		assert.NotPanics(t, func() {
 			monitorController.SetAnalysisError(errors.New("test"), tools.GoSec, "/tmp")
@@ -184,57 +218,36 @@ func TestLogAnalysisError(t *testing.T) {
 	})
 }
 
-func TestSetLanguageIsFinished(t *testing.T) {
-	t.Run("should set go as finished", func(t *testing.T) {
-		monitor := horusec.NewMonitor()
-		monitor.AddProcess(1)
-
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
-		monitorController.SetMonitor(monitor)
-
-		monitorController.SetToolFinishedAnalysis()
-		assert.Equal(t, 0, monitor.GetProcess())
-	})
-}
-
 func TestToolIsToIgnore(t *testing.T) {
 	t.Run("should return true when language is match", func(t *testing.T) {
-		monitor := horusec.NewMonitor()
-		monitor.AddProcess(1)
 		configs := &config.Config{}
 		configs.SetToolsToIgnore([]string{"GoSec"})
 
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs)
 
 		assert.Equal(t, true, monitorController.ToolIsToIgnore(tools.GoSec))
 	})
 	t.Run("should return true when language is match uppercase", func(t *testing.T) {
-		monitor := horusec.NewMonitor()
-		monitor.AddProcess(1)
 		configs := &config.Config{}
 		configs.SetToolsToIgnore([]string{"GOSEC"})
 
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs)
 
 		assert.Equal(t, true, monitorController.ToolIsToIgnore(tools.GoSec))
 	})
 	t.Run("should return true when language is match lowercase and multi tools", func(t *testing.T) {
-		monitor := horusec.NewMonitor()
-		monitor.AddProcess(1)
 		configs := &config.Config{}
 		configs.SetToolsToIgnore([]string{"SecurityCodeScan", "gosEC"})
 
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs)
 
 		assert.Equal(t, true, monitorController.ToolIsToIgnore(tools.GoSec))
 	})
 	t.Run("should return false when language is not match", func(t *testing.T) {
-		monitor := horusec.NewMonitor()
-		monitor.AddProcess(1)
 		configs := &config.Config{}
 		configs.SetToolsToIgnore([]string{"SECURITYCODESCAN"})
 
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs, &horusec.Monitor{})
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs)
 
 		assert.Equal(t, false, monitorController.ToolIsToIgnore(tools.GoSec))
 	})
@@ -242,21 +255,21 @@ func TestToolIsToIgnore(t *testing.T) {
 
 func TestService_GetCodeWithMaxCharacters(t *testing.T) {
 	t.Run("should return default code", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 		code := "text"
 		column := 0
 		newCode := monitorController.GetCodeWithMaxCharacters(code, column)
 		assert.Equal(t, "text", newCode)
 	})
 	t.Run("should return default code if column is negative", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 		code := "text"
 		column := -1
 		newCode := monitorController.GetCodeWithMaxCharacters(code, column)
 		assert.Equal(t, "text", newCode)
 	})
 	t.Run("should return 4:105 characters when text is so bigger", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 		code := "text"
 		for i := 0; i < 10; i++ {
 			for i := 0; i <= 9; i++ {
@@ -268,7 +281,7 @@ func TestService_GetCodeWithMaxCharacters(t *testing.T) {
 		assert.Equal(t, "0123456789012345678901234567890123456789012345678901234567890123456789012345678901234567890123456789", newCode)
 	})
 	t.Run("should return first 100 characters when text is so bigger", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 		code := "text"
 		for i := 0; i < 10; i++ {
 			for i := 0; i <= 9; i++ {
@@ -280,7 +293,7 @@ func TestService_GetCodeWithMaxCharacters(t *testing.T) {
 		assert.Equal(t, "text012345678901234567890123456789012345678901234567890123456789012345678901234567890123456789012345", newCode)
 	})
 	t.Run("should return first 100 characters when text contains breaking lines", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 		code := `22: func GetMD5(s string) string {
 23:     h := md5.New()
 24:     io.WriteString(h, s) // #nohorus
@@ -293,7 +306,7 @@ func TestService_GetCodeWithMaxCharacters(t *testing.T) {
 	`, newCode)
 	})
 	t.Run("should return first 100 characters when text is so bigger", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 		code := "text"
 		for i := 0; i <= 200; i++ {
 			code += strconv.Itoa(i)
@@ -303,7 +316,7 @@ func TestService_GetCodeWithMaxCharacters(t *testing.T) {
 		assert.Equal(t, "4041424344454647484950515253545556575859606162636465666768697071727374757677787980818283848586878889", newCode)
 	})
 	t.Run("should return first 100 characters when text is so bigger", func(t *testing.T) {
-		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{}, nil)
+		monitorController := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
 		code := "text"
 		for i := 0; i <= 200; i++ {
 			code += strconv.Itoa(i)
@@ -313,3 +326,101 @@ func TestService_GetCodeWithMaxCharacters(t *testing.T) {
 		assert.Len(t, newCode, 100)
 	})
 }
+
+func TestAddNewVulnerabilityIntoAnalysis(t *testing.T) {
+	t.Run("should add all vulnerabilities when diff is not set", func(t *testing.T) {
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "10"})
+		assert.Len(t, service.GetAnalysis().AnalysisVulnerabilities, 1)
+	})
+
+	t.Run("should add only vulnerabilities in lines changed against diff base", func(t *testing.T) {
+		changes := diff.NewDiff()
+		changes.AddLines("main.go", 10, 12)
+		changes.AddFile("new.go")
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
+		service.SetDiff(changes)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "11"})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "20"})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "new.go", Line: "99"})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "other.go", Line: "1"})
+		assert.Len(t, service.GetAnalysis().AnalysisVulnerabilities, 2)
+	})
+
+	t.Run("should add vulnerabilities with path relative to work dir sub path in lines changed", func(t *testing.T) {
+		changes := diff.NewDiff()
+		changes.AddLines("api/main.go", 10, 12)
+		configs := &config.Config{}
+		configs.SetWorkDir(&workdir.WorkDir{Go: []string{"api"}})
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs)
+		service.SetDiff(changes)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "11",
+			Language: languages.Go})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "20",
+			Language: languages.Go})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "11",
+			Language: languages.Python})
+		assert.Len(t, service.GetAnalysis().AnalysisVulnerabilities, 1)
+	})
+
+	t.Run("should not add vulnerabilities of files ignored in .horusecignore", func(t *testing.T) {
+		configs := &config.Config{}
+		configs.SetProjectPath(t.TempDir())
+		assert.NoError(t, ioutil.WriteFile(filepath.Join(configs.GetProjectPath(), ".horusecignore"),
+			[]byte("test/\n"), os.ModePerm))
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, configs)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "test/main_test.go", Line: "1"})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "1"})
+		assert.Len(t, service.GetAnalysis().AnalysisVulnerabilities, 1)
+	})
+
+	t.Run("should not add vulnerabilities when tool context is canceled", func(t *testing.T) {
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
+		ctx, cancel := context.WithCancel(context.Background())
+		serviceWithContext := service.WithContext(ctx)
+
+		serviceWithContext.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "1"})
+		cancel()
+		serviceWithContext.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "2"})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "3"})
+		assert.Len(t, service.GetAnalysis().AnalysisVulnerabilities, 2)
+	})
+}
+
+func TestParseFindingsToVulnerabilities(t *testing.T) {
+	t.Run("should classify vulnerabilities by cwe of rules", func(t *testing.T) {
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
+		findings := []engine.Finding{
+			{ID: "782ad071-1cf3-4230-936f-b7a1e794828d", Name: "Command Injection", Description: "Command Injection"},
+			{ID: uuid.New().String(), Name: "Logs", Description: "For more information checkout the CWE-532 advisory."},
+		}
+
+		assert.NoError(t, service.ParseFindingsToVulnerabilities(findings, tools.HorusecCsharp, languages.CSharp))
+
+		vulnerabilities := service.GetAnalysis().AnalysisVulnerabilities
+		assert.Len(t, vulnerabilities, 2)
+		assert.Equal(t, pq.StringArray{"CWE-78"}, vulnerabilities[0].Vulnerability.CWEs)
+		assert.Equal(t, pq.StringArray{"A03:2021-Injection"}, vulnerabilities[0].Vulnerability.OWASPTop10)
+		assert.Equal(t, pq.StringArray{"CWE-532"}, vulnerabilities[1].Vulnerability.CWEs)
+	})
+
+	t.Run("should set rule information and remediation of rules", func(t *testing.T) {
+		service := NewFormatterService(&horusec.Analysis{}, &docker.Mock{}, &config.Config{})
+		findings := []engine.Finding{
+			{ID: "HS-TEST-1", Name: "XML External Entity", Description: "XXE attack. For more information checkout " +
+				"the CWE-611 (https://cwe.mitre.org/data/definitions/611.html) advisory."},
+		}
+
+		assert.NoError(t, service.ParseFindingsToVulnerabilities(findings, tools.HorusecJava, languages.Java))
+
+		vulnerability := service.GetAnalysis().AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, "HS-TEST-1", vulnerability.RuleID)
+		assert.Equal(t, "XML External Entity", vulnerability.Title)
+		assert.Contains(t, vulnerability.Remediation, "external entities")
+		assert.Equal(t, pq.StringArray{"https://cwe.mitre.org/data/definitions/611.html"}, vulnerability.References)
+	})
+}
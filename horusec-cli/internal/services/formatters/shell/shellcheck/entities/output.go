@@ -2,6 +2,8 @@ package entities
 
 import "strconv"
 
+const wikiURL = "https://github.com/koalaman/shellcheck/wiki/"
+
 type Output struct {
 	File      string      `json:"file"`
 	Line      int         `json:"line"`
@@ -21,3 +23,20 @@ func (o *Output) GetLine() string {
 func (o *Output) GetColumn() string {
 	return strconv.Itoa(o.Column)
 }
+
+func (o *Output) GetRuleID() string {
+	if o.Code == 0 {
+		return ""
+	}
+
+	return "SC" + strconv.Itoa(o.Code)
+}
+
+// GetReference returns the page of the rule in the wiki of shellcheck, which explains the problem and how to fix it
+func (o *Output) GetReference() string {
+	if o.Code == 0 {
+		return ""
+	}
+
+	return wikiURL + o.GetRuleID()
+}
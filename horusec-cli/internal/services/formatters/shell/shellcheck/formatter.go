@@ -52,7 +52,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.startShellCheck(projectSubPath), tools.ShellCheck, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.ShellCheck)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) startShellCheck(projectSubPath string) error {
@@ -94,6 +93,7 @@ func (f *Formatter) newContainerOutputFromString(containerOutput string) (output
 	return output, err
 }
 
+//nolint:funlen parse struct is necessary > 15 lines
 func (f *Formatter) setVulnerabilityData(output *entities.Output) *horusec.Vulnerability {
 	data := f.getDefaultVulnerabilitySeverity()
 	data.Severity = severity.Low
@@ -102,6 +102,8 @@ func (f *Formatter) setVulnerabilityData(output *entities.Output) *horusec.Vulne
 	data.Column = output.GetColumn()
 	data.Line = output.GetLine()
 	data.File = strings.ReplaceAll(output.File, "./", "")
+	data.SetRule(output.GetRuleID(), "")
+	data.SetRemediation("", output.GetReference())
 	data = hash.Bind(data)
 	return f.SetCommitAuthor(data)
 }
@@ -0,0 +1,139 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+)
+
+const (
+	suppressionNextLine = "horusec-ignore-next-line"
+	suppressionReason   = "reason:"
+	// used when the suppression comment does not state a reason
+	defaultSuppressionReason = "Suppressed by inline comment"
+)
+
+// suppressionRegex match comments like "// horusec-ignore-next-line [rule-or-hash] reason: ..." or "# horusec-ignore"
+var suppressionRegex = regexp.MustCompile(`(?://|#|/\*|--|<!--)\s*(horusec-ignore(?:-next-line)?)\b(.*)$`)
+
+type suppression struct {
+	nextLine bool
+	target   string
+	reason   string
+}
+
+// setInlineSuppression mark as false positive the vulnerability suppressed by a comment in the same line or in the
+// line before it, so developers can triage the vulnerability next to the code
+func (s *Service) setInlineSuppression(vulnerability *horusec.Vulnerability) {
+	lineNumber := s.getFirstLineNumber(vulnerability.Line)
+	if vulnerability.File == "" || lineNumber <= 0 {
+		return
+	}
+
+	previousLine, currentLine := s.readLineAndPreviousLine(s.getVulnerabilityFilePath(vulnerability.File), lineNumber)
+	if found := s.parseSuppression(currentLine); found != nil && !found.nextLine {
+		s.applySuppression(vulnerability, found)
+		return
+	}
+	if found := s.parseSuppression(previousLine); found != nil && found.nextLine {
+		s.applySuppression(vulnerability, found)
+	}
+}
+
+func (s *Service) applySuppression(vulnerability *horusec.Vulnerability, found *suppression) {
+	if !found.isTargetOf(vulnerability) {
+		return
+	}
+
+	vulnerability.Type = enumHorusec.FalsePositive
+	vulnerability.SuppressionReason = found.reason
+	if vulnerability.SuppressionReason == "" {
+		vulnerability.SuppressionReason = defaultSuppressionReason
+	}
+}
+
+func (s *Service) parseSuppression(line string) *suppression {
+	matches := suppressionRegex.FindStringSubmatch(line)
+	if len(matches) < 3 {
+		return nil
+	}
+
+	found := &suppression{nextLine: matches[1] == suppressionNextLine}
+	content := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(matches[2]), "*/"))
+	content = strings.TrimSpace(strings.TrimSuffix(content, "-->"))
+	if index := strings.Index(content, suppressionReason); index >= 0 {
+		found.reason = strings.TrimSpace(content[index+len(suppressionReason):])
+		content = strings.TrimSpace(content[:index])
+	}
+
+	found.target = strings.Trim(content, "[]")
+	return found
+}
+
+func (s *Service) getVulnerabilityFilePath(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+
+	return filepath.Join(s.GetConfigProjectPath(), file)
+}
+
+func (s *Service) readLineAndPreviousLine(path string, lineNumber int) (previousLine, currentLine string) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", ""
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, bufio.MaxScanTokenSize), 10*bufio.MaxScanTokenSize)
+	for current := 1; scanner.Scan(); current++ {
+		if current == lineNumber-1 {
+			previousLine = scanner.Text()
+		}
+		if current == lineNumber {
+			return previousLine, scanner.Text()
+		}
+	}
+
+	return "", ""
+}
+
+func (s *Service) getFirstLineNumber(line string) int {
+	lineNumber, _ := strconv.Atoi(strings.TrimSpace(strings.Split(line, "-")[0]))
+	return lineNumber
+}
+
+// isTargetOf return true when suppression has no target or the target is exactly the hash, the tool or the rule id
+// of the vulnerability
+func (s *suppression) isTargetOf(vulnerability *horusec.Vulnerability) bool {
+	if s.target == "" {
+		return true
+	}
+
+	return vulnerability.HasHash(s.target) ||
+		strings.EqualFold(s.target, vulnerability.SecurityTool.ToString()) ||
+		(vulnerability.RuleID != "" && strings.EqualFold(s.target, vulnerability.RuleID))
+}
@@ -0,0 +1,118 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package formatters
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/docker"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+const suppressionExampleCode = `package main
+
+func main() {
+	// horusec-ignore-next-line reason: only used in tests
+	password := "123456"
+	token := "abcdef" // horusec-ignore
+	// horusec-ignore-next-line G101 reason: not a secret
+	secret := "987654"
+	key := "qwerty"
+	// horusec-ignore-next-line G10 reason: prefix of the rule
+	private := "zxcvbn"
+}
+`
+
+func newServiceWithSuppressionExample(t *testing.T) IService {
+	analysis := &horusec.Analysis{ID: uuid.New()}
+	configs := &config.Config{}
+	configs.SetProjectPath(t.TempDir())
+
+	service := NewFormatterService(analysis, &docker.Mock{}, configs)
+	assert.NoError(t, os.MkdirAll(service.GetConfigProjectPath(), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(service.GetConfigProjectPath(), "main.go"),
+		[]byte(suppressionExampleCode), os.ModePerm))
+
+	return service
+}
+
+func TestInlineSuppression(t *testing.T) {
+	t.Run("should set false positive with reason when comment is in line before", func(t *testing.T) {
+		service := newServiceWithSuppressionExample(t)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "5"})
+
+		vulnerability := service.GetAnalysis().AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, enumHorusec.FalsePositive, vulnerability.Type)
+		assert.Equal(t, "only used in tests", vulnerability.SuppressionReason)
+	})
+
+	t.Run("should set false positive with default reason when comment is in same line", func(t *testing.T) {
+		service := newServiceWithSuppressionExample(t)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "6"})
+
+		vulnerability := service.GetAnalysis().AnalysisVulnerabilities[0].Vulnerability
+		assert.Equal(t, enumHorusec.FalsePositive, vulnerability.Type)
+		assert.Equal(t, defaultSuppressionReason, vulnerability.SuppressionReason)
+	})
+
+	t.Run("should set false positive only when rule is the target of comment", func(t *testing.T) {
+		service := newServiceWithSuppressionExample(t)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{
+			File: "main.go", Line: "8", RuleID: "G101", SecurityTool: tools.GoSec})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{
+			File: "main.go", Line: "8", RuleID: "G104", SecurityTool: tools.GoSec})
+
+		assert.Equal(t, enumHorusec.FalsePositive, service.GetAnalysis().AnalysisVulnerabilities[0].Vulnerability.Type)
+		assert.Equal(t, "not a secret", service.GetAnalysis().AnalysisVulnerabilities[0].Vulnerability.SuppressionReason)
+		assert.Empty(t, service.GetAnalysis().AnalysisVulnerabilities[1].Vulnerability.Type)
+	})
+
+	t.Run("should not set false positive when target is only part of the rule or of the details", func(t *testing.T) {
+		service := newServiceWithSuppressionExample(t)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{
+			File: "main.go", Line: "11", RuleID: "G101", SecurityTool: tools.GoSec})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{
+			File: "main.go", Line: "11", Details: "G10 hard coded credentials", SecurityTool: tools.GoSec})
+
+		for _, analysisVulnerability := range service.GetAnalysis().AnalysisVulnerabilities {
+			assert.Empty(t, analysisVulnerability.Vulnerability.Type)
+		}
+	})
+
+	t.Run("should not set false positive when not exists comment", func(t *testing.T) {
+		service := newServiceWithSuppressionExample(t)
+
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "9"})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "main.go", Line: "99"})
+		service.AddNewVulnerabilityIntoAnalysis(&horusec.Vulnerability{File: "not-exists.go", Line: "5"})
+
+		for _, analysisVulnerability := range service.GetAnalysis().AnalysisVulnerabilities {
+			assert.Empty(t, analysisVulnerability.Vulnerability.Type)
+			assert.Empty(t, analysisVulnerability.Vulnerability.SuppressionReason)
+		}
+	})
+}
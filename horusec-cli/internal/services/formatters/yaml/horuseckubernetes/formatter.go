@@ -15,6 +15,8 @@
 package horuseckubernetes
 
 import (
+	"fmt"
+
 	engine "github.com/ZupIT/horusec-engine"
 	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes"
 	engineenums "github.com/ZupIT/horusec/development-kit/pkg/enums/engine"
@@ -45,7 +47,6 @@ func (f *Formatter) StartAnalysis(projectSubPath string) {
 
 	f.SetAnalysisError(f.execEngineAndParseResults(projectSubPath), tools.HorusecKubernetes, projectSubPath)
 	f.LogDebugWithReplace(messages.MsgDebugToolFinishAnalysis, tools.HorusecKubernetes)
-	f.SetToolFinishedAnalysis()
 }
 
 func (f *Formatter) execEngineAndParseResults(projectSubPath string) error {
@@ -59,12 +60,38 @@ func (f *Formatter) execEngineAndParseResults(projectSubPath string) error {
 	return f.ParseFindingsToVulnerabilities(findings, tools.HorusecKubernetes, languages.Yaml)
 }
 
+// execEngineAnalysis runs the manifest rules in the parsed manifests, the text rules in the yaml files that could not
+// be parsed, and the custom rules, which are text rules, in all yaml files
 func (f *Formatter) execEngineAnalysis(projectSubPath string) ([]engine.Finding, error) {
-	textUnit, err := f.GetTextUnitByRulesExt(f.GetProjectPathWithWorkdir(projectSubPath))
+	projectPath := f.GetProjectPathWithWorkdir(projectSubPath)
+	manifestUnits, invalidUnits, err := f.GetManifestUnits(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	textUnits, err := f.GetTextUnitByRulesExt(projectPath)
 	if err != nil {
 		return nil, err
 	}
 
-	allRules := append(f.GetAllRules(), f.GetCustomRulesByTool(tools.HorusecKubernetes)...)
-	return engine.RunMaxUnitsByAnalysis(textUnit, allRules, engineenums.DefaultMaxUnitsPerAnalysis), nil
+	findings := engine.RunMaxUnitsByAnalysis(manifestUnits, f.GetManifestRules(), engineenums.DefaultMaxUnitsPerAnalysis)
+	findings = append(findings, engine.RunMaxUnitsByAnalysis(invalidUnits, f.GetAllRules(),
+		engineenums.DefaultMaxUnitsPerAnalysis)...)
+	return f.removeDuplicatedFindings(append(findings, engine.RunMaxUnitsByAnalysis(textUnits,
+		f.GetCustomRulesByTool(tools.HorusecKubernetes), engineenums.DefaultMaxUnitsPerAnalysis)...)), nil
+}
+
+// removeDuplicatedFindings removes the findings in the same line of a base that is used by more than one overlay
+func (f *Formatter) removeDuplicatedFindings(findings []engine.Finding) (unique []engine.Finding) {
+	found := map[string]bool{}
+	for index := range findings {
+		key := fmt.Sprintf("%s:%s:%d", findings[index].ID, findings[index].SourceLocation.Filename,
+			findings[index].SourceLocation.Line)
+		if !found[key] {
+			found[key] = true
+			unique = append(unique, findings[index])
+		}
+	}
+
+	return unique
 }
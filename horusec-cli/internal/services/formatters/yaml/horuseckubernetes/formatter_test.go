@@ -15,9 +15,13 @@
 package horuseckubernetes
 
 import (
+	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
 
 	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/horusec-cli/internal/services/formatters"
 	"github.com/stretchr/testify/assert"
@@ -29,7 +33,6 @@ func TestStartAnalysis(t *testing.T) {
 		service := &formatters.Mock{}
 
 		service.On("LogDebugWithReplace")
-		service.On("SetToolFinishedAnalysis")
 		service.On("SetAnalysisError")
 		service.On("ToolIsToIgnore").Return(false)
 		service.On("GetProjectPathWithWorkdir").Return(".")
@@ -43,11 +46,39 @@ func TestStartAnalysis(t *testing.T) {
 		assert.Empty(t, len(analysis.Errors))
 	})
 
+	t.Run("should find vulnerabilities in manifests, helm charts and kustomizations", func(t *testing.T) {
+		service := &formatters.Mock{}
+		customRule := text.TextRule{
+			Metadata:    engine.Metadata{ID: "custom-kubernetes", Name: "Custom Kubernetes"},
+			Type:        text.Regular,
+			Expressions: []*regexp.Regexp{regexp.MustCompile(`namePrefix`)},
+		}
+
+		service.On("GetProjectPathWithWorkdir").Return("../../../../../../examples/yaml")
+		service.On("GetCustomRulesByTool").Return([]engine.Rule{customRule})
+
+		findings, err := NewFormatter(service).(*Formatter).execEngineAnalysis("")
+
+		assert.NoError(t, err)
+		files := map[string]bool{}
+		for index := range findings {
+			path := filepath.ToSlash(findings[index].SourceLocation.Filename)
+			files[path[strings.Index(path, "examples/yaml/")+len("examples/yaml/"):]] = true
+			assert.NotContains(t, path, "debug.yaml")
+		}
+		assert.Equal(t, map[string]bool{
+			"example1/example.yaml":                           true,
+			"example2/chart/templates/deployment.yaml":        true,
+			"example2/chart/templates/role.yaml":              true,
+			"example3/base/deployment.yaml":                   true,
+			"example3/overlays/production/kustomization.yaml": true,
+		}, files)
+	})
+
 	t.Run("should return error when getting text unit", func(t *testing.T) {
 		service := &formatters.Mock{}
 
 		service.On("LogDebugWithReplace")
-		service.On("SetToolFinishedAnalysis")
 		service.On("SetAnalysisError")
 		service.On("ToolIsToIgnore").Return(false)
 		service.On("GetProjectPathWithWorkdir").Return("!!!")
@@ -15,23 +15,33 @@
 package git
 
 import (
+	"bufio"
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
 	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
 )
 
 type IService interface {
 	GetCommitAuthor(line, filePath string) (commitAuthor horusec.CommitAuthor)
+	GetDiff(diffBase string) (*diff.Diff, error)
+	WalkHistory(commitRange string, depth int64, walkFn func(commit *history.Commit) error) error
 }
 
+var hunkHeaderRegex = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,(\d+))? @@`)
+
 type Service struct {
 	config config.IConfig
 }
@@ -137,3 +147,81 @@ func (s *Service) existsGitFolderInPath() bool {
 
 	return true
 }
+
+func (s *Service) GetDiff(diffBase string) (*diff.Diff, error) {
+	changes := diff.NewDiff()
+	output, err := s.executeGitCMD("diff", "--relative", "--no-color", "--no-ext-diff", "--unified=0",
+		"--src-prefix=a/", "--dst-prefix=b/", diffBase, "--")
+	if err != nil {
+		return nil, err
+	}
+	s.parseDiffOutput(changes, output)
+
+	output, err = s.executeGitCMD("ls-files", "--others", "--exclude-standard")
+	if err != nil {
+		return nil, err
+	}
+	s.parseUntrackedOutput(changes, output)
+	return changes, nil
+}
+
+func (s *Service) executeGitCMD(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = s.config.GetProjectPath()
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+
+	response, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return response, nil
+}
+
+func (s *Service) parseDiffOutput(changes *diff.Diff, output []byte) {
+	currentFile := ""
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "+++ "):
+			currentFile = getFileFromDiffHeader(line)
+		case strings.HasPrefix(line, "@@") && currentFile != "":
+			s.addHunkLines(changes, currentFile, line)
+		}
+	}
+}
+
+func getFileFromDiffHeader(line string) string {
+	path := strings.TrimPrefix(line, "+++ ")
+	if path == "/dev/null" {
+		return ""
+	}
+
+	return strings.TrimPrefix(path, "b/")
+}
+
+func (s *Service) addHunkLines(changes *diff.Diff, file, line string) {
+	matches := hunkHeaderRegex.FindStringSubmatch(line)
+	if len(matches) < 3 {
+		return
+	}
+
+	start, _ := strconv.Atoi(matches[1])
+	total := 1
+	if matches[2] != "" {
+		total, _ = strconv.Atoi(matches[2])
+	}
+	// hunks with zero lines only removed content, so there is no line to analyze
+	if total > 0 {
+		changes.AddLines(file, start, start+total-1)
+	}
+}
+
+func (s *Service) parseUntrackedOutput(changes *diff.Diff, output []byte) {
+	for _, path := range strings.Split(string(output), "\n") {
+		if strings.TrimSpace(path) != "" {
+			changes.AddFile(path)
+		}
+	}
+}
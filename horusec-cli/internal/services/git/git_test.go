@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/diff"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -67,3 +68,44 @@ func TestGetCommitAuthor(t *testing.T) {
 		assert.NotEmpty(t, NewGitService(&config.Config{}))
 	})
 }
+
+func TestGetDiff(t *testing.T) {
+	c := &config.Config{}
+	c.SetProjectPath("../../../../")
+	service := Service{
+		config: c,
+	}
+
+	t.Run("Should success get diff against HEAD", func(t *testing.T) {
+		changes, err := service.GetDiff("HEAD")
+		assert.NoError(t, err)
+		assert.NotNil(t, changes)
+	})
+
+	t.Run("Should return error when diff base not exists", func(t *testing.T) {
+		changes, err := service.GetDiff("not-exists-reference")
+		assert.Error(t, err)
+		assert.Nil(t, changes)
+	})
+
+	t.Run("Should parse only added and modified lines of diff output", func(t *testing.T) {
+		output := "diff --git a/api/server.go b/api/server.go\n" +
+			"--- a/api/server.go\n" +
+			"+++ b/api/server.go\n" +
+			"@@ -10,0 +11,3 @@ func main() {\n" +
+			"@@ -20 +23 @@ func main() {\n" +
+			"@@ -30,2 +32,0 @@ func main() {\n" +
+			"diff --git a/removed.go b/removed.go\n" +
+			"--- a/removed.go\n" +
+			"+++ /dev/null\n" +
+			"@@ -1,3 +0,0 @@\n"
+		changes := diff.NewDiff()
+
+		service.parseDiffOutput(changes, []byte(output))
+
+		assert.True(t, changes.IsLineChanged("api/server.go", "13"))
+		assert.True(t, changes.IsLineChanged("api/server.go", "23"))
+		assert.False(t, changes.IsLineChanged("api/server.go", "32"))
+		assert.False(t, changes.IsFileChanged("removed.go"))
+	})
+}
@@ -0,0 +1,291 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
+	goGit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+)
+
+const historyDateFormat = "2006-01-02 15:04:05 -0700"
+
+// historyWalker walks the commits of repository without the git binary, paths outside of project path are ignored
+// and the paths inside are relative to project path, like the --relative option of git
+type historyWalker struct {
+	repository *goGit.Repository
+	prefix     string
+	excluded   map[plumbing.Hash]bool
+	depth      int64
+	walked     int64
+	walkFn     func(commit *history.Commit) error
+}
+
+// WalkHistory calls walkFn with the lines added by each commit reachable from HEAD or inside commitRange, from the
+// newest to the oldest commit. The commits are read one at a time from the repository, so only one commit is kept
+// in memory. When depth is greater than 0 only the last depth commits are walked
+func (s *Service) WalkHistory(commitRange string, depth int64, walkFn func(commit *history.Commit) error) error {
+	walker, err := s.newHistoryWalker(depth, walkFn)
+	if err != nil {
+		return err
+	}
+
+	from, err := walker.setRange(commitRange)
+	if err != nil {
+		return err
+	}
+
+	return walker.walk(from)
+}
+
+func (s *Service) newHistoryWalker(depth int64,
+	walkFn func(commit *history.Commit) error) (*historyWalker, error) {
+	repository, err := goGit.PlainOpenWithOptions(s.config.GetProjectPath(),
+		&goGit.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := s.getProjectPrefix(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	return &historyWalker{repository: repository, prefix: prefix, depth: depth, walkFn: walkFn}, nil
+}
+
+// getProjectPrefix returns the path of project relative to the root of repository, empty when they are the same
+func (s *Service) getProjectPrefix(repository *goGit.Repository) (string, error) {
+	worktree, err := repository.Worktree()
+	if err != nil {
+		return "", err
+	}
+
+	root, err := getRealPath(worktree.Filesystem.Root())
+	if err != nil {
+		return "", err
+	}
+
+	projectPath, err := getRealPath(s.config.GetProjectPath())
+	if err != nil {
+		return "", err
+	}
+
+	return getRelativePrefix(root, projectPath)
+}
+
+func getRealPath(path string) (string, error) {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(absolutePath)
+}
+
+func getRelativePrefix(root, path string) (string, error) {
+	prefix, err := filepath.Rel(root, path)
+	if err != nil || prefix == "." {
+		return "", err
+	}
+
+	return filepath.ToSlash(prefix) + "/", nil
+}
+
+// setRange resolves the commit range in the same format of git log, like v1.0.0..HEAD or only HEAD, and returns
+// the commit where the walk starts. The commits reachable from the start of range are excluded
+func (w *historyWalker) setRange(commitRange string) (plumbing.Hash, error) {
+	from, to := "", commitRange
+	if strings.Contains(commitRange, "..") {
+		revisions := strings.SplitN(commitRange, "..", 2)
+		from, to = revisions[0], revisions[1]
+	}
+
+	if from != "" {
+		if err := w.setExcluded(from); err != nil {
+			return plumbing.ZeroHash, err
+		}
+	}
+
+	return w.resolve(to)
+}
+
+func (w *historyWalker) resolve(revision string) (plumbing.Hash, error) {
+	if revision == "" {
+		revision = plumbing.HEAD.String()
+	}
+
+	hash, err := w.repository.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return plumbing.ZeroHash, err
+	}
+
+	return *hash, nil
+}
+
+func (w *historyWalker) setExcluded(revision string) error {
+	hash, err := w.resolve(revision)
+	if err != nil {
+		return err
+	}
+
+	commits, err := w.repository.Log(&goGit.LogOptions{From: hash})
+	if err != nil {
+		return err
+	}
+
+	w.excluded = map[plumbing.Hash]bool{}
+	return commits.ForEach(func(commit *object.Commit) error {
+		w.excluded[commit.Hash] = true
+		return nil
+	})
+}
+
+func (w *historyWalker) walk(from plumbing.Hash) error {
+	commits, err := w.repository.Log(&goGit.LogOptions{From: from, Order: goGit.LogOrderCommitterTime})
+	if err != nil {
+		return err
+	}
+
+	return commits.ForEach(w.walkCommit)
+}
+
+// walkCommit skips the commits out of range and stops the walk when depth is reached
+func (w *historyWalker) walkCommit(commit *object.Commit) error {
+	if w.excluded[commit.Hash] {
+		return nil
+	}
+
+	if w.depth > 0 && w.walked >= w.depth {
+		return storer.ErrStop
+	}
+
+	w.walked++
+	historyCommit := w.newHistoryCommit(commit)
+	if err := w.addLines(historyCommit, commit); err != nil {
+		return err
+	}
+
+	return w.walkFn(historyCommit)
+}
+
+func (w *historyWalker) newHistoryCommit(commit *object.Commit) *history.Commit {
+	return &history.Commit{
+		Hash:    commit.Hash.String(),
+		Author:  commit.Author.Name,
+		Email:   commit.Author.Email,
+		Date:    commit.Author.When.Format(historyDateFormat),
+		Message: strings.SplitN(strings.TrimSpace(commit.Message), "\n", 2)[0],
+	}
+}
+
+// addLines adds the lines added by commit against its parent, merge commits are skipped like in git log -p
+func (w *historyWalker) addLines(historyCommit *history.Commit, commit *object.Commit) error {
+	if commit.NumParents() > 1 {
+		return nil
+	}
+
+	patch, err := w.getPatch(commit)
+	if err != nil {
+		return err
+	}
+
+	for _, filePatch := range patch.FilePatches() {
+		if path, ok := w.getPath(filePatch); ok {
+			addChunksLines(historyCommit, path, filePatch.Chunks())
+		}
+	}
+
+	return nil
+}
+
+// getPath returns the path relative to project of the file after the commit, false when the file is binary, was
+// removed or is out of project path
+func (w *historyWalker) getPath(filePatch diff.FilePatch) (string, bool) {
+	_, to := filePatch.Files()
+	if filePatch.IsBinary() || to == nil || !strings.HasPrefix(to.Path(), w.prefix) {
+		return "", false
+	}
+
+	return strings.TrimPrefix(to.Path(), w.prefix), true
+}
+
+// getPatch returns the patch of commit against its parent, or against an empty tree in the first commit. Renamed
+// files are detected, so only their changed lines are added
+func (w *historyWalker) getPatch(commit *object.Commit) (*object.Patch, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, err
+	}
+
+	parentTree, err := w.getParentTree(commit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := object.DiffTreeWithOptions(context.Background(), parentTree, tree, object.DefaultDiffTreeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes.Patch()
+}
+
+// getParentTree returns nil for the first commit, so all lines of its files are added
+func (w *historyWalker) getParentTree(commit *object.Commit) (*object.Tree, error) {
+	if commit.NumParents() != 1 {
+		return nil, nil
+	}
+
+	parent, err := commit.Parent(0)
+	if err != nil {
+		return nil, err
+	}
+
+	return parent.Tree()
+}
+
+// addChunksLines adds the lines of added chunks with their line number in the file of commit
+func addChunksLines(historyCommit *history.Commit, path string, chunks []diff.Chunk) {
+	currentLine := 1
+	for _, chunk := range chunks {
+		lines := getChunkLines(chunk)
+		if chunk.Type() == diff.Add {
+			for index, line := range lines {
+				historyCommit.AddLine(path, currentLine+index, line)
+			}
+		}
+
+		if chunk.Type() != diff.Delete {
+			currentLine += len(lines)
+		}
+	}
+}
+
+func getChunkLines(chunk diff.Chunk) []string {
+	lines := strings.Split(chunk.Content(), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	return lines
+}
@@ -0,0 +1,143 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package git
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/history"
+	goGit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRepository struct {
+	t        *testing.T
+	path     string
+	worktree *goGit.Worktree
+	commits  int
+}
+
+func newTestRepository(t *testing.T) *testRepository {
+	path := t.TempDir()
+	repository, err := goGit.PlainInit(path, false)
+	assert.NoError(t, err)
+	worktree, err := repository.Worktree()
+	assert.NoError(t, err)
+
+	return &testRepository{t: t, path: path, worktree: worktree}
+}
+
+func (r *testRepository) commit(message string, files map[string]string) string {
+	for name, content := range files {
+		path := filepath.Join(r.path, name)
+		assert.NoError(r.t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+		assert.NoError(r.t, ioutil.WriteFile(path, []byte(content), os.ModePerm))
+		_, err := r.worktree.Add(name)
+		assert.NoError(r.t, err)
+	}
+
+	r.commits++
+	hash, err := r.worktree.Commit(message, &goGit.CommitOptions{Author: &object.Signature{
+		Name: "horusec", Email: "horusec@zup.com.br", When: time.Date(2021, 1, r.commits, 10, 0, 0, 0, time.UTC)}})
+	assert.NoError(r.t, err)
+	return hash.String()
+}
+
+func (r *testRepository) walkHistory(projectPath, commitRange string, depth int64) (
+	commits []*history.Commit, err error) {
+	c := &config.Config{}
+	c.SetProjectPath(projectPath)
+	err = NewGitService(c).WalkHistory(commitRange, depth, func(commit *history.Commit) error {
+		commits = append(commits, commit)
+		return nil
+	})
+
+	return commits, err
+}
+
+func TestWalkHistory(t *testing.T) {
+	repository := newTestRepository(t)
+	first := repository.commit("initial commit", map[string]string{"app/config.env": "NAME=app\n",
+		"docs/README.md": "TOKEN=docs\n"})
+	repository.commit("add token\n\nbody of message", map[string]string{"app/config.env": "NAME=app\nTOKEN=secret\n",
+		"docs/README.md": "TOKEN=changed\n"})
+	repository.commit("add image", map[string]string{"app/image.png": "\x89PNG\x00\x00\x00"})
+
+	t.Run("Should walk added lines of each commit from the newest with paths relative to project", func(t *testing.T) {
+		commits, err := repository.walkHistory(filepath.Join(repository.path, "app"), "", 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, commits, 3)
+		assert.Empty(t, commits[0].Files)
+		assert.Equal(t, "add token", commits[1].Message)
+		assert.Equal(t, "horusec", commits[1].Author)
+		assert.Equal(t, "horusec@zup.com.br", commits[1].Email)
+		assert.Equal(t, "2021-01-02 10:00:00 +0000", commits[1].Date)
+		assert.Len(t, commits[1].Files, 1)
+		assert.Equal(t, "config.env", commits[1].Files[0].Path)
+		assert.Equal(t, []history.Line{{Number: 2, Content: "TOKEN=secret"}}, commits[1].Files[0].Lines)
+		assert.Equal(t, first, commits[2].Hash)
+		assert.Equal(t, []history.Line{{Number: 1, Content: "NAME=app"}}, commits[2].Files[0].Lines)
+	})
+
+	t.Run("Should walk files of all repository when project is the root", func(t *testing.T) {
+		commits, err := repository.walkHistory(repository.path, "", 0)
+
+		assert.NoError(t, err)
+		assert.Len(t, commits[1].Files, 2)
+	})
+
+	t.Run("Should walk only commits of range limited by depth", func(t *testing.T) {
+		commits, err := repository.walkHistory(repository.path, first+"..HEAD", 0)
+		assert.NoError(t, err)
+		assert.Len(t, commits, 2)
+
+		commits, err = repository.walkHistory(repository.path, "HEAD", 1)
+		assert.NoError(t, err)
+		assert.Len(t, commits, 1)
+		assert.Equal(t, "add image", commits[0].Message)
+	})
+
+	t.Run("Should return error when commit range not exists", func(t *testing.T) {
+		commits, err := repository.walkHistory(repository.path, "not-exists-reference..HEAD", 0)
+		assert.Error(t, err)
+		assert.Nil(t, commits)
+	})
+
+	t.Run("Should return error when project is not a git repository", func(t *testing.T) {
+		_, err := repository.walkHistory(t.TempDir(), "", 0)
+		assert.Error(t, err)
+	})
+
+	t.Run("Should stop walking and return error of walk function", func(t *testing.T) {
+		c := &config.Config{}
+		c.SetProjectPath(repository.path)
+		walked := 0
+		err := NewGitService(c).WalkHistory("", 0, func(commit *history.Commit) error {
+			walked++
+			return errors.New("test")
+		})
+
+		assert.Error(t, err)
+		assert.Equal(t, 1, walked)
+	})
+}
@@ -0,0 +1,237 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecignore
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/file"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/logger"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/enums/toignore"
+	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
+	"github.com/bmatcuk/doublestar/v2"
+)
+
+const FileName = ".horusecignore"
+
+type IService interface {
+	IsToIgnore(path string) bool
+}
+
+type pattern struct {
+	base     string
+	glob     string
+	negate   bool
+	dirOnly  bool
+	anchored bool
+}
+
+type Service struct {
+	config   cliConfig.IConfig
+	patterns []pattern
+	once     sync.Once
+}
+
+// NewHorusecIgnoreService return a service to check paths against the .horusecignore files found in any directory
+// of the project, the files are read in the first check because the project path can change before the analysis
+func NewHorusecIgnoreService(config cliConfig.IConfig) IService {
+	return &Service{
+		config: config,
+	}
+}
+
+// IsToIgnore check the path with gitignore semantics, the path can be relative to the project path or start with it
+func (s *Service) IsToIgnore(path string) bool {
+	s.once.Do(s.loadPatterns)
+	if len(s.patterns) == 0 {
+		return false
+	}
+
+	relativePath := s.getRelativePath(path)
+	if relativePath == "" || relativePath == "." || strings.HasPrefix(relativePath, "..") {
+		return false
+	}
+
+	return s.isParentIgnored(relativePath) || s.match(relativePath, s.isDir(relativePath))
+}
+
+// isParentIgnored check the parent directories because it is not possible re-include a file if a parent
+// directory of that file is excluded
+func (s *Service) isParentIgnored(relativePath string) bool {
+	parts := strings.Split(relativePath, "/")
+	for index := 1; index < len(parts); index++ {
+		if s.match(strings.Join(parts[:index], "/"), true) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Service) match(relativePath string, isDir bool) (ignored bool) {
+	for index := range s.patterns {
+		if s.patterns[index].match(relativePath, isDir) {
+			ignored = !s.patterns[index].negate
+		}
+	}
+
+	return ignored
+}
+
+func (s *Service) getRelativePath(path string) string {
+	projectPath := s.config.GetProjectPath()
+	if projectPath != "" && strings.HasPrefix(path, projectPath) {
+		path, _ = filepath.Rel(projectPath, path)
+	}
+
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+}
+
+func (s *Service) isDir(relativePath string) bool {
+	info, err := os.Stat(filepath.Join(s.config.GetProjectPath(), relativePath))
+	return err == nil && info.IsDir()
+}
+
+func (s *Service) loadPatterns() {
+	projectPath := s.config.GetProjectPath()
+	if projectPath == "" {
+		return
+	}
+
+	for _, ignoreFile := range s.findIgnoreFiles(projectPath) {
+		base, _ := filepath.Rel(projectPath, filepath.Dir(ignoreFile))
+		s.patterns = append(s.patterns, s.readPatterns(ignoreFile, filepath.ToSlash(base))...)
+	}
+}
+
+// findIgnoreFiles return the files ordered by depth, so patterns of deeper files have precedence over patterns of
+// the files in the parent directories
+func (s *Service) findIgnoreFiles(projectPath string) (ignoreFiles []string) {
+	_ = filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() && s.isDefaultFolderToIgnore(projectPath, path) {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && info.Name() == FileName {
+			ignoreFiles = append(ignoreFiles, path)
+		}
+		return nil
+	})
+
+	sort.SliceStable(ignoreFiles, func(i, j int) bool {
+		return strings.Count(ignoreFiles[i], string(os.PathSeparator)) <
+			strings.Count(ignoreFiles[j], string(os.PathSeparator))
+	})
+	return ignoreFiles
+}
+
+func (s *Service) isDefaultFolderToIgnore(projectPath, path string) bool {
+	relativePath, _ := filepath.Rel(projectPath, path)
+	relativePath = string(os.PathSeparator) + relativePath + string(os.PathSeparator)
+	for _, value := range toignore.GetDefaultFoldersToIgnore() {
+		if strings.Contains(relativePath, file.ReplacePathSeparator(value)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Service) readPatterns(ignoreFile, base string) (patterns []pattern) {
+	content, err := os.Open(ignoreFile)
+	if err != nil {
+		logger.LogErrorWithLevel(messages.MsgErrorReadHorusecIgnoreFile, err)
+		return nil
+	}
+	defer func() {
+		logger.LogError(messages.MsgErrorDeferFileClose, content.Close())
+	}()
+
+	scanner := bufio.NewScanner(content)
+	for scanner.Scan() {
+		if newPattern := parsePattern(scanner.Text(), base); newPattern != nil {
+			patterns = append(patterns, *newPattern)
+		}
+	}
+
+	return patterns
+}
+
+func parsePattern(line, base string) *pattern {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	newPattern := &pattern{base: base}
+	if strings.HasPrefix(line, "!") {
+		newPattern.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		newPattern.dirOnly = true
+		line = strings.TrimSuffix(line, "/")
+	}
+
+	newPattern.anchored = strings.Contains(line, "/")
+	newPattern.glob = strings.TrimPrefix(line, "/")
+	if newPattern.glob == "" {
+		return nil
+	}
+
+	return newPattern
+}
+
+func (p *pattern) match(relativePath string, isDir bool) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+
+	pathFromBase, ok := p.getPathFromBase(relativePath)
+	if !ok {
+		return false
+	}
+
+	glob := p.glob
+	if !p.anchored {
+		glob = "**/" + glob
+	}
+
+	matched, _ := doublestar.Match(glob, pathFromBase)
+	return matched
+}
+
+func (p *pattern) getPathFromBase(relativePath string) (string, bool) {
+	if p.base == "" || p.base == "." {
+		return relativePath, true
+	}
+
+	if !strings.HasPrefix(relativePath, p.base+"/") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(relativePath, p.base+"/"), true
+}
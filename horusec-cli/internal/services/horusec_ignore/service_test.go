@@ -0,0 +1,93 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horusecignore
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeFile(t *testing.T, path, content string) {
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), os.ModePerm))
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), os.ModePerm))
+}
+
+func newServiceWithExampleProject(t *testing.T) (IService, string) {
+	projectPath := t.TempDir()
+	writeFile(t, filepath.Join(projectPath, FileName), "# comment\n*.log\n!important.log\n/build\ndocs/\n**/generated/**\n")
+	writeFile(t, filepath.Join(projectPath, "src", FileName), "secret.go\n")
+	writeFile(t, filepath.Join(projectPath, "docs", "readme.md"), "")
+	writeFile(t, filepath.Join(projectPath, "src", "docs"), "")
+
+	configs := &config.Config{}
+	configs.SetProjectPath(projectPath)
+	return NewHorusecIgnoreService(configs), projectPath
+}
+
+func TestIsToIgnore(t *testing.T) {
+	service, projectPath := newServiceWithExampleProject(t)
+
+	t.Run("should ignore files matching patterns in any directory", func(t *testing.T) {
+		assert.True(t, service.IsToIgnore("app.log"))
+		assert.True(t, service.IsToIgnore("src/api/app.log"))
+		assert.True(t, service.IsToIgnore(filepath.Join(projectPath, "src", "api", "app.log")))
+	})
+
+	t.Run("should not ignore files re-included by negation", func(t *testing.T) {
+		assert.False(t, service.IsToIgnore("important.log"))
+		assert.False(t, service.IsToIgnore("src/important.log"))
+	})
+
+	t.Run("should ignore anchored paths only from the directory of the ignore file", func(t *testing.T) {
+		assert.True(t, service.IsToIgnore("build/main.go"))
+		assert.False(t, service.IsToIgnore("src/build/main.go"))
+	})
+
+	t.Run("should ignore directory only patterns just for directories", func(t *testing.T) {
+		assert.True(t, service.IsToIgnore("docs"))
+		assert.True(t, service.IsToIgnore("docs/readme.md"))
+		assert.False(t, service.IsToIgnore("src/docs"))
+	})
+
+	t.Run("should ignore paths matching double star patterns", func(t *testing.T) {
+		assert.True(t, service.IsToIgnore("generated/models.go"))
+		assert.True(t, service.IsToIgnore("src/api/generated/v1/models.go"))
+	})
+
+	t.Run("should apply patterns of nested ignore file only inside its directory", func(t *testing.T) {
+		assert.True(t, service.IsToIgnore("src/secret.go"))
+		assert.True(t, service.IsToIgnore("src/api/secret.go"))
+		assert.False(t, service.IsToIgnore("secret.go"))
+	})
+
+	t.Run("should not ignore paths outside of the project", func(t *testing.T) {
+		assert.False(t, service.IsToIgnore("../app.log"))
+		assert.False(t, service.IsToIgnore(""))
+	})
+}
+
+func TestIsToIgnoreWithoutIgnoreFile(t *testing.T) {
+	t.Run("should not ignore any path when not exists ignore file", func(t *testing.T) {
+		configs := &config.Config{}
+		configs.SetProjectPath(t.TempDir())
+
+		assert.False(t, NewHorusecIgnoreService(configs).IsToIgnore("app.log"))
+	})
+}
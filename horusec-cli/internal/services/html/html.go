@@ -0,0 +1,136 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"bytes"
+	"html/template"
+	"sort"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/html"
+	"github.com/ZupIT/horusec/horusec-cli/internal/services/html/templates"
+)
+
+const dateLayout = "2006-01-02 15:04:05"
+
+type Interface interface {
+	ConvertVulnerabilityToHTML() ([]byte, error)
+}
+
+type HTML struct {
+	analysis           *horusecEntities.Analysis
+	enableCommitAuthor bool
+	tpl                *template.Template
+}
+
+func NewHTML(analysis *horusecEntities.Analysis, enableCommitAuthor bool) Interface {
+	return &HTML{
+		analysis:           analysis,
+		enableCommitAuthor: enableCommitAuthor,
+		tpl:                template.Must(template.New("report").Parse(templates.ReportTpl)),
+	}
+}
+
+func (h *HTML) ConvertVulnerabilityToHTML() ([]byte, error) {
+	body := new(bytes.Buffer)
+	if err := h.tpl.Execute(body, h.newReport()); err != nil {
+		return nil, err
+	}
+
+	return body.Bytes(), nil
+}
+
+func (h *HTML) newReport() *html.Report {
+	return &html.Report{
+		Analysis:           h.analysis,
+		StartedAt:          h.analysis.CreatedAt.Format(dateLayout),
+		FinishedAt:         h.analysis.FinishedAt.Format(dateLayout),
+		EnableCommitAuthor: h.enableCommitAuthor,
+		Summary:            h.getSummary(),
+		Severities:         h.getSeverities(),
+		Languages:          h.getLanguages(),
+		Tools:              h.getTools(),
+		Types:              h.getTypes(),
+	}
+}
+
+func (h *HTML) getSummary() (summary []html.SummaryRow) {
+	totalBySeverity := h.analysis.GetTotalVulnerabilitiesBySeverity()
+	for _, vulnType := range h.getVulnerabilityTypes() {
+		row := html.SummaryRow{Type: vulnType.ToString()}
+		for _, severityName := range h.getSeverities() {
+			row.Totals = append(row.Totals, totalBySeverity[vulnType][severity.Severity(severityName)])
+		}
+
+		summary = append(summary, row)
+	}
+
+	return summary
+}
+
+func (h *HTML) getLanguages() []string {
+	return h.getDistinctValues(func(vulnerability *horusecEntities.Vulnerability) string {
+		return vulnerability.Language.ToString()
+	})
+}
+
+func (h *HTML) getTools() []string {
+	return h.getDistinctValues(func(vulnerability *horusecEntities.Vulnerability) string {
+		return vulnerability.SecurityTool.ToString()
+	})
+}
+
+func (h *HTML) getTypes() []string {
+	return h.getDistinctValues(func(vulnerability *horusecEntities.Vulnerability) string {
+		return vulnerability.Type.ToString()
+	})
+}
+
+func (h *HTML) getDistinctValues(getValue func(v *horusecEntities.Vulnerability) string) (values []string) {
+	exists := map[string]bool{}
+	for index := range h.analysis.AnalysisVulnerabilities {
+		value := getValue(&h.analysis.AnalysisVulnerabilities[index].Vulnerability)
+		if value != "" && !exists[value] {
+			exists[value] = true
+			values = append(values, value)
+		}
+	}
+
+	sort.Strings(values)
+	return values
+}
+
+func (h *HTML) getVulnerabilityTypes() []horusec.VulnerabilityType {
+	return []horusec.VulnerabilityType{
+		horusec.Vulnerability,
+		horusec.RiskAccepted,
+		horusec.FalsePositive,
+		horusec.Corrected,
+	}
+}
+
+func (h *HTML) getSeverities() []string {
+	return []string{
+		severity.Critical.ToString(),
+		severity.High.ToString(),
+		severity.Medium.ToString(),
+		severity.Low.ToString(),
+		severity.Unknown.ToString(),
+		severity.Info.ToString(),
+	}
+}
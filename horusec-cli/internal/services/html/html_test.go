@@ -0,0 +1,68 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package html
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertVulnerabilityToHTML(t *testing.T) {
+	analysis := &horusec.Analysis{
+		ID:        uuid.New(),
+		CreatedAt: time.Now(),
+		Status:    enumHorusec.Success,
+		AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+			{
+				Vulnerability: horusec.Vulnerability{
+					Line:         "10",
+					File:         "main.go",
+					Code:         "exec.Command(\"sh\", \"-c\", input)",
+					Details:      "<script>alert(1)</script>",
+					Severity:     severity.High,
+					SecurityTool: tools.GoSec,
+					Language:     languages.Go,
+					Type:         enumHorusec.Vulnerability,
+					CommitAuthor: "horusec-author",
+				},
+			},
+		},
+	}
+
+	t.Run("should success parse analysis to html output", func(t *testing.T) {
+		output, err := NewHTML(analysis, false).ConvertVulnerabilityToHTML()
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(output), "<option value=\"GoSec\">GoSec</option>")
+		assert.Contains(t, string(output), "main.go")
+		assert.Contains(t, string(output), "&lt;script&gt;alert(1)&lt;/script&gt;")
+		assert.NotContains(t, string(output), "horusec-author")
+	})
+
+	t.Run("should show commit author when is enabled", func(t *testing.T) {
+		output, err := NewHTML(analysis, true).ConvertVulnerabilityToHTML()
+
+		assert.NoError(t, err)
+		assert.Contains(t, string(output), "horusec-author")
+	})
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint
+package templates
+
+const ReportTpl = `<!doctype html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8" />
+  <meta name="viewport" content="width=device-width, initial-scale=1" />
+  <title>Horusec - Analysis Report</title>
+  <style>
+    body { font-family: Arial, Helvetica, sans-serif; margin: 0; background: #1c1c1e; color: #f4f4f4; }
+    header { padding: 24px 32px; background: #111111; border-bottom: 3px solid #ef4123; }
+    header h1 { margin: 0 0 8px 0; font-size: 24px; }
+    main { padding: 24px 32px; }
+    h2 { font-size: 18px; border-bottom: 1px solid #3a3a3c; padding-bottom: 8px; }
+    table { border-collapse: collapse; width: 100%; margin-bottom: 24px; font-size: 13px; }
+    th, td { border: 1px solid #3a3a3c; padding: 6px 8px; text-align: left; vertical-align: top; }
+    th { background: #2c2c2e; }
+    pre { margin: 0; white-space: pre-wrap; word-break: break-all; background: #2c2c2e; padding: 6px; }
+    .filters { display: flex; gap: 16px; margin-bottom: 16px; flex-wrap: wrap; }
+    .filters label { display: flex; flex-direction: column; font-size: 12px; gap: 4px; }
+    .severity-CRITICAL { color: #ff453a; font-weight: bold; }
+    .severity-HIGH { color: #ff9f0a; font-weight: bold; }
+    .severity-MEDIUM { color: #ffd60a; }
+    .severity-LOW { color: #30d158; }
+    .severity-UNKNOWN, .severity-INFO { color: #8e8e93; }
+    .errors { color: #ff453a; }
+  </style>
+</head>
+<body>
+  <header>
+    <h1>Horusec Analysis Report</h1>
+    <div>Analysis ID: {{ .Analysis.ID }}</div>
+    <div>Repository: {{ .Analysis.RepositoryName }}</div>
+    <div>Status: {{ .Analysis.Status }}</div>
+    <div>Started at: {{ .StartedAt }} | Finished at: {{ .FinishedAt }}</div>
+  </header>
+  <main>
+    <h2>Summary</h2>
+    <table id="summary">
+      <thead>
+        <tr>
+          <th>Type</th>
+          {{- range .Severities }}
+          <th class="severity-{{ . }}">{{ . }}</th>
+          {{- end }}
+        </tr>
+      </thead>
+      <tbody>
+        {{- range .Summary }}
+        <tr>
+          <td>{{ .Type }}</td>
+          {{- range .Totals }}
+          <td>{{ . }}</td>
+          {{- end }}
+        </tr>
+        {{- end }}
+      </tbody>
+    </table>
+
+    {{- if .Analysis.Errors }}
+    <h2>Errors</h2>
+    <pre class="errors">{{ .Analysis.Errors }}</pre>
+    {{- end }}
+
+    <h2>Vulnerabilities ({{ len .Analysis.AnalysisVulnerabilities }})</h2>
+    <div class="filters">
+      <label>Language
+        <select data-filter="language" onchange="applyFilters()">
+          <option value="">All</option>
+          {{- range .Languages }}
+          <option value="{{ . }}">{{ . }}</option>
+          {{- end }}
+        </select>
+      </label>
+      <label>Tool
+        <select data-filter="tool" onchange="applyFilters()">
+          <option value="">All</option>
+          {{- range .Tools }}
+          <option value="{{ . }}">{{ . }}</option>
+          {{- end }}
+        </select>
+      </label>
+      <label>Severity
+        <select data-filter="severity" onchange="applyFilters()">
+          <option value="">All</option>
+          {{- range .Severities }}
+          <option value="{{ . }}">{{ . }}</option>
+          {{- end }}
+        </select>
+      </label>
+      <label>Type
+        <select data-filter="type" onchange="applyFilters()">
+          <option value="">All</option>
+          {{- range .Types }}
+          <option value="{{ . }}">{{ . }}</option>
+          {{- end }}
+        </select>
+      </label>
+    </div>
+    <table id="vulnerabilities">
+      <thead>
+        <tr>
+          <th>Severity</th>
+          <th>Language</th>
+          <th>Tool</th>
+          <th>Type</th>
+          <th>File</th>
+          <th>Line</th>
+          <th>Column</th>
+          <th>Confidence</th>
+          <th>Details</th>
+          <th>Classification</th>
+          <th>Code</th>
+          {{- if .EnableCommitAuthor }}
+          <th>Commit</th>
+          {{- end }}
+          <th>ReferenceHash</th>
+        </tr>
+      </thead>
+      <tbody>
+        {{- $enableCommitAuthor := .EnableCommitAuthor }}
+        {{- range .Analysis.AnalysisVulnerabilities }}
+        {{- with .Vulnerability }}
+        <tr data-language="{{ .Language }}" data-tool="{{ .SecurityTool }}" data-severity="{{ .Severity }}" data-type="{{ .Type }}">
+          <td class="severity-{{ .Severity }}">{{ .Severity }}</td>
+          <td>{{ .Language }}</td>
+          <td>{{ .SecurityTool }}</td>
+          <td>{{ .Type }}</td>
+          <td>{{ .File }}</td>
+          <td>{{ .Line }}</td>
+          <td>{{ .Column }}</td>
+          <td>{{ .Confidence }}</td>
+          <td><pre>{{ .Details }}</pre></td>
+          <td>
+            {{- range .CWEs }}
+            <div>{{ . }}</div>
+            {{- end }}
+            {{- range .OWASPTop10 }}
+            <div>{{ . }}</div>
+            {{- end }}
+          </td>
+          <td><pre>{{ .Code }}</pre></td>
+          {{- if $enableCommitAuthor }}
+          <td>
+            <div>Author: {{ .CommitAuthor }}</div>
+            <div>Email: {{ .CommitEmail }}</div>
+            <div>Date: {{ .CommitDate }}</div>
+            <div>Hash: {{ .CommitHash }}</div>
+            <div>Message: {{ .CommitMessage }}</div>
+          </td>
+          {{- end }}
+          <td>{{ .VulnHash }}</td>
+        </tr>
+        {{- end }}
+        {{- end }}
+      </tbody>
+    </table>
+  </main>
+  <script>
+    function applyFilters() {
+      var filters = {};
+      document.querySelectorAll("select[data-filter]").forEach(function (select) {
+        filters[select.getAttribute("data-filter")] = select.value;
+      });
+      document.querySelectorAll("#vulnerabilities tbody tr").forEach(function (row) {
+        var visible = Object.keys(filters).every(function (key) {
+          return filters[key] === "" || row.getAttribute("data-" + key) === filters[key];
+        });
+        row.style.display = visible ? "" : "none";
+      });
+    }
+  </script>
+</body>
+</html>
+`
@@ -0,0 +1,122 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package junit
+
+import (
+	"fmt"
+	"strings"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/junit"
+)
+
+const ReportName = "horusec"
+
+type Interface interface {
+	ConvertVulnerabilityToJUnit() junit.Report
+}
+
+type JUnit struct {
+	analysis *horusecEntities.Analysis
+}
+
+func NewJUnit(analysis *horusecEntities.Analysis) Interface {
+	return &JUnit{
+		analysis: analysis,
+	}
+}
+
+func (j *JUnit) ConvertVulnerabilityToJUnit() (report junit.Report) {
+	report.Name = ReportName
+	report.TestSuites = []junit.TestSuite{}
+
+	suitesByTool := map[tools.Tool]int{}
+	for index := range j.analysis.AnalysisVulnerabilities {
+		vulnerability := j.analysis.AnalysisVulnerabilities[index].Vulnerability
+
+		suiteIndex, ok := suitesByTool[vulnerability.SecurityTool]
+		if !ok {
+			suiteIndex = len(report.TestSuites)
+			suitesByTool[vulnerability.SecurityTool] = suiteIndex
+			report.TestSuites = append(report.TestSuites, junit.TestSuite{Name: vulnerability.SecurityTool.ToString()})
+		}
+
+		j.addVulnerabilityInTestSuite(&report.TestSuites[suiteIndex], &vulnerability)
+	}
+
+	return j.setReportTotals(report)
+}
+
+func (j *JUnit) addVulnerabilityInTestSuite(suite *junit.TestSuite, vulnerability *horusecEntities.Vulnerability) {
+	testCase := junit.TestCase{
+		Name:      j.getTestCaseName(vulnerability),
+		ClassName: j.getTestCaseClassName(vulnerability),
+	}
+
+	if j.isTypeVulnToSkip(vulnerability) {
+		testCase.Skipped = &junit.Skipped{Message: vulnerability.Type.ToString()}
+		suite.Skipped++
+	} else {
+		testCase.Failure = j.newFailure(vulnerability)
+		suite.Failures++
+	}
+
+	suite.Tests++
+	suite.TestCases = append(suite.TestCases, testCase)
+}
+
+func (j *JUnit) newFailure(vulnerability *horusecEntities.Vulnerability) *junit.Failure {
+	return &junit.Failure{
+		Message: j.getTestCaseName(vulnerability),
+		Type:    vulnerability.Severity.ToString(),
+		Content: fmt.Sprintf("Severity: %s\nConfidence: %s\nFile: %s\nLine: %s\nColumn: %s\nCode: %s\n"+
+			"Details: %s\nReferenceHash: %s", vulnerability.Severity, vulnerability.Confidence, vulnerability.File,
+			vulnerability.Line, vulnerability.Column, vulnerability.Code, vulnerability.Details, vulnerability.VulnHash),
+	}
+}
+
+func (j *JUnit) setReportTotals(report junit.Report) junit.Report {
+	for index := range report.TestSuites {
+		report.Tests += report.TestSuites[index].Tests
+		report.Failures += report.TestSuites[index].Failures
+		report.Skipped += report.TestSuites[index].Skipped
+	}
+
+	return report
+}
+
+func (j *JUnit) getTestCaseName(vulnerability *horusecEntities.Vulnerability) string {
+	name := strings.TrimSpace(strings.Split(vulnerability.Details, "\n")[0])
+	if name == "" {
+		return vulnerability.VulnHash
+	}
+
+	return name
+}
+
+func (j *JUnit) getTestCaseClassName(vulnerability *horusecEntities.Vulnerability) string {
+	if vulnerability.Line == "" {
+		return vulnerability.File
+	}
+
+	return fmt.Sprintf("%s:%s", vulnerability.File, vulnerability.Line)
+}
+
+func (j *JUnit) isTypeVulnToSkip(vulnerability *horusecEntities.Vulnerability) bool {
+	return vulnerability.Type == horusec.FalsePositive || vulnerability.Type == horusec.RiskAccepted ||
+		vulnerability.Type == horusec.Corrected
+}
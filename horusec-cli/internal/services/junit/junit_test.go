@@ -0,0 +1,89 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package junit
+
+import (
+	"testing"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertVulnerabilityToJUnit(t *testing.T) {
+	t.Run("should success parse analysis to junit output", func(t *testing.T) {
+		analysis := &horusec.Analysis{
+			AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+				{
+					Vulnerability: horusec.Vulnerability{
+						Line:         "10",
+						File:         "main.go",
+						Details:      "Hard-coded credential\nAvoid hard-coded credentials",
+						Severity:     severity.High,
+						SecurityTool: tools.GoSec,
+						Type:         enumHorusec.Vulnerability,
+					},
+				},
+				{
+					Vulnerability: horusec.Vulnerability{
+						Line:         "12",
+						File:         "main.go",
+						Details:      "Weak hash",
+						Severity:     severity.Medium,
+						SecurityTool: tools.GoSec,
+						Type:         enumHorusec.FalsePositive,
+					},
+				},
+				{
+					Vulnerability: horusec.Vulnerability{
+						File:         "package.json",
+						Details:      "Vulnerable dependency",
+						Severity:     severity.Low,
+						SecurityTool: tools.NpmAudit,
+						Type:         enumHorusec.RiskAccepted,
+					},
+				},
+			},
+		}
+
+		report := NewJUnit(analysis).ConvertVulnerabilityToJUnit()
+
+		assert.Equal(t, 3, report.Tests)
+		assert.Equal(t, 1, report.Failures)
+		assert.Equal(t, 2, report.Skipped)
+		assert.Len(t, report.TestSuites, 2)
+
+		goSecSuite := report.TestSuites[0]
+		assert.Equal(t, tools.GoSec.ToString(), goSecSuite.Name)
+		assert.Equal(t, "Hard-coded credential", goSecSuite.TestCases[0].Name)
+		assert.Equal(t, "main.go:10", goSecSuite.TestCases[0].ClassName)
+		assert.Equal(t, severity.High.ToString(), goSecSuite.TestCases[0].Failure.Type)
+		assert.Nil(t, goSecSuite.TestCases[1].Failure)
+		assert.Equal(t, enumHorusec.FalsePositive.ToString(), goSecSuite.TestCases[1].Skipped.Message)
+
+		npmSuite := report.TestSuites[1]
+		assert.Equal(t, "package.json", npmSuite.TestCases[0].ClassName)
+		assert.NotNil(t, npmSuite.TestCases[0].Skipped)
+	})
+
+	t.Run("should return empty test suites when analysis has no vulnerabilities", func(t *testing.T) {
+		report := NewJUnit(&horusec.Analysis{}).ConvertVulnerabilityToJUnit()
+
+		assert.Equal(t, ReportName, report.Name)
+		assert.Empty(t, report.TestSuites)
+	})
+}
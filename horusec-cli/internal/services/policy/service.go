@@ -0,0 +1,77 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"strings"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	horusecEnum "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
+)
+
+type IService interface {
+	Evaluate(analysis *horusecEntities.Analysis) *policy.Result
+}
+
+type Service struct {
+	config cliConfig.IConfig
+}
+
+func NewPolicyService(config cliConfig.IConfig) IService {
+	return &Service{
+		config: config,
+	}
+}
+
+func (s *Service) Evaluate(analysis *horusecEntities.Analysis) *policy.Result {
+	result := &policy.Result{}
+	for _, rule := range s.config.GetPolicy().Rules {
+		total := s.countVulnerabilitiesByRule(analysis, &rule)
+		result.Rules = append(result.Rules, policy.RuleResult{
+			Rule:     rule,
+			Total:    total,
+			Violated: total > rule.MaxCount,
+		})
+	}
+
+	return result
+}
+
+func (s *Service) countVulnerabilitiesByRule(analysis *horusecEntities.Analysis, rule *policy.Rule) (total int) {
+	for index := range analysis.AnalysisVulnerabilities {
+		if s.isVulnerabilityMatchRule(&analysis.AnalysisVulnerabilities[index].Vulnerability, rule) {
+			total++
+		}
+	}
+
+	return total
+}
+
+// isVulnerabilityMatchRule only count vulnerabilities not marked as false positive, risk accepted or corrected
+func (s *Service) isVulnerabilityMatchRule(vulnerability *horusecEntities.Vulnerability, rule *policy.Rule) bool {
+	if s.isTypeVulnToSkip(vulnerability) || (rule.OnlyNew && vulnerability.PreExisting) {
+		return false
+	}
+
+	return (rule.Severity == "" || rule.Severity == vulnerability.Severity) &&
+		(rule.Tool == "" || strings.EqualFold(rule.Tool, vulnerability.SecurityTool.ToString())) &&
+		(rule.Language == "" || strings.EqualFold(rule.Language, vulnerability.Language.ToString()))
+}
+
+func (s *Service) isTypeVulnToSkip(vulnerability *horusecEntities.Vulnerability) bool {
+	return vulnerability.Type != "" && vulnerability.Type != horusecEnum.Vulnerability
+}
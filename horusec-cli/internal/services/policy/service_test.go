@@ -0,0 +1,87 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package policy
+
+import (
+	"testing"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	horusecEnum "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
+	"github.com/stretchr/testify/assert"
+)
+
+func newAnalysis(vulnerabilities ...horusecEntities.Vulnerability) *horusecEntities.Analysis {
+	analysis := &horusecEntities.Analysis{}
+	for index := range vulnerabilities {
+		analysis.AnalysisVulnerabilities = append(analysis.AnalysisVulnerabilities,
+			horusecEntities.AnalysisVulnerabilities{Vulnerability: vulnerabilities[index]})
+	}
+
+	return analysis
+}
+
+func newService(rules ...policy.Rule) IService {
+	config := cliConfig.NewConfig()
+	config.SetPolicy(policy.Policy{Rules: rules})
+	return NewPolicyService(config)
+}
+
+func TestEvaluate(t *testing.T) {
+	analysis := newAnalysis(
+		horusecEntities.Vulnerability{Severity: severity.Critical, SecurityTool: tools.GoSec, Language: languages.Go},
+		horusecEntities.Vulnerability{Severity: severity.High, SecurityTool: tools.HorusecLeaks,
+			Language: languages.Leaks},
+		horusecEntities.Vulnerability{Severity: severity.High, SecurityTool: tools.HorusecLeaks,
+			Language: languages.Leaks, PreExisting: true},
+		horusecEntities.Vulnerability{Severity: severity.Critical, SecurityTool: tools.GoSec, Language: languages.Go,
+			Type: horusecEnum.FalsePositive},
+	)
+
+	t.Run("should violate rule when found more vulnerabilities than allowed", func(t *testing.T) {
+		result := newService(policy.Rule{Name: "no-critical", Severity: severity.Critical}).Evaluate(analysis)
+
+		assert.Len(t, result.Rules, 1)
+		assert.Equal(t, 1, result.Rules[0].Total)
+		assert.True(t, result.Rules[0].Violated)
+	})
+
+	t.Run("should not violate rule when total is not greater than max count", func(t *testing.T) {
+		result := newService(policy.Rule{Name: "max-high", Severity: severity.High, MaxCount: 2}).Evaluate(analysis)
+
+		assert.Equal(t, 2, result.Rules[0].Total)
+		assert.False(t, result.IsViolated())
+	})
+
+	t.Run("should filter by tool and language ignoring case", func(t *testing.T) {
+		result := newService(
+			policy.Rule{Name: "leaks", Tool: "horuseclEAKS"},
+			policy.Rule{Name: "go", Language: "GO"},
+		).Evaluate(analysis)
+
+		assert.Equal(t, 2, result.Rules[0].Total)
+		assert.Equal(t, 1, result.Rules[1].Total)
+	})
+
+	t.Run("should ignore pre existing vulnerabilities when only new", func(t *testing.T) {
+		result := newService(policy.Rule{Name: "new-leaks", Tool: "HorusecLeaks", OnlyNew: true}).Evaluate(analysis)
+
+		assert.Equal(t, 1, result.Rules[0].Total)
+	})
+}
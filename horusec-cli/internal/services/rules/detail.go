@@ -0,0 +1,165 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"strings"
+
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/cwe"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/structure"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/development-kit/pkg/utils/classification"
+)
+
+const (
+	TypeBlock    = "Block"
+	TypeManifest = "Manifest"
+)
+
+// Detail is the information of a rule shown to users, the expressions are only of text rules and the targets are the
+// blocks or kinds of resources checked by the rules of structured data
+type Detail struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description"`
+	Severity    string             `json:"severity"`
+	Confidence  string             `json:"confidence"`
+	Language    languages.Language `json:"language"`
+	Engine      tools.Tool         `json:"engine"`
+	Type        string             `json:"type"`
+	CWEs        []string           `json:"cwes,omitempty"`
+	OWASPTop10  []string           `json:"owaspTop10,omitempty"`
+	Expressions []string           `json:"expressions,omitempty"`
+	Targets     []string           `json:"targets,omitempty"`
+}
+
+// Filter selects rules by the fields which are not empty, the fields are compared ignoring case and search is
+// contained in the ID, name or description of rule
+type Filter struct {
+	Language   string
+	Engine     string
+	Severity   string
+	Confidence string
+	Search     string
+}
+
+func textRuleTypes() map[text.MatchType]string {
+	return map[text.MatchType]string{
+		text.Regular:  "Regular",
+		text.NotMatch: "NotMatch",
+		text.OrMatch:  "OrMatch",
+		text.AndMatch: "AndMatch",
+	}
+}
+
+func (r *Rule) ToDetail() *Detail {
+	detail := &Detail{
+		ID:          r.ID,
+		Name:        r.Name,
+		Description: r.Description,
+		Severity:    r.Severity,
+		Confidence:  r.Confidence,
+		Language:    r.Language,
+		Engine:      r.Tool,
+	}
+
+	if cwes := cwe.GetCWEs(r.ID, r.Description); len(cwes) > 0 {
+		detail.CWEs = cwes
+		detail.OWASPTop10 = classification.GetOWASPTop10(cwes...)
+	}
+
+	return r.setDetailOfType(detail)
+}
+
+func (r *Rule) setDetailOfType(detail *Detail) *Detail {
+	switch value := r.Rule.(type) {
+	case custom.Rule:
+		detail.Type, detail.Expressions = r.getTextTypeAndExpressions(value.TextRule)
+	case text.TextRule:
+		detail.Type, detail.Expressions = r.getTextTypeAndExpressions(value)
+	case structure.Rule:
+		detail.Type, detail.Targets = TypeBlock, r.getBlocks(value)
+	case manifest.Rule:
+		detail.Type, detail.Targets = TypeManifest, value.Kinds
+	}
+
+	return detail
+}
+
+func (r *Rule) getTextTypeAndExpressions(rule text.TextRule) (ruleType string, expressions []string) {
+	for _, expression := range rule.Expressions {
+		expressions = append(expressions, expression.String())
+	}
+
+	return textRuleTypes()[rule.Type], expressions
+}
+
+func (r *Rule) getBlocks(rule structure.Rule) (blocks []string) {
+	if len(rule.Labels) == 0 {
+		return []string{rule.BlockType}
+	}
+
+	for _, label := range rule.Labels {
+		blocks = append(blocks, rule.BlockType+" "+label)
+	}
+
+	return blocks
+}
+
+func (f *Filter) IsMatch(rule *Rule) bool {
+	return f.isEqual(f.Language, string(rule.Language)) && f.isEqual(f.Engine, string(rule.Tool)) &&
+		f.isEqual(f.Severity, rule.Severity) && f.isEqual(f.Confidence, rule.Confidence) && f.isSearchMatch(rule)
+}
+
+func (f *Filter) isEqual(filter, value string) bool {
+	return filter == "" || strings.EqualFold(filter, value)
+}
+
+func (f *Filter) isSearchMatch(rule *Rule) bool {
+	search := strings.ToLower(f.Search)
+	for _, value := range []string{rule.ID, rule.Name, rule.Description} {
+		if strings.Contains(strings.ToLower(value), search) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FilterRules returns the rules matched by filter keeping their order
+func FilterRules(rules []Rule, filter *Filter) (filtered []Rule) {
+	for index := range rules {
+		if filter.IsMatch(&rules[index]) {
+			filtered = append(filtered, rules[index])
+		}
+	}
+
+	return filtered
+}
+
+// GetRulesByID returns all rules with the ID, the rules shared by engines are returned once for each engine
+func GetRulesByID(rules []Rule, id string) (found []Rule) {
+	for index := range rules {
+		if strings.EqualFold(rules[index].ID, id) {
+			found = append(found, rules[index])
+		}
+	}
+
+	return found
+}
@@ -0,0 +1,109 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"regexp"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/structure"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRule_ToDetail(t *testing.T) {
+	t.Run("should return detail of text rule with expressions", func(t *testing.T) {
+		rule := NewRule(tools.HorusecGo, languages.Go, text.TextRule{
+			Metadata: engine.Metadata{ID: "HS-GO-1", Name: "Test", Description: "Test rule", Severity: "HIGH",
+				Confidence: "LOW"},
+			Type:        text.AndMatch,
+			Expressions: []*regexp.Regexp{regexp.MustCompile(`exec\(`), regexp.MustCompile(`input`)},
+		})
+
+		assert.Equal(t, &Detail{ID: "HS-GO-1", Name: "Test", Description: "Test rule", Severity: "HIGH",
+			Confidence: "LOW", Language: languages.Go, Engine: tools.HorusecGo, Type: "AndMatch",
+			Expressions: []string{`exec\(`, `input`}}, rule.ToDetail())
+	})
+
+	t.Run("should return detail of structured rules with targets", func(t *testing.T) {
+		blockRule := NewRule(tools.HorusecHCL, languages.HCL, structure.Rule{BlockType: "resource",
+			Labels: []string{"aws_s3_bucket"}})
+		providerRule := NewRule(tools.HorusecHCL, languages.HCL, structure.Rule{BlockType: "provider"})
+		manifestRule := NewRule(tools.HorusecKubernetes, languages.Yaml, manifest.Rule{Kinds: []string{"Pod"}})
+
+		assert.Equal(t, TypeBlock, blockRule.ToDetail().Type)
+		assert.Equal(t, []string{"resource aws_s3_bucket"}, blockRule.ToDetail().Targets)
+		assert.Equal(t, []string{"provider"}, providerRule.ToDetail().Targets)
+		assert.Equal(t, TypeManifest, manifestRule.ToDetail().Type)
+		assert.Equal(t, []string{"Pod"}, manifestRule.ToDetail().Targets)
+	})
+
+	t.Run("should return detail with cwes and owasp top 10 of description", func(t *testing.T) {
+		rule := NewRule(tools.HorusecGo, languages.Go, text.TextRule{
+			Metadata: engine.Metadata{ID: "HS-GO-2", Name: "SQL Injection", Description: "SQL injection. CWE-89"},
+		})
+
+		detail := rule.ToDetail()
+
+		assert.Equal(t, []string{"CWE-89"}, detail.CWEs)
+		assert.Equal(t, []string{"A03:2021-Injection"}, detail.OWASPTop10)
+	})
+}
+
+func TestFilterRules(t *testing.T) {
+	rules := GetBuiltInRules()
+
+	t.Run("should return all rules when filter is empty", func(t *testing.T) {
+		assert.Len(t, FilterRules(rules, &Filter{}), len(rules))
+	})
+
+	t.Run("should return rules of language and severity ignoring case", func(t *testing.T) {
+		filtered := FilterRules(rules, &Filter{Language: "hcl", Severity: "high"})
+
+		assert.NotEmpty(t, filtered)
+		for index := range filtered {
+			assert.Equal(t, languages.HCL, filtered[index].Language)
+			assert.Equal(t, "HIGH", filtered[index].Severity)
+		}
+	})
+
+	t.Run("should return rules with search in name or description", func(t *testing.T) {
+		filtered := FilterRules(rules, &Filter{Engine: "HorusecGo", Search: "insecureskipverify"})
+
+		assert.Len(t, filtered, 1)
+		assert.Equal(t, "6d44fe57-24d2-44a0-ba0e-a1e688030c5d", filtered[0].ID)
+	})
+
+	t.Run("should return no rules when nothing matches", func(t *testing.T) {
+		assert.Empty(t, FilterRules(rules, &Filter{Confidence: "unknown"}))
+	})
+}
+
+func TestGetRulesByID(t *testing.T) {
+	t.Run("should return rule of each engine with the id", func(t *testing.T) {
+		rules := []Rule{
+			{Metadata: engine.Metadata{ID: "HS-JVM-1"}, Tool: tools.HorusecJava},
+			{Metadata: engine.Metadata{ID: "HS-JVM-1"}, Tool: tools.HorusecKotlin},
+			{Metadata: engine.Metadata{ID: "HS-GO-1"}, Tool: tools.HorusecGo},
+		}
+
+		assert.Len(t, GetRulesByID(rules, "hs-jvm-1"), 2)
+		assert.Empty(t, GetRulesByID(rules, "HS-GO-2"))
+	})
+}
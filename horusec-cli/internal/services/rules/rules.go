@@ -0,0 +1,122 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"sort"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/csharp"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/dart"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/dockerfile"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/golang"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/structure"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/java"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kotlin"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/leaks"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/nodejs"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/python"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+)
+
+// Rule is a rule of a native engine, or a custom rule of user, with the tool which runs it
+type Rule struct {
+	engine.Metadata
+	Tool     tools.Tool
+	Language languages.Language
+	Rule     engine.Rule
+}
+
+type nativeEngine struct {
+	tool     tools.Tool
+	language languages.Language
+	getRules func() []engine.Rule
+}
+
+func nativeEngines() []nativeEngine {
+	return []nativeEngine{
+		{tools.HorusecCsharp, languages.CSharp, csharp.NewRules().GetAllRules},
+		{tools.HorusecDart, languages.Dart, dart.NewRules().GetAllRules},
+		{tools.HorusecDockerfile, languages.Dockerfile, dockerfile.NewRules().GetAllRules},
+		{tools.HorusecGo, languages.Go, golang.NewRules().GetAllRules},
+		{tools.HorusecHCL, languages.HCL, hcl.NewRules().GetAllRules},
+		{tools.HorusecJava, languages.Java, java.NewRules().GetAllRules},
+		{tools.HorusecKotlin, languages.Kotlin, kotlin.NewRules().GetAllRules},
+		{tools.HorusecKubernetes, languages.Yaml, kubernetes.NewRules().GetAllRules},
+		{tools.HorusecKubernetes, languages.Yaml, kubernetes.NewRules().GetManifestRules},
+		{tools.HorusecLeaks, languages.Leaks, leaks.NewRules().GetAllRules},
+		{tools.HorusecNodejs, languages.Javascript, nodejs.NewRules().GetAllRules},
+		{tools.HorusecPython, languages.Python, python.NewRules().GetAllRules},
+	}
+}
+
+// GetBuiltInRules returns the rules of all native engines, the rules shared by engines like the ones of jvm are
+// returned once for each engine
+func GetBuiltInRules() (rules []Rule) {
+	for _, native := range nativeEngines() {
+		for _, rule := range native.getRules() {
+			rules = append(rules, NewRule(native.tool, native.language, rule))
+		}
+	}
+
+	return rules
+}
+
+// GetCustomRules returns the custom rules loaded by tool, the language of text rules is the one of their native tool
+func GetCustomRules(customRulesByTool map[tools.Tool][]engine.Rule) (rules []Rule) {
+	for tool, toolRules := range customRulesByTool {
+		for _, rule := range toolRules {
+			rules = append(rules, NewRule(tool, GetLanguageOfTool(tool), rule))
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		return rules[i].Tool < rules[j].Tool
+	})
+
+	return rules
+}
+
+// GetLanguageOfTool returns the language analysed by a native tool, or generic for other tools
+func GetLanguageOfTool(tool tools.Tool) languages.Language {
+	for _, native := range nativeEngines() {
+		if native.tool == tool {
+			return native.language
+		}
+	}
+
+	return languages.Generic
+}
+
+func NewRule(tool tools.Tool, language languages.Language, rule engine.Rule) Rule {
+	switch value := rule.(type) {
+	case custom.Rule:
+		return Rule{Metadata: value.Metadata, Tool: tool, Language: value.Language, Rule: rule}
+	case text.TextRule:
+		return Rule{Metadata: value.Metadata, Tool: tool, Language: language, Rule: rule}
+	case structure.Rule:
+		return Rule{Metadata: value.Metadata, Tool: tool, Language: language, Rule: rule}
+	case manifest.Rule:
+		return Rule{Metadata: value.Metadata, Tool: tool, Language: language, Rule: rule}
+	}
+
+	return Rule{Tool: tool, Language: language, Rule: rule}
+}
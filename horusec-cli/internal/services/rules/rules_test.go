@@ -0,0 +1,79 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"regexp"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBuiltInRules(t *testing.T) {
+	t.Run("should return rules of all native engines with metadata", func(t *testing.T) {
+		rules := GetBuiltInRules()
+		assert.NotEmpty(t, rules)
+
+		toolsFound := map[tools.Tool]bool{}
+		for index := range rules {
+			assert.NotEmpty(t, rules[index].ID)
+			assert.NotEmpty(t, rules[index].Name)
+			assert.NotEmpty(t, rules[index].Language)
+			assert.NotNil(t, rules[index].Rule)
+			toolsFound[rules[index].Tool] = true
+		}
+
+		for _, native := range nativeEngines() {
+			assert.True(t, toolsFound[native.tool], native.tool)
+		}
+	})
+}
+
+func TestGetCustomRules(t *testing.T) {
+	t.Run("should return custom rules with the language of tool or of rule", func(t *testing.T) {
+		rules := GetCustomRules(map[tools.Tool][]engine.Rule{
+			tools.HorusecJava: {text.TextRule{Metadata: engine.Metadata{ID: "HS-JAVA-1"},
+				Expressions: []*regexp.Regexp{regexp.MustCompile(`test`)}}},
+			tools.HorusecCustom: {custom.Rule{TextRule: text.TextRule{Metadata: engine.Metadata{ID: "HS-RUBY-1"}},
+				Language: languages.Ruby}},
+		})
+
+		assert.Len(t, rules, 2)
+		for index := range rules {
+			switch rules[index].ID {
+			case "HS-JAVA-1":
+				assert.Equal(t, languages.Java, rules[index].Language)
+			case "HS-RUBY-1":
+				assert.Equal(t, languages.Ruby, rules[index].Language)
+				assert.Equal(t, tools.HorusecCustom, rules[index].Tool)
+			}
+		}
+	})
+}
+
+func TestGetLanguageOfTool(t *testing.T) {
+	t.Run("should return language of native tool", func(t *testing.T) {
+		assert.Equal(t, languages.Yaml, GetLanguageOfTool(tools.HorusecKubernetes))
+	})
+
+	t.Run("should return generic for other tools", func(t *testing.T) {
+		assert.Equal(t, languages.Generic, GetLanguageOfTool(tools.GoSec))
+	})
+}
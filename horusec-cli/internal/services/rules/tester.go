@@ -0,0 +1,257 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/hcl/structure"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/kubernetes/manifest"
+)
+
+const (
+	MarkerRuleID = "ruleid"
+	MarkerOK     = "ok"
+)
+
+var ErrRuleNotFound = errors.New("rule not found")
+
+// markerRegex matches comments like "// ruleid: HS-JAVA-1" and "# ok: HS-LEAKS-1, HS-LEAKS-2", the marker must be
+// alone in its line and refers to the next line with code
+var markerRegex = regexp.MustCompile(`^\s*(?://|#|/\*|\*|<!--|--|;)\s*(ruleid|ok)\s*:\s*([\w.-]+(?:\s*,\s*[\w.-]+)*)`)
+
+// Result is the test of a rule in a fixture, the expected lines are the ones marked with ruleid and all other lines
+// found by the rule are unexpected
+type Result struct {
+	RuleID     string
+	File       string
+	Expected   []int
+	Found      []int
+	Missing    []int
+	Unexpected []int
+	Error      error
+}
+
+type fixture struct {
+	path     string
+	content  []byte
+	ruleIDs  []string
+	expected map[string][]int
+}
+
+type Tester struct {
+	rulesByID map[string]Rule
+}
+
+// NewTester returns a tester of the rules, when rules have the same ID the first one is used
+func NewTester(rules []Rule) *Tester {
+	rulesByID := map[string]Rule{}
+	for index := range rules {
+		if _, ok := rulesByID[rules[index].ID]; !ok {
+			rulesByID[rules[index].ID] = rules[index]
+		}
+	}
+
+	return &Tester{rulesByID: rulesByID}
+}
+
+func (r *Result) IsPassed() bool {
+	return r.Error == nil && len(r.Missing) == 0 && len(r.Unexpected) == 0
+}
+
+// Test runs the rules referenced by the markers of each fixture file in path, which can be a file or a folder. Files
+// without markers and hidden folders are ignored
+func (t *Tester) Test(fixturesPath string) (results []*Result, err error) {
+	return results, filepath.Walk(fixturesPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return t.getWalkDirResult(path != fixturesPath && strings.HasPrefix(info.Name(), "."))
+		}
+
+		fileResults, err := t.TestFile(path)
+		results = append(results, fileResults...)
+		return err
+	})
+}
+
+func (t *Tester) getWalkDirResult(isHidden bool) error {
+	if isHidden {
+		return filepath.SkipDir
+	}
+
+	return nil
+}
+
+func (t *Tester) TestFile(path string) (results []*Result, err error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	fixtureFile := t.parseFixture(path, content)
+	for _, ruleID := range fixtureFile.ruleIDs {
+		results = append(results, t.testRule(fixtureFile, ruleID))
+	}
+
+	return results, nil
+}
+
+func (t *Tester) parseFixture(path string, content []byte) *fixture {
+	fixtureFile := &fixture{path: path, content: content, expected: map[string][]int{}}
+	var pending [][]string
+	for index, line := range strings.Split(string(content), "\n") {
+		if marker := markerRegex.FindStringSubmatch(line); marker != nil {
+			pending = append(pending, marker)
+			continue
+		}
+
+		if len(pending) > 0 && strings.TrimSpace(line) != "" {
+			fixtureFile.addMarkers(pending, index+1)
+			pending = nil
+		}
+	}
+
+	return fixtureFile
+}
+
+func (f *fixture) addMarkers(markers [][]string, line int) {
+	for _, marker := range markers {
+		for _, ruleID := range strings.Split(marker[2], ",") {
+			f.addMarker(marker[1], strings.TrimSpace(ruleID), line)
+		}
+	}
+}
+
+func (f *fixture) addMarker(markerType, ruleID string, line int) {
+	if _, ok := f.expected[ruleID]; !ok {
+		f.ruleIDs = append(f.ruleIDs, ruleID)
+		f.expected[ruleID] = []int{}
+	}
+
+	if markerType == MarkerRuleID {
+		f.expected[ruleID] = append(f.expected[ruleID], line)
+	}
+}
+
+func (t *Tester) testRule(fixtureFile *fixture, ruleID string) *Result {
+	result := &Result{RuleID: ruleID, File: fixtureFile.path, Expected: fixtureFile.expected[ruleID]}
+	rule, ok := t.rulesByID[ruleID]
+	if !ok {
+		result.Error = ErrRuleNotFound
+		return result
+	}
+
+	findings, err := t.runRule(rule.Rule, fixtureFile)
+	if err != nil {
+		result.Error = err
+		return result
+	}
+
+	return t.setFoundLines(result, t.getLines(findings))
+}
+
+func (t *Tester) runRule(rule engine.Rule, fixtureFile *fixture) ([]engine.Finding, error) {
+	unit, err := t.newUnit(rule, fixtureFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return unit.Eval(t.getEngineRule(rule)), nil
+}
+
+func (t *Tester) setFoundLines(result *Result, found []int) *Result {
+	result.Found = found
+	result.Missing = t.getDifference(result.Expected, found)
+	result.Unexpected = t.getDifference(found, result.Expected)
+	return result
+}
+
+func (t *Tester) newUnit(rule engine.Rule, fixtureFile *fixture) (engine.Unit, error) {
+	switch rule.(type) {
+	case structure.Rule:
+		file, err := structure.NewFile(fixtureFile.path, fixtureFile.content)
+		return structure.Unit{Files: []*structure.File{file}}, err
+	case manifest.Rule:
+		file, err := manifest.NewFile(fixtureFile.path, fixtureFile.content)
+		return manifest.Unit{Files: []*manifest.File{file}}, err
+	}
+
+	file, err := text.NewTextFile(fixtureFile.path, t.getContentWithLastNewline(fixtureFile.content))
+	return text.TextUnit{Files: []text.TextFile{file}}, err
+}
+
+// getContentWithLastNewline adds the newline at the end of content when missing, because the text engine returns the
+// line 0 for findings in the last line without it
+func (t *Tester) getContentWithLastNewline(content []byte) []byte {
+	if len(content) == 0 || content[len(content)-1] == '\n' {
+		return content
+	}
+
+	return append(append([]byte{}, content...), '\n')
+}
+
+// getEngineRule returns the text rule of custom rules, because the text unit only evaluates text rules
+func (t *Tester) getEngineRule(rule engine.Rule) engine.Rule {
+	if customRule, ok := rule.(custom.Rule); ok {
+		return customRule.TextRule
+	}
+
+	return rule
+}
+
+func (t *Tester) getLines(findings []engine.Finding) (lines []int) {
+	found := map[int]bool{}
+	for index := range findings {
+		if line := findings[index].SourceLocation.Line; !found[line] {
+			found[line] = true
+			lines = append(lines, line)
+		}
+	}
+
+	sort.Ints(lines)
+	return lines
+}
+
+func (t *Tester) getDifference(lines, others []int) (difference []int) {
+	for _, line := range lines {
+		if !t.contains(others, line) {
+			difference = append(difference, line)
+		}
+	}
+
+	return difference
+}
+
+func (t *Tester) contains(lines []int, line int) bool {
+	for _, value := range lines {
+		if value == line {
+			return true
+		}
+	}
+
+	return false
+}
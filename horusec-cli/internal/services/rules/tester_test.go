@@ -0,0 +1,172 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	engine "github.com/ZupIT/horusec-engine"
+	"github.com/ZupIT/horusec-engine/text"
+	"github.com/ZupIT/horusec/development-kit/pkg/engines/custom"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/languages"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/stretchr/testify/assert"
+)
+
+const javaFixture = `class Main {
+    // ruleid: HS-JAVA-1
+    void run() { Runtime.getRuntime().exec(input); }
+
+    // ok: HS-JAVA-1
+    void safe() { new ProcessBuilder("ls").start(); }
+}
+`
+
+func newJavaRule(expression string) Rule {
+	return NewRule(tools.HorusecJava, languages.Java, text.TextRule{
+		Metadata:    engine.Metadata{ID: "HS-JAVA-1"},
+		Type:        text.Regular,
+		Expressions: []*regexp.Regexp{regexp.MustCompile(expression)},
+	})
+}
+
+func writeFixture(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestTester_TestFile(t *testing.T) {
+	t.Run("should pass when rule finds only the lines marked with ruleid", func(t *testing.T) {
+		path := writeFixture(t, t.TempDir(), "Main.java", javaFixture)
+
+		results, err := NewTester([]Rule{newJavaRule(`\.exec\(`)}).TestFile(path)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.True(t, results[0].IsPassed())
+		assert.Equal(t, []int{3}, results[0].Expected)
+		assert.Equal(t, []int{3}, results[0].Found)
+	})
+
+	t.Run("should fail with missing lines when rule does not find marked line", func(t *testing.T) {
+		path := writeFixture(t, t.TempDir(), "Main.java", javaFixture)
+
+		results, err := NewTester([]Rule{newJavaRule(`Runtime\.exec\(`)}).TestFile(path)
+
+		assert.NoError(t, err)
+		assert.False(t, results[0].IsPassed())
+		assert.Equal(t, []int{3}, results[0].Missing)
+	})
+
+	t.Run("should fail with unexpected lines when rule finds line not marked with ruleid", func(t *testing.T) {
+		path := writeFixture(t, t.TempDir(), "Main.java", javaFixture)
+
+		results, err := NewTester([]Rule{newJavaRule(`void`)}).TestFile(path)
+
+		assert.NoError(t, err)
+		assert.False(t, results[0].IsPassed())
+		assert.Equal(t, []int{6}, results[0].Unexpected)
+	})
+
+	t.Run("should fail when rule of marker is not found", func(t *testing.T) {
+		path := writeFixture(t, t.TempDir(), "Main.java", javaFixture)
+
+		results, err := NewTester([]Rule{}).TestFile(path)
+
+		assert.NoError(t, err)
+		assert.ErrorIs(t, results[0].Error, ErrRuleNotFound)
+		assert.False(t, results[0].IsPassed())
+	})
+
+	t.Run("should test custom rules with many ids in marker and in last line", func(t *testing.T) {
+		path := writeFixture(t, t.TempDir(), "app.rb", "# ruleid: HS-RUBY-1, HS-RUBY-2\neval(params[:code])")
+		rules := GetCustomRules(map[tools.Tool][]engine.Rule{tools.HorusecCustom: {
+			custom.Rule{TextRule: text.TextRule{Metadata: engine.Metadata{ID: "HS-RUBY-1"}, Type: text.Regular,
+				Expressions: []*regexp.Regexp{regexp.MustCompile(`eval\(`)}}, Language: languages.Ruby},
+			custom.Rule{TextRule: text.TextRule{Metadata: engine.Metadata{ID: "HS-RUBY-2"}, Type: text.Regular,
+				Expressions: []*regexp.Regexp{regexp.MustCompile(`params`)}}, Language: languages.Ruby},
+		}})
+
+		results, err := NewTester(rules).TestFile(path)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		for _, result := range results {
+			assert.True(t, result.IsPassed(), result.RuleID)
+		}
+	})
+
+	t.Run("should test built-in rules of structured data", func(t *testing.T) {
+		dir := t.TempDir()
+		hclPath := writeFixture(t, dir, "main.tf", `resource "aws_s3_bucket" "public" {
+  bucket = "public"
+  # ruleid: 3f81b118-ecd0-43b7-a40a-a6fac4ada944
+  acl    = "public-read"
+}
+`)
+		manifestPath := writeFixture(t, dir, "pod.yaml", `apiVersion: v1
+kind: Pod
+metadata:
+  name: test
+spec:
+  # ruleid: 9689aa3b-123a-467c-b4cd-e532d5713715
+  hostNetwork: true
+  containers:
+    - name: test
+      image: nginx
+`)
+		tester := NewTester(GetBuiltInRules())
+
+		for _, path := range []string{hclPath, manifestPath} {
+			results, err := tester.TestFile(path)
+			assert.NoError(t, err)
+			assert.Len(t, results, 1)
+			assert.True(t, results[0].IsPassed(), results[0])
+		}
+	})
+
+	t.Run("should return error when file not exists", func(t *testing.T) {
+		_, err := NewTester([]Rule{}).TestFile("./not-exists.java")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestTester_Test(t *testing.T) {
+	t.Run("should test fixtures of folder ignoring hidden folders and files without markers", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFixture(t, dir, "Main.java", javaFixture)
+		writeFixture(t, dir, "README.md", "fixtures of rules\n")
+		assert.NoError(t, os.Mkdir(filepath.Join(dir, ".git"), 0750))
+		writeFixture(t, filepath.Join(dir, ".git"), "Other.java", javaFixture)
+
+		results, err := NewTester([]Rule{newJavaRule(`\.exec\(`)}).Test(dir)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, filepath.Join(dir, "Main.java"), results[0].File)
+	})
+
+	t.Run("should return error when path not exists", func(t *testing.T) {
+		_, err := NewTester([]Rule{}).Test("./not-exists")
+
+		assert.Error(t, err)
+	})
+}
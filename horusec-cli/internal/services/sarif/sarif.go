@@ -0,0 +1,197 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"strconv"
+	"strings"
+
+	horusecEntities "github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	horusecSeverity "github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/sarif"
+)
+
+const (
+	SchemaURI      = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+	Version        = "2.1.0"
+	InformationURI = "https://horusec.io"
+	FingerprintKey = "horusecHash/v1"
+	// SuppressionKindInSource is used to vulnerabilities suppressed by comments in source code
+	SuppressionKindInSource = "inSource"
+)
+
+type Interface interface {
+	ConvertVulnerabilityToSarif() sarif.Report
+}
+
+type Sarif struct {
+	analysis *horusecEntities.Analysis
+}
+
+func NewSarif(analysis *horusecEntities.Analysis) Interface {
+	return &Sarif{
+		analysis: analysis,
+	}
+}
+
+func (s *Sarif) ConvertVulnerabilityToSarif() (report sarif.Report) {
+	report.Schema = SchemaURI
+	report.Version = Version
+	report.Runs = []sarif.Run{}
+
+	runsByTool := map[tools.Tool]int{}
+	for index := range s.analysis.AnalysisVulnerabilities {
+		vulnerability := s.analysis.AnalysisVulnerabilities[index].Vulnerability
+
+		runIndex, ok := runsByTool[vulnerability.SecurityTool]
+		if !ok {
+			runIndex = len(report.Runs)
+			runsByTool[vulnerability.SecurityTool] = runIndex
+			report.Runs = append(report.Runs, s.newRun(vulnerability.SecurityTool))
+		}
+
+		s.addVulnerabilityInRun(&report.Runs[runIndex], &vulnerability)
+	}
+
+	return report
+}
+
+func (s *Sarif) newRun(tool tools.Tool) sarif.Run {
+	return sarif.Run{
+		Tool: sarif.Tool{
+			Driver: sarif.Driver{
+				Name:           tool.ToString(),
+				InformationURI: InformationURI,
+				Rules:          []sarif.Rule{},
+			},
+		},
+		Results: []sarif.Result{},
+	}
+}
+
+func (s *Sarif) addVulnerabilityInRun(run *sarif.Run, vulnerability *horusecEntities.Vulnerability) {
+	ruleID := s.getRuleID(vulnerability)
+	if !s.existsRuleInRun(run, ruleID) {
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, s.newRule(ruleID, vulnerability))
+	}
+
+	run.Results = append(run.Results, s.newResult(ruleID, vulnerability))
+}
+
+func (s *Sarif) existsRuleInRun(run *sarif.Run, ruleID string) bool {
+	for index := range run.Tool.Driver.Rules {
+		if run.Tool.Driver.Rules[index].ID == ruleID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Sarif) newRule(ruleID string, vulnerability *horusecEntities.Vulnerability) sarif.Rule {
+	rule := sarif.Rule{
+		ID:               ruleID,
+		ShortDescription: sarif.Message{Text: ruleID},
+		FullDescription:  sarif.Message{Text: vulnerability.Details},
+	}
+
+	if vulnerability.Title != "" {
+		rule.ShortDescription = sarif.Message{Text: vulnerability.Title}
+	}
+
+	if vulnerability.Remediation != "" {
+		rule.Help = &sarif.Message{Text: vulnerability.Remediation}
+	}
+
+	if len(vulnerability.References) > 0 {
+		rule.HelpURI = vulnerability.References[0]
+	}
+
+	return rule
+}
+
+func (s *Sarif) newResult(ruleID string, vulnerability *horusecEntities.Vulnerability) sarif.Result {
+	result := sarif.Result{
+		RuleID:  ruleID,
+		Level:   s.convertHorusecSeverityToSarif(vulnerability.Severity),
+		Message: sarif.Message{Text: vulnerability.Details},
+		Locations: []sarif.Location{
+			{
+				PhysicalLocation: sarif.PhysicalLocation{
+					ArtifactLocation: sarif.ArtifactLocation{URI: vulnerability.File},
+					Region:           s.newRegion(vulnerability),
+				},
+			},
+		},
+		PartialFingerprints: map[string]string{
+			FingerprintKey: vulnerability.VulnHash,
+		},
+	}
+
+	if vulnerability.SuppressionReason != "" {
+		result.Suppressions = []sarif.Suppression{
+			{Kind: SuppressionKindInSource, Justification: vulnerability.SuppressionReason},
+		}
+	}
+
+	return result
+}
+
+func (s *Sarif) newRegion(vulnerability *horusecEntities.Vulnerability) *sarif.Region {
+	line, _ := strconv.Atoi(vulnerability.Line)
+	if line <= 0 {
+		return nil
+	}
+
+	region := &sarif.Region{StartLine: line}
+	if column, _ := strconv.Atoi(vulnerability.Column); column > 0 {
+		region.StartColumn = column
+	}
+
+	if vulnerability.Code != "" {
+		region.Snippet = &sarif.Message{Text: vulnerability.Code}
+	}
+
+	return region
+}
+
+func (s *Sarif) getRuleID(vulnerability *horusecEntities.Vulnerability) string {
+	ruleID := strings.TrimSpace(strings.Split(vulnerability.Details, "\n")[0])
+	if ruleID == "" {
+		return vulnerability.SecurityTool.ToString()
+	}
+
+	return ruleID
+}
+
+func (s *Sarif) convertHorusecSeverityToSarif(severity horusecSeverity.Severity) string {
+	if level, ok := s.getSarifLevelMap()[severity]; ok {
+		return level
+	}
+
+	return "none"
+}
+
+func (s *Sarif) getSarifLevelMap() map[horusecSeverity.Severity]string {
+	return map[horusecSeverity.Severity]string{
+		horusecSeverity.Critical: "error",
+		horusecSeverity.High:     "error",
+		horusecSeverity.Medium:   "warning",
+		horusecSeverity.Low:      "note",
+		horusecSeverity.Unknown:  "note",
+		horusecSeverity.Info:     "note",
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sarif
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZupIT/horusec/development-kit/pkg/entities/horusec"
+	enumHorusec "github.com/ZupIT/horusec/development-kit/pkg/enums/horusec"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
+	"github.com/ZupIT/horusec/development-kit/pkg/enums/tools"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertVulnerabilityToSarif(t *testing.T) {
+	t.Run("should success parse analysis to sarif output", func(t *testing.T) {
+		analysis := &horusec.Analysis{
+			ID:        uuid.New(),
+			CreatedAt: time.Now(),
+			Status:    enumHorusec.Running,
+			AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+				{
+					Vulnerability: horusec.Vulnerability{
+						Line:         "10",
+						Column:       "2",
+						File:         "main.go",
+						Code:         "password := \"123\"",
+						Details:      "Hard-coded credential\nAvoid hard-coded credentials",
+						Severity:     severity.High,
+						SecurityTool: tools.GoSec,
+						VulnHash:     "hash1",
+					},
+				},
+				{
+					Vulnerability: horusec.Vulnerability{
+						Line:         "12",
+						File:         "main.go",
+						Details:      "Hard-coded credential\nAvoid hard-coded credentials",
+						Severity:     severity.Medium,
+						SecurityTool: tools.GoSec,
+						VulnHash:     "hash2",
+					},
+				},
+				{
+					Vulnerability: horusec.Vulnerability{
+						Line:         "0",
+						File:         "package.json",
+						Details:      "Vulnerable dependency",
+						Severity:     severity.Low,
+						SecurityTool: tools.NpmAudit,
+						VulnHash:     "hash3",
+					},
+				},
+			},
+		}
+
+		report := NewSarif(analysis).ConvertVulnerabilityToSarif()
+
+		assert.Equal(t, Version, report.Version)
+		assert.Len(t, report.Runs, 2)
+
+		goSecRun := report.Runs[0]
+		assert.Equal(t, tools.GoSec.ToString(), goSecRun.Tool.Driver.Name)
+		assert.Len(t, goSecRun.Tool.Driver.Rules, 1)
+		assert.Len(t, goSecRun.Results, 2)
+		assert.Equal(t, "Hard-coded credential", goSecRun.Results[0].RuleID)
+		assert.Equal(t, "error", goSecRun.Results[0].Level)
+		assert.Equal(t, "warning", goSecRun.Results[1].Level)
+		assert.Equal(t, "hash1", goSecRun.Results[0].PartialFingerprints[FingerprintKey])
+		assert.Equal(t, 10, goSecRun.Results[0].Locations[0].PhysicalLocation.Region.StartLine)
+		assert.Equal(t, 2, goSecRun.Results[0].Locations[0].PhysicalLocation.Region.StartColumn)
+
+		npmRun := report.Runs[1]
+		assert.Equal(t, "note", npmRun.Results[0].Level)
+		assert.Nil(t, npmRun.Results[0].Locations[0].PhysicalLocation.Region)
+	})
+
+	t.Run("should return empty runs when analysis has no vulnerabilities", func(t *testing.T) {
+		report := NewSarif(&horusec.Analysis{}).ConvertVulnerabilityToSarif()
+
+		assert.Equal(t, SchemaURI, report.Schema)
+		assert.Empty(t, report.Runs)
+	})
+
+	t.Run("should add in source suppression when vulnerability was suppressed by comment", func(t *testing.T) {
+		analysis := &horusec.Analysis{
+			AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{
+				{
+					Vulnerability: horusec.Vulnerability{
+						SecurityTool:      tools.GoSec,
+						Details:           "Hard-coded credential",
+						SuppressionReason: "only used in tests",
+					},
+				},
+			},
+		}
+
+		report := NewSarif(analysis).ConvertVulnerabilityToSarif()
+
+		assert.Len(t, report.Runs[0].Results[0].Suppressions, 1)
+		assert.Equal(t, SuppressionKindInSource, report.Runs[0].Results[0].Suppressions[0].Kind)
+		assert.Equal(t, "only used in tests", report.Runs[0].Results[0].Suppressions[0].Justification)
+	})
+	t.Run("should add title, remediation and reference of vulnerability in rule", func(t *testing.T) {
+		vulnerability := horusec.Vulnerability{SecurityTool: tools.GoSec, Details: "G101\nHard-coded credential"}
+		vulnerability.SetClassification("CWE-798")
+		vulnerability.SetRule("G101", "Look for hard coded credentials")
+		vulnerability.SetRemediation("Load credentials from environment")
+		analysis := &horusec.Analysis{
+			AnalysisVulnerabilities: []horusec.AnalysisVulnerabilities{{Vulnerability: vulnerability}},
+		}
+
+		rule := NewSarif(analysis).ConvertVulnerabilityToSarif().Runs[0].Tool.Driver.Rules[0]
+
+		assert.Equal(t, "G101", rule.ID)
+		assert.Equal(t, "Look for hard coded credentials", rule.ShortDescription.Text)
+		assert.Equal(t, "Load credentials from environment", rule.Help.Text)
+		assert.Equal(t, "https://cwe.mitre.org/data/definitions/798.html", rule.HelpURI)
+	})
+}
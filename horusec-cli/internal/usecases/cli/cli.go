@@ -24,6 +24,8 @@ import (
 
 	"github.com/ZupIT/horusec/development-kit/pkg/enums/severity"
 	cliConfig "github.com/ZupIT/horusec/horusec-cli/config"
+	externaltools "github.com/ZupIT/horusec/horusec-cli/internal/entities/external_tools"
+	"github.com/ZupIT/horusec/horusec-cli/internal/entities/policy"
 	"github.com/ZupIT/horusec/horusec-cli/internal/entities/workdir"
 	"github.com/ZupIT/horusec/horusec-cli/internal/enums/outputtype"
 	"github.com/ZupIT/horusec/horusec-cli/internal/helpers/messages"
@@ -36,6 +38,8 @@ type ConfigToValidate struct {
 	timeoutInSecondsRequest         int64
 	timeoutInSecondsAnalysis        int64
 	monitorRetryInSeconds           int64
+	maxConcurrentTools              int64
+	gitHistoryDepth                 int64
 	repositoryAuthorization         string
 	printOutputType                 string
 	jSONOutputFilePath              string
@@ -48,6 +52,9 @@ type ConfigToValidate struct {
 	certPath                        string
 	falsePositiveHashes             []string
 	riskAcceptHashes                []string
+	baselineFilePath                string
+	policy                          policy.Policy
+	externalTools                   []externaltools.ExternalTool
 }
 
 type UseCases struct{}
@@ -68,6 +75,8 @@ func (au *UseCases) ValidateConfigs(config cliConfig.IConfig) error {
 		validation.Field(&c.timeoutInSecondsRequest, validation.Required, validation.Min(10)),
 		validation.Field(&c.timeoutInSecondsAnalysis, validation.Required, validation.Min(10)),
 		validation.Field(&c.monitorRetryInSeconds, validation.Required, validation.Min(10)),
+		validation.Field(&c.maxConcurrentTools, validation.Required, validation.Min(1)),
+		validation.Field(&c.gitHistoryDepth, validation.Min(int64(0))),
 		validation.Field(&c.repositoryAuthorization, validation.Required, is.UUID),
 		validation.Field(&c.printOutputType, validation.Required, au.validationOutputTypes()),
 		validation.Field(&c.jSONOutputFilePath, validation.By(au.checkAndValidateJSONOutputFilePath(config))),
@@ -80,6 +89,9 @@ func (au *UseCases) ValidateConfigs(config cliConfig.IConfig) error {
 		validation.Field(&c.certPath, validation.By(au.validateCertPath(config.GetCertPath()))),
 		validation.Field(&c.falsePositiveHashes, validation.By(au.checkIfExistsDuplicatedFalsePositiveHashes(config))),
 		validation.Field(&c.riskAcceptHashes, validation.By(au.checkIfExistsDuplicatedRiskAcceptHashes(config))),
+		validation.Field(&c.baselineFilePath, validation.By(au.validateBaselineFilePath(config))),
+		validation.Field(&c.policy, validation.By(au.validatePolicy(config))),
+		validation.Field(&c.externalTools, validation.By(au.validateExternalTools(config))),
 	)
 }
 
@@ -90,6 +102,8 @@ func (au *UseCases) parseConfigsToConfigValidate(config cliConfig.IConfig) Confi
 		timeoutInSecondsRequest:         config.GetTimeoutInSecondsRequest(),
 		timeoutInSecondsAnalysis:        config.GetTimeoutInSecondsAnalysis(),
 		monitorRetryInSeconds:           config.GetMonitorRetryInSeconds(),
+		maxConcurrentTools:              config.GetMaxConcurrentTools(),
+		gitHistoryDepth:                 config.GetGitHistoryDepth(),
 		repositoryAuthorization:         config.GetRepositoryAuthorization(),
 		printOutputType:                 config.GetPrintOutputType(),
 		jSONOutputFilePath:              config.GetJSONOutputFilePath(),
@@ -102,6 +116,9 @@ func (au *UseCases) parseConfigsToConfigValidate(config cliConfig.IConfig) Confi
 		certPath:                        config.GetCertPath(),
 		falsePositiveHashes:             config.GetFalsePositiveHashes(),
 		riskAcceptHashes:                config.GetRiskAcceptHashes(),
+		baselineFilePath:                config.GetBaselineFilePath(),
+		policy:                          config.GetPolicy(),
+		externalTools:                   config.GetExternalTools(),
 	}
 }
 
@@ -131,14 +148,59 @@ func (au *UseCases) checkIfExistsDuplicatedRiskAcceptHashes(config cliConfig.ICo
 	}
 }
 
+func (au *UseCases) validateBaselineFilePath(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		if config.GetWriteBaseline() {
+			if config.GetBaselineFilePath() == "" {
+				return errors.New(messages.MsgErrorBaselineFilePathNotValid + "baseline file path is required")
+			}
+			return nil
+		}
+		if config.GetBaselineFilePath() != "" {
+			if _, err := os.Stat(config.GetBaselineFilePath()); err != nil {
+				return errors.New(messages.MsgErrorBaselineFilePathNotValid + err.Error())
+			}
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validatePolicy(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		configPolicy := config.GetPolicy()
+		if err := configPolicy.Validate(); err != nil {
+			return errors.New(messages.MsgErrorPolicyNotValid + err.Error())
+		}
+		return nil
+	}
+}
+
+func (au *UseCases) validateExternalTools(config cliConfig.IConfig) func(value interface{}) error {
+	return func(value interface{}) error {
+		for index, externalTool := range config.GetExternalTools() {
+			if err := externalTool.Validate(); err != nil {
+				return fmt.Errorf("%s%d: %w", messages.MsgErrorExternalToolNotValid, index, err)
+			}
+		}
+		return nil
+	}
+}
+
 func (au *UseCases) checkAndValidateJSONOutputFilePath(config cliConfig.IConfig) func(value interface{}) error {
 	return func(value interface{}) error {
 		if config.GetPrintOutputType() == outputtype.JSON.ToString() ||
-			config.GetPrintOutputType() == outputtype.SonarQube.ToString() {
+			config.GetPrintOutputType() == outputtype.SonarQube.ToString() ||
+			config.GetPrintOutputType() == outputtype.Sarif.ToString() {
 			if err := au.validateJSONOutputFilePath(config); err != nil {
 				return err
 			}
 		}
+		if config.GetPrintOutputType() == outputtype.JUnit.ToString() {
+			return au.validateOutputFilePathByExtension(config, ".xml")
+		}
+		if config.GetPrintOutputType() == outputtype.HTML.ToString() {
+			return au.validateOutputFilePathByExtension(config, ".html")
+		}
 		return nil
 	}
 }
@@ -159,10 +221,27 @@ func (au *UseCases) validateJSONOutputFilePath(config cliConfig.IConfig) error {
 	return nil
 }
 
+func (au *UseCases) validateOutputFilePathByExtension(config cliConfig.IConfig, ext string) error {
+	if len(config.GetJSONOutputFilePath()) <= len(ext) {
+		return errors.New(messages.MsgErrorJSONOutputFilePathNotValid + ext + " file path is required")
+	}
+	if filepath.Ext(config.GetJSONOutputFilePath()) != ext {
+		return errors.New(messages.MsgErrorJSONOutputFilePathNotValid + "is not valid " + ext + " file")
+	}
+
+	if output, err := filepath.Abs(config.GetJSONOutputFilePath()); err != nil || output == "" {
+		return errors.New(messages.MsgErrorJSONOutputFilePathNotValid + err.Error())
+	}
+	return nil
+}
+
 func (au *UseCases) validationOutputTypes() validation.InRule {
 	return validation.In(
 		outputtype.JSON.ToString(),
 		outputtype.SonarQube.ToString(),
+		outputtype.Sarif.ToString(),
+		outputtype.JUnit.ToString(),
+		outputtype.HTML.ToString(),
 		outputtype.Text.ToString(),
 	)
 }
@@ -94,6 +94,38 @@ func TestValidateConfigs(t *testing.T) {
 		assert.Equal(t, "jSONOutputFilePath: JSON File path is required or is invalid: is not valid .json file.",
 			err.Error())
 	})
+	t.Run("Should return error when junit output file is not a xml file", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetPrintOutputType(outputtype.JUnit.ToString())
+		config.SetJSONOutputFilePath("output.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Equal(t, "jSONOutputFilePath: JSON File path is required or is invalid: is not valid .xml file.",
+			err.Error())
+	})
+	t.Run("Should return error when write baseline without baseline file path", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetWriteBaseline(true)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Equal(t, "baselineFilePath: Baseline file path is required or is invalid: "+
+			"baseline file path is required.", err.Error())
+	})
+	t.Run("Should return error when baseline file not exists", func(t *testing.T) {
+		config := &cliConfig.Config{}
+		config.SetWorkDir(&workdir.WorkDir{})
+		config.NewConfigsFromEnvironments()
+		config.SetBaselineFilePath("./not-exists-baseline.json")
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+	})
 	t.Run("Should return error when invalid workdir", func(t *testing.T) {
 		config := &cliConfig.Config{}
 
@@ -156,6 +188,40 @@ func TestValidateConfigs(t *testing.T) {
 		assert.Equal(t, "falsePositiveHashes: False positive is not valid because is duplicated in risk accept: 1e836029-4e90-4151-bb4a-d86ef47f96b6; riskAcceptHashes: Risk Accept is not valid because is duplicated in false positive: 1e836029-4e90-4151-bb4a-d86ef47f96b6.",
 			err.Error())
 	})
+	t.Run("Should return error when max concurrent tools is negative", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetMaxConcurrentTools(-1)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "maxConcurrentTools: must be no less than 1")
+	})
+	t.Run("Should return error when git history depth is negative", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetGitHistoryDepth(-1)
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "gitHistoryDepth: must be no less than 0")
+	})
+	t.Run("Should return error when external tool is invalid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetExternalTools([]map[string]interface{}{{"name": "linter", "outputFormat": "sarif"}})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "externalTools: External tool is invalid: 0:")
+	})
+	t.Run("Should return error when policy rule is invalid", func(t *testing.T) {
+		config := cliConfig.NewConfig()
+		config.SetPolicy(map[string]interface{}{
+			"rules": []map[string]interface{}{{"name": "", "severity": "INVALID", "exitCode": 300}},
+		})
+
+		err := useCases.ValidateConfigs(config)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "policy: Policy is invalid: rule 0:")
+	})
 	t.Run("Should return not error when validate false positive and risk accepted", func(t *testing.T) {
 		config := cliConfig.NewConfig()
 		config.SetFalsePositiveHashes([]string{"1e836029-4e90-4151-bb4a-d86ef47f96b6"})
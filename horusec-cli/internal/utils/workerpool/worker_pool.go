@@ -0,0 +1,115 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type Interface interface {
+	Submit(timeout time.Duration, task func(ctx context.Context), onTimeout func(err error))
+	Wait() error
+}
+
+// WorkerPool run tasks with at most maxWorkers at same time, all tasks are canceled when the pool context is done
+type WorkerPool struct {
+	ctx       context.Context
+	workers   chan struct{}
+	waitGroup sync.WaitGroup
+}
+
+func NewWorkerPool(ctx context.Context, maxWorkers int) Interface {
+	if maxWorkers <= 0 {
+		maxWorkers = 1
+	}
+
+	return &WorkerPool{
+		ctx:     ctx,
+		workers: make(chan struct{}, maxWorkers),
+	}
+}
+
+// Submit not block the caller, so is safe to submit new tasks inside of a running task.
+// When timeout is greater than zero, the task context is canceled after it and onTimeout is called, the worker is only
+// released when the task returns
+func (w *WorkerPool) Submit(timeout time.Duration, task func(ctx context.Context), onTimeout func(err error)) {
+	w.waitGroup.Add(1)
+
+	go func() {
+		defer w.waitGroup.Done()
+
+		select {
+		case w.workers <- struct{}{}:
+			defer func() { <-w.workers }()
+			w.runTask(timeout, task, onTimeout)
+		case <-w.ctx.Done():
+		}
+	}()
+}
+
+// Wait return nil when all tasks finished or the pool context error when it is done before
+func (w *WorkerPool) Wait() error {
+	finished := make(chan struct{})
+	go func() {
+		w.waitGroup.Wait()
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+		return nil
+	case <-w.ctx.Done():
+		return w.ctx.Err()
+	}
+}
+
+func (w *WorkerPool) runTask(timeout time.Duration, task func(ctx context.Context), onTimeout func(err error)) {
+	if w.ctx.Err() != nil {
+		return
+	}
+
+	ctx, cancel := w.getTaskContext(timeout)
+	defer cancel()
+
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		task(ctx)
+	}()
+
+	select {
+	case <-finished:
+	case <-ctx.Done():
+		w.callOnTimeout(ctx, onTimeout)
+		// the worker is kept busy until the task returns, tasks that ignore the context still count to max workers
+		<-finished
+	}
+}
+
+func (w *WorkerPool) getTaskContext(timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(w.ctx)
+	}
+
+	return context.WithTimeout(w.ctx, timeout)
+}
+
+func (w *WorkerPool) callOnTimeout(ctx context.Context, onTimeout func(err error)) {
+	if onTimeout != nil && w.ctx.Err() == nil {
+		onTimeout(ctx.Err())
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2020 ZUP IT SERVICOS EM TECNOLOGIA E INOVACAO SA
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWorkerPool(t *testing.T) {
+	t.Run("should use at least one worker", func(t *testing.T) {
+		pool := NewWorkerPool(context.Background(), 0).(*WorkerPool)
+
+		assert.Equal(t, 1, cap(pool.workers))
+	})
+}
+
+func TestSubmit(t *testing.T) {
+	t.Run("should run all tasks without exceed max workers", func(t *testing.T) {
+		pool := NewWorkerPool(context.Background(), 2)
+
+		var running, maxRunning, total int32
+		for i := 0; i < 10; i++ {
+			pool.Submit(0, func(ctx context.Context) {
+				current := atomic.AddInt32(&running, 1)
+				for {
+					old := atomic.LoadInt32(&maxRunning)
+					if current <= old || atomic.CompareAndSwapInt32(&maxRunning, old, current) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				atomic.AddInt32(&running, -1)
+				atomic.AddInt32(&total, 1)
+			}, nil)
+		}
+
+		assert.NoError(t, pool.Wait())
+		assert.Equal(t, int32(10), total)
+		assert.LessOrEqual(t, maxRunning, int32(2))
+	})
+
+	t.Run("should run task submitted inside of other task", func(t *testing.T) {
+		pool := NewWorkerPool(context.Background(), 1)
+
+		var total int32
+		pool.Submit(0, func(ctx context.Context) {
+			pool.Submit(0, func(ctx context.Context) {
+				atomic.AddInt32(&total, 1)
+			}, nil)
+		}, nil)
+
+		assert.NoError(t, pool.Wait())
+		assert.Equal(t, int32(1), total)
+	})
+
+	t.Run("should cancel task context and call on timeout when task timeout is reached", func(t *testing.T) {
+		pool := NewWorkerPool(context.Background(), 1)
+
+		var timeoutErr error
+		var mutex sync.Mutex
+		pool.Submit(10*time.Millisecond, func(ctx context.Context) {
+			<-ctx.Done()
+		}, func(err error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			timeoutErr = err
+		})
+
+		assert.NoError(t, pool.Wait())
+		assert.Equal(t, context.DeadlineExceeded, timeoutErr)
+	})
+
+	t.Run("should keep worker busy until timed out task returns", func(t *testing.T) {
+		pool := NewWorkerPool(context.Background(), 1)
+
+		var running, maxRunning int32
+		task := func(ctx context.Context) {
+			current := atomic.AddInt32(&running, 1)
+			if current > atomic.LoadInt32(&maxRunning) {
+				atomic.StoreInt32(&maxRunning, current)
+			}
+
+			time.Sleep(30 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+		}
+		pool.Submit(5*time.Millisecond, task, nil)
+		pool.Submit(5*time.Millisecond, task, nil)
+
+		assert.NoError(t, pool.Wait())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxRunning))
+		assert.Equal(t, int32(0), atomic.LoadInt32(&running))
+	})
+}
+
+func TestWait(t *testing.T) {
+	t.Run("should return error and not start remaining tasks when pool context is done", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		pool := NewWorkerPool(ctx, 1)
+
+		var started int32
+		for i := 0; i < 3; i++ {
+			pool.Submit(0, func(ctx context.Context) {
+				atomic.AddInt32(&started, 1)
+				<-ctx.Done()
+			}, func(err error) {
+				assert.Fail(t, "should not call on timeout when pool context is done")
+			})
+		}
+
+		assert.Equal(t, context.DeadlineExceeded, pool.Wait())
+		time.Sleep(10 * time.Millisecond)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&started))
+	})
+}